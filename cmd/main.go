@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/hizu77/avito-autumn-2025/config"
 	"github.com/hizu77/avito-autumn-2025/internal/bootstrap"
@@ -15,6 +16,15 @@ func main() {
 		log.Fatal(errors.Wrap(err, "failed to initialize zap logger"))
 	}
 
+	// migrate runs before the service is configured,
+	// it needs nothing but the database.
+	if len(os.Args) > 1 && os.Args[1] == migrateCommand {
+		if err = migrate(os.Args[2:], logger); err != nil {
+			logger.Fatal("failed to migrate", zap.Error(err))
+		}
+		return
+	}
+
 	cfg, err := config.New()
 	if err != nil {
 		logger.Fatal("failed to initialize config", zap.Error(err))
@@ -27,18 +37,44 @@ func main() {
 		logger.Fatal("failed to initialize global context", zap.Error(err))
 	}
 
-	pool, err := bootstrap.InitPostgres(
-		ctx,
-		cfg.Postgres.URL,
-		logger,
-	)
+	err = bootstrap.InitTracing(ctx, cfg.Tracing, logger)
 	if err != nil {
-		logger.Fatal("failed to initialize postgres", zap.Error(err))
+		logger.Fatal("failed to initialize tracing", zap.Error(err))
+	}
+
+	var storages *bootstrap.Storages
+
+	switch cfg.Storage.Driver {
+	case bootstrap.StorageDriverMemory:
+		storages, err = bootstrap.InitMemoryStorages()
+		if err != nil {
+			logger.Fatal("failed to initialize in-memory storage", zap.Error(err))
+		}
+	case bootstrap.StorageDriverPostgres:
+		pool, err := bootstrap.InitPostgres(
+			ctx,
+			cfg.Postgres.URL,
+			logger,
+		)
+		if err != nil {
+			logger.Fatal("failed to initialize postgres", zap.Error(err))
+		}
+
+		if cfg.Migrate.OnStart {
+			err = bootstrap.MigrateOnStart(ctx, pool, logger)
+			if err != nil {
+				logger.Fatal("failed to apply migrations", zap.Error(err))
+			}
+		}
+
+		storages = bootstrap.InitPostgresStorages(pool)
+	default:
+		logger.Fatal("unknown storage driver", zap.String("driver", cfg.Storage.Driver))
 	}
 
 	app := bootstrap.InitApp(cfg, logger)
 
-	err = bootstrap.InitHandlers(ctx, app, pool, cfg)
+	err = bootstrap.InitHandlers(ctx, app, storages, cfg)
 	if err != nil {
 		logger.Fatal("failed to initialize handler", zap.Error(err))
 	}
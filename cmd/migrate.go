@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/config"
+	"github.com/hizu77/avito-autumn-2025/internal/bootstrap"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	migrateCommand = "migrate"
+
+	migrateUp     = "up"
+	migrateDown   = "down"
+	migrateStatus = "status"
+)
+
+var errMigrateUsage = errors.New("usage: migrate up|down|status")
+
+// migrate connects to POSTGRES_URL and runs the migrate command.
+// The rest of the configuration is not required.
+func migrate(args []string, logger *zap.Logger) error {
+	cfg, err := config.NewPostgres()
+	if err != nil {
+		return errors.Wrap(err, "init config")
+	}
+
+	bootstrap.InitCloser()
+
+	ctx := context.Background()
+
+	pool, err := bootstrap.InitPostgres(ctx, cfg.URL, logger)
+	if err != nil {
+		return errors.Wrap(err, "init postgres")
+	}
+	defer pool.Close()
+
+	return runMigrate(ctx, pool, args, os.Stdout)
+}
+
+// runMigrate runs `migrate up|down|status` against the database
+// with the migrations embedded in the binary.
+func runMigrate(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	args []string,
+	out io.Writer,
+) error {
+	if len(args) != 1 {
+		return errMigrateUsage
+	}
+
+	m, err := bootstrap.InitMigrator(pool)
+	if err != nil {
+		return errors.Wrap(err, "init migrator")
+	}
+
+	switch args[0] {
+	case migrateUp:
+		applied, err := m.Up(ctx)
+		for _, migration := range applied {
+			_, _ = fmt.Fprintf(out, "applied V%d %s\n", migration.Version, migration.Description)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			_, _ = fmt.Fprintln(out, "schema is up to date")
+		}
+	case migrateDown:
+		undone, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(out, "undone V%d %s\n", undone.Version, undone.Description)
+	case migrateStatus:
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "VERSION\tDESCRIPTION\tSTATE\tINSTALLED ON")
+		for _, status := range statuses {
+			installedOn := ""
+			if status.InstalledOn != nil {
+				installedOn = status.InstalledOn.Format(time.DateTime)
+			}
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, status.Description, status.State, installedOn)
+		}
+
+		return errors.Wrap(w.Flush(), "write status")
+	default:
+		return errMigrateUsage
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v11"
 	"github.com/joho/godotenv"
 	"github.com/pkg/errors"
@@ -8,25 +10,94 @@ import (
 
 type (
 	Config struct {
-		Postgres `envPrefix:"POSTGRES_"`
-		HTTP     `envPrefix:"HTTP_"`
-		Admin    `envPrefix:"ADMIN_"`
+		Storage      `envPrefix:"STORAGE_"`
+		Postgres     `envPrefix:"POSTGRES_"`
+		HTTP         `envPrefix:"HTTP_"`
+		Admin        `envPrefix:"ADMIN_"`
+		JWT          `envPrefix:"JWT_"`
+		Webhook      `envPrefix:"WEBHOOK_"`
+		Integrations `envPrefix:"INTEGRATION_"`
+		Tracing      `envPrefix:"TRACING_"`
+		Migrate      `envPrefix:"MIGRATE_"`
+		Idempotency  `envPrefix:"IDEMPOTENCY_"`
+	}
+
+	// Storage.Driver is postgres, or memory to keep all data in the process
+	// without a database, e.g. for local runs and tests.
+	Storage struct {
+		Driver string `env:"DRIVER" envDefault:"postgres"`
 	}
 
+	// Postgres.URL is required by the postgres storage driver only.
 	Postgres struct {
-		URL string `env:"URL"`
+		URL string `env:"URL" envDefault:""`
 	}
 
+	// HTTP.ShutdownDelay is how long /ready answers 503 before
+	// the server stops, so that balancers stop routing to it first.
 	HTTP struct {
-		Host string `env:"HOST"`
-		Port string `env:"PORT"`
+		Host          string        `env:"HOST"`
+		Port          string        `env:"PORT"`
+		ShutdownDelay time.Duration `env:"SHUTDOWN_DELAY" envDefault:"5s"`
 	}
 
+	// Admin.Secret signs access tokens only with the HS256 algorithm.
 	Admin struct {
-		Secret   string `env:"SECRET"`
+		Secret   string `env:"SECRET" envDefault:""`
 		ID       string `env:"ID"`
 		Password string `env:"PASSWORD"`
 	}
+
+	// JWT selects how access tokens are signed. RS256 and EdDSA sign with
+	// the private key from SIGNING_KEY_FILE, VERIFICATION_KEY_FILES lists
+	// comma separated public keys that are still accepted, e.g. during a rotation.
+	JWT struct {
+		Algorithm            string   `env:"ALGORITHM" envDefault:"HS256"`
+		SigningKeyFile       string   `env:"SIGNING_KEY_FILE" envDefault:""`
+		VerificationKeyFiles []string `env:"VERIFICATION_KEY_FILES" envDefault:"" envSeparator:","`
+	}
+
+	// Migrate.OnStart applies the embedded migrations on startup,
+	// instead of running Flyway or the migrate command beforehand.
+	Migrate struct {
+		OnStart bool `env:"ON_START" envDefault:"false"`
+	}
+
+	Webhook struct {
+		PollInterval time.Duration `env:"POLL_INTERVAL" envDefault:"1s"`
+		BatchSize    int           `env:"BATCH_SIZE" envDefault:"50"`
+		MaxAttempts  int           `env:"MAX_ATTEMPTS" envDefault:"10"`
+		RetryBackoff time.Duration `env:"RETRY_BACKOFF" envDefault:"1s"`
+		MaxBackoff   time.Duration `env:"MAX_BACKOFF" envDefault:"10m"`
+		Timeout      time.Duration `env:"TIMEOUT" envDefault:"5s"`
+		LeaseTimeout time.Duration `env:"LEASE_TIMEOUT" envDefault:"5m"`
+	}
+
+	// Idempotency.TTL is how long the response to a request with an
+	// Idempotency-Key is replayed. LockTimeout is how long a request in
+	// progress holds its key, it must exceed the time to serve a request.
+	Idempotency struct {
+		TTL             time.Duration `env:"TTL" envDefault:"24h"`
+		LockTimeout     time.Duration `env:"LOCK_TIMEOUT" envDefault:"1m"`
+		CleanupInterval time.Duration `env:"CLEANUP_INTERVAL" envDefault:"10m"`
+	}
+
+	// Integrations hold the secrets of git hosting webhooks.
+	// An empty secret disables the corresponding endpoint.
+	Integrations struct {
+		GitHubSecret string `env:"GITHUB_SECRET" envDefault:""`
+		GitLabToken  string `env:"GITLAB_TOKEN" envDefault:""`
+	}
+
+	// Tracing selects the span exporter: none, stdout for local runs
+	// or otlp to send spans to an OTLP/HTTP collector at OTLP_ENDPOINT.
+	Tracing struct {
+		Exporter     string  `env:"EXPORTER" envDefault:"none"`
+		OTLPEndpoint string  `env:"OTLP_ENDPOINT" envDefault:"localhost:4318"`
+		OTLPInsecure bool    `env:"OTLP_INSECURE" envDefault:"true"`
+		ServiceName  string  `env:"SERVICE_NAME" envDefault:"reviewer-service"`
+		SampleRatio  float64 `env:"SAMPLE_RATIO" envDefault:"1"`
+	}
 )
 
 func New() (*Config, error) {
@@ -41,3 +112,19 @@ func New() (*Config, error) {
 
 	return &cfg, nil
 }
+
+// NewPostgres parses only the POSTGRES_ variables, for commands
+// that need the database and nothing else, such as migrate.
+func NewPostgres() (*Postgres, error) {
+	cfg := Postgres{}
+
+	_ = godotenv.Load()
+	if err := env.ParseWithOptions(&cfg, env.Options{
+		Prefix:          "POSTGRES_",
+		RequiredIfNoDef: true,
+	}); err != nil {
+		return nil, errors.Wrap(err, "parse env")
+	}
+
+	return &cfg, nil
+}
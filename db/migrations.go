@@ -0,0 +1,56 @@
+// Package db embeds the Flyway migrations of the service
+// together with their undo scripts.
+package db
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/hizu77/avito-autumn-2025/pkg/migrator"
+	"github.com/pkg/errors"
+)
+
+// Undo scripts are kept apart, so that the Flyway
+// container does not pick them up from db/migrations.
+var (
+	//go:embed migrations/*.sql
+	migrations embed.FS
+
+	//go:embed undo/*.sql
+	undo embed.FS
+)
+
+// Migrations loads the embedded migrations ordered by version.
+func Migrations() ([]migrator.Migration, error) {
+	migrationsFS, err := fs.Sub(migrations, "migrations")
+	if err != nil {
+		return nil, errors.Wrap(err, "open migrations")
+	}
+
+	undoFS, err := fs.Sub(undo, "undo")
+	if err != nil {
+		return nil, errors.Wrap(err, "open undo migrations")
+	}
+
+	loaded, err := migrator.Load(migrationsFS, undoFS)
+	if err != nil {
+		return nil, errors.Wrap(err, "load migrations")
+	}
+
+	return loaded, nil
+}
+
+// LatestMigrationVersion returns the highest version among the embedded
+// migrations, i.e. the version the database is expected to be at.
+func LatestMigrationVersion() (int, error) {
+	loaded, err := Migrations()
+	if err != nil {
+		return 0, err
+	}
+
+	if len(loaded) == 0 {
+		return 0, nil
+	}
+
+	return loaded[len(loaded)-1].Version, nil
+}
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS assignment_events (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL CHECK (event_type IN ('ASSIGNED', 'REASSIGNED', 'MERGED')),
+    actor           TEXT NOT NULL,
+    old_reviewer_id TEXT REFERENCES users(id),
+    new_reviewer_id TEXT REFERENCES users(id),
+    reason          TEXT NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_events_pull_request_id
+    ON assignment_events(pull_request_id, created_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_events_old_reviewer_id
+    ON assignment_events(old_reviewer_id)
+    WHERE event_type = 'REASSIGNED';
+
+INSERT INTO assignment_events (
+    pull_request_id,
+    event_type,
+    actor,
+    old_reviewer_id,
+    new_reviewer_id,
+    reason,
+    created_at
+)
+SELECT
+    pull_request_id,
+    'REASSIGNED',
+    'unknown',
+    old_reviewer_id,
+    new_reviewer_id,
+    'reassign requested',
+    reassigned_at
+FROM pull_request_reassignments;
+
+DROP TABLE IF EXISTS pull_request_reassignments;
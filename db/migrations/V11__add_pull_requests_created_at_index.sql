@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at_id
+    ON pull_requests(created_at DESC, id DESC);
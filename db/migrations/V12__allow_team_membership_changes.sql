@@ -0,0 +1,21 @@
+ALTER TABLE users
+    ALTER COLUMN team_name DROP NOT NULL;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams(name) ON UPDATE CASCADE;
+
+CREATE TABLE IF NOT EXISTS team_membership_events (
+    id            BIGSERIAL PRIMARY KEY,
+    user_id       TEXT NOT NULL REFERENCES users(id),
+    old_team_name TEXT,
+    new_team_name TEXT,
+    actor         TEXT NOT NULL,
+    created_at    TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_team_membership_events_user_id
+    ON team_membership_events(user_id, created_at);
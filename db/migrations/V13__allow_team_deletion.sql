@@ -0,0 +1,18 @@
+-- Pull requests keep RESTRICT foreign keys to their authors and reviewers:
+-- team deletion removes the OPEN ones explicitly and refuses to delete users
+-- with merged history.
+
+-- Audit logs keep the ids of deleted users.
+ALTER TABLE assignment_events
+    DROP CONSTRAINT IF EXISTS assignment_events_old_reviewer_id_fkey,
+    DROP CONSTRAINT IF EXISTS assignment_events_new_reviewer_id_fkey;
+
+ALTER TABLE team_membership_events
+    DROP CONSTRAINT IF EXISTS team_membership_events_user_id_fkey;
+
+ALTER TABLE assignment_events
+    DROP CONSTRAINT IF EXISTS assignment_events_event_type_check;
+
+ALTER TABLE assignment_events
+    ADD CONSTRAINT assignment_events_event_type_check
+        CHECK (event_type IN ('ASSIGNED', 'REASSIGNED', 'UNASSIGNED', 'MERGED'));
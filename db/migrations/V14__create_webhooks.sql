@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS webhooks (
+    id         BIGSERIAL PRIMARY KEY,
+    url        TEXT NOT NULL,
+    team_name  TEXT REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    secret     TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_team_name ON webhooks(team_name);
+
+CREATE TABLE IF NOT EXISTS webhook_outbox (
+    id              BIGSERIAL PRIMARY KEY,
+    webhook_id      BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_type      TEXT NOT NULL,
+    payload         JSONB NOT NULL,
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    failed_at       TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_outbox_pending
+    ON webhook_outbox(next_attempt_at, id)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS git_login_mappings (
+    provider TEXT NOT NULL CHECK (provider IN ('GITHUB', 'GITLAB')),
+    login    TEXT NOT NULL,
+    user_id  TEXT NOT NULL REFERENCES users(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    PRIMARY KEY (provider, login)
+);
+
+CREATE INDEX IF NOT EXISTS idx_git_login_mappings_user_id ON git_login_mappings(user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE admins
+    ADD COLUMN IF NOT EXISTS role TEXT NOT NULL DEFAULT 'ADMIN'
+        CHECK (role IN ('ADMIN', 'TEAM_LEAD', 'MEMBER', 'SERVICE_BOT')),
+    ADD COLUMN IF NOT EXISTS team_name TEXT REFERENCES teams(name) ON UPDATE CASCADE ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS user_id TEXT REFERENCES users(id) ON UPDATE CASCADE ON DELETE SET NULL;
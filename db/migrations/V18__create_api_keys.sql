@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    id         TEXT PRIMARY KEY,
+    name       TEXT NOT NULL,
+    key_hash   TEXT NOT NULL,
+    scopes     TEXT[] NOT NULL
+        CHECK (scopes <@ ARRAY['pr:read', 'pr:write', 'team:read', 'team:write', 'user:read', 'user:admin']),
+    created_by TEXT REFERENCES admins(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ
+);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    caller_id     TEXT        NOT NULL,
+    key           TEXT        NOT NULL,
+    request_hash  TEXT        NOT NULL,
+    status_code   INTEGER,
+    response_body BYTEA,
+    created_at    TIMESTAMPTZ NOT NULL,
+    expires_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (caller_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at
+    ON idempotency_keys(expires_at);
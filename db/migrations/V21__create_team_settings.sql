@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS team_settings (
+    team_name     TEXT    PRIMARY KEY REFERENCES teams(name) ON UPDATE CASCADE ON DELETE CASCADE,
+    min_reviewers INTEGER NOT NULL CHECK (min_reviewers >= 0),
+    max_reviewers INTEGER NOT NULL CHECK (max_reviewers >= 1),
+    CHECK (min_reviewers <= max_reviewers)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS pull_request_reassignments (
+    id              BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    old_reviewer_id TEXT NOT NULL REFERENCES users(id),
+    new_reviewer_id TEXT NOT NULL REFERENCES users(id),
+    reassigned_at   TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_reassignments_old_reviewer_id
+    ON pull_request_reassignments(old_reviewer_id);
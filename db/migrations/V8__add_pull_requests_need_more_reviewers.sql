@@ -0,0 +1,15 @@
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS need_more_reviewers BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE pull_requests pr
+SET need_more_reviewers = TRUE
+WHERE pr.status_id = (SELECT id FROM pull_request_statuses WHERE name = 'OPEN')
+  AND (
+    SELECT COUNT(*)
+    FROM pull_request_reviewers r
+    WHERE r.pull_request_id = pr.id
+  ) < 2;
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_need_more_reviewers
+    ON pull_requests(need_more_reviewers)
+    WHERE need_more_reviewers;
@@ -0,0 +1,8 @@
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS reviewer_strategy TEXT NOT NULL DEFAULT 'RANDOM'
+        CHECK (reviewer_strategy IN ('RANDOM', 'LEAST_LOADED', 'ROUND_ROBIN', 'SENIORITY')),
+    ADD COLUMN IF NOT EXISTS round_robin_cursor TEXT;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS seniority INTEGER NOT NULL DEFAULT 1
+        CHECK (seniority >= 1);
@@ -0,0 +1,12 @@
+-- Fails while there are users without a team, as team_name becomes NOT NULL again.
+DROP TABLE IF EXISTS team_membership_events;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams(name);
+
+ALTER TABLE users
+    ALTER COLUMN team_name SET NOT NULL;
@@ -0,0 +1,9 @@
+ALTER TABLE assignment_events
+    DROP CONSTRAINT IF EXISTS assignment_events_event_type_check;
+
+DELETE FROM assignment_events
+WHERE event_type = 'UNASSIGNED';
+
+ALTER TABLE assignment_events
+    ADD CONSTRAINT assignment_events_event_type_check
+        CHECK (event_type IN ('ASSIGNED', 'REASSIGNED', 'MERGED'));
@@ -0,0 +1,4 @@
+ALTER TABLE admins
+    DROP COLUMN IF EXISTS user_id,
+    DROP COLUMN IF EXISTS team_name,
+    DROP COLUMN IF EXISTS role;
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS revoked_access_tokens;
+
+DROP TABLE IF EXISTS admin_refresh_tokens;
+
+ALTER TABLE admins
+    DROP COLUMN IF EXISTS password_changed_at;
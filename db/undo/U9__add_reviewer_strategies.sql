@@ -0,0 +1,6 @@
+ALTER TABLE users
+    DROP COLUMN IF EXISTS seniority;
+
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS round_robin_cursor,
+    DROP COLUMN IF EXISTS reviewer_strategy;
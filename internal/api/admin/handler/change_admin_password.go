@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) ChangeAdminPassword(w http.ResponseWriter, r *http.Request) {
+	const op = "admin.ChangeAdminPassword"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	ctx := r.Context()
+	accessToken, ok := model.AccessTokenFromContext(ctx)
+	if !ok {
+		httperr.WriteError(w, r, httperr.CodeUnauthorized)
+		return
+	}
+
+	var changeAdminPasswordRequest request.ChangeAdminPassword
+	if err := render.DecodeJSON(r.Body, &changeAdminPasswordRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateChangeAdminPasswordRequest(changeAdminPasswordRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	tokens, err := h.service.ChangeAdminPassword(
+		ctx,
+		accessToken,
+		changeAdminPasswordRequest.OldPassword,
+		changeAdminPasswordRequest.NewPassword,
+	)
+	if err != nil {
+		logger.Error("change admin password",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAdminErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	mappedTokens := mapTokenPairToResponseChangeAdminPassword(tokens)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mappedTokens)
+}
+
+func validateChangeAdminPasswordRequest(req request.ChangeAdminPassword) error {
+	if req.OldPassword == "" {
+		return errors.New("old_password is required")
+	}
+
+	if req.NewPassword == "" {
+		return errors.New("new_password is required")
+	}
+
+	if req.NewPassword == req.OldPassword {
+		return errors.New("new_password must differ from old_password")
+	}
+
+	return nil
+}
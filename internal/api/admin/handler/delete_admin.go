@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/response"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) DeleteAdmin(w http.ResponseWriter, r *http.Request) {
+	const op = "admin.DeleteAdmin"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var deleteAdminRequest request.DeleteAdmin
+	if err := render.DecodeJSON(r.Body, &deleteAdminRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateDeleteAdminRequest(deleteAdminRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	err := h.service.DeleteAdmin(ctx, deleteAdminRequest.ID)
+	if err != nil {
+		logger.Error("delete admin",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		// Unlike on login, a missing account here is not a credentials problem.
+		code := mapDomainAdminErrorToCode(err)
+		if errors.Is(err, model.ErrAdminDoesNotExist) {
+			code = httperr.CodeNotFound
+		}
+
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response.DeleteAdmin{ID: deleteAdminRequest.ID})
+}
+
+func validateDeleteAdminRequest(req request.DeleteAdmin) error {
+	if req.ID == "" {
+		return errors.New("id is required")
+	}
+
+	return nil
+}
@@ -8,8 +8,17 @@ import (
 )
 
 type service interface {
-	LoginAdmin(ctx context.Context, id string, password string) (string, error)
-	RegisterAdmin(ctx context.Context, id string, password string) (model.Admin, error)
+	LoginAdmin(ctx context.Context, id string, password string) (model.TokenPair, error)
+	RegisterAdmin(ctx context.Context, admin model.Admin, password string) (model.Admin, error)
+	RefreshAdminToken(ctx context.Context, refreshToken string) (model.TokenPair, error)
+	LogoutAdmin(ctx context.Context, accessToken model.AccessToken, refreshToken string) error
+	ChangeAdminPassword(
+		ctx context.Context,
+		accessToken model.AccessToken,
+		oldPassword string,
+		newPassword string,
+	) (model.TokenPair, error)
+	DeleteAdmin(ctx context.Context, id string) error
 }
 
 type Handler struct {
@@ -6,16 +6,18 @@ import (
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
 func (h *Handler) LoginAdmin(w http.ResponseWriter, r *http.Request) {
 	const op = "admin.LoginAdmin"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var loginAdminRequest request.LoginAdmin
 	if err := render.DecodeJSON(r.Body, &loginAdminRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +27,7 @@ func (h *Handler) LoginAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateLoginAdminRequest(loginAdminRequest); err != nil {
-		h.logger.Error("validating request",
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -35,13 +37,13 @@ func (h *Handler) LoginAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
-	token, err := h.service.LoginAdmin(
+	tokens, err := h.service.LoginAdmin(
 		ctx,
 		loginAdminRequest.ID,
 		loginAdminRequest.Password,
 	)
 	if err != nil {
-		h.logger.Error("login admin",
+		logger.Error("login admin",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -51,7 +53,7 @@ func (h *Handler) LoginAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	mappedToken := mapTokenToResponseLoginAdmin(token)
+	mappedToken := mapTokenPairToResponseLoginAdmin(tokens)
 
 	render.Status(r, http.StatusOK)
 	render.JSON(w, r, mappedToken)
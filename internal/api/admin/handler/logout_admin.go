@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/response"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) LogoutAdmin(w http.ResponseWriter, r *http.Request) {
+	const op = "admin.LogoutAdmin"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	ctx := r.Context()
+	accessToken, ok := model.AccessTokenFromContext(ctx)
+	if !ok {
+		httperr.WriteError(w, r, httperr.CodeUnauthorized)
+		return
+	}
+
+	// The body is optional: without a refresh token all sessions are closed.
+	var logoutAdminRequest request.LogoutAdmin
+	err := render.DecodeJSON(r.Body, &logoutAdminRequest)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	err = h.service.LogoutAdmin(ctx, accessToken, logoutAdminRequest.RefreshToken)
+	if err != nil {
+		logger.Error("logout admin",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAdminErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response.LogoutAdmin{ID: accessToken.AdminID})
+}
@@ -1,21 +1,58 @@
 package admin
 
 import (
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
 	"github.com/hizu77/avito-autumn-2025/internal/api/admin/response"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/pkg/errors"
 )
 
-func mapTokenToResponseLoginAdmin(token string) response.LoginAdmin {
+func mapTokenPairToResponseLoginAdmin(tokens model.TokenPair) response.LoginAdmin {
 	return response.LoginAdmin{
-		Token: token,
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 	}
 }
 
+func mapTokenPairToResponseRefreshAdminToken(tokens model.TokenPair) response.RefreshAdminToken {
+	return response.RefreshAdminToken{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func mapTokenPairToResponseChangeAdminPassword(tokens model.TokenPair) response.ChangeAdminPassword {
+	return response.ChangeAdminPassword{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func mapRequestRegisterAdminToDomainAdmin(req request.RegisterAdmin) (model.Admin, error) {
+	role := model.RoleAdmin
+	if req.Role != "" {
+		parsed, err := model.ParseRole(req.Role)
+		if err != nil {
+			return model.Admin{}, errors.New("unknown role")
+		}
+		role = parsed
+	}
+
+	return model.Admin{
+		ID:       req.ID,
+		Role:     role,
+		TeamName: req.TeamName,
+		UserID:   req.UserID,
+	}, nil
+}
+
 func mapDomainAdminToResponseRegisterAdmin(admin model.Admin) response.RegisterAdmin {
 	return response.RegisterAdmin{
-		ID: admin.ID,
+		ID:       admin.ID,
+		Role:     admin.Role.String(),
+		TeamName: admin.TeamName,
+		UserID:   admin.UserID,
 	}
 }
 
@@ -26,6 +63,13 @@ func mapDomainAdminErrorToCode(err error) httperr.ErrorCode {
 	case errors.Is(err, model.ErrInvalidAdminPassword),
 		errors.Is(err, model.ErrAdminDoesNotExist):
 		return httperr.CodeInvalidCredentials
+	case errors.Is(err, model.ErrInvalidRefreshToken):
+		return httperr.CodeUnauthorized
+	case errors.Is(err, model.ErrAdminDeletesSelf):
+		return httperr.CodeBadRequest
+	case errors.Is(err, model.ErrTeamDoesNotExist),
+		errors.Is(err, model.ErrUserDoesNotExist):
+		return httperr.CodeNotFound
 	default:
 		return httperr.CodeInternal
 	}
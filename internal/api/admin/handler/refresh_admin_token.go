@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RefreshAdminToken(w http.ResponseWriter, r *http.Request) {
+	const op = "admin.RefreshAdminToken"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var refreshAdminTokenRequest request.RefreshAdminToken
+	if err := render.DecodeJSON(r.Body, &refreshAdminTokenRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateRefreshAdminTokenRequest(refreshAdminTokenRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	tokens, err := h.service.RefreshAdminToken(ctx, refreshAdminTokenRequest.RefreshToken)
+	if err != nil {
+		logger.Error("refresh admin token",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAdminErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	mappedTokens := mapTokenPairToResponseRefreshAdminToken(tokens)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mappedTokens)
+}
+
+func validateRefreshAdminTokenRequest(req request.RefreshAdminToken) error {
+	if req.RefreshToken == "" {
+		return errors.New("refresh_token is required")
+	}
+
+	return nil
+}
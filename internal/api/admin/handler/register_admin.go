@@ -6,16 +6,19 @@ import (
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/admin/request"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
 func (h *Handler) RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 	const op = "admin.RegisterAdmin"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var registerAdminRequest request.RegisterAdmin
 	if err := render.DecodeJSON(r.Body, &registerAdminRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -24,8 +27,9 @@ func (h *Handler) RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := validateRegisterAdminRequest(registerAdminRequest); err != nil {
-		h.logger.Error("validating request",
+	admin, err := validateRegisterAdminRequest(registerAdminRequest)
+	if err != nil {
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -35,13 +39,13 @@ func (h *Handler) RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := r.Context()
-	admin, err := h.service.RegisterAdmin(
+	admin, err = h.service.RegisterAdmin(
 		ctx,
-		registerAdminRequest.ID,
+		admin,
 		registerAdminRequest.Password,
 	)
 	if err != nil {
-		h.logger.Error("register admin",
+		logger.Error("register admin",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -57,14 +61,27 @@ func (h *Handler) RegisterAdmin(w http.ResponseWriter, r *http.Request) {
 	render.JSON(w, r, mappedAdmin)
 }
 
-func validateRegisterAdminRequest(req request.RegisterAdmin) error {
+func validateRegisterAdminRequest(req request.RegisterAdmin) (model.Admin, error) {
 	if req.ID == "" {
-		return errors.New("id is required")
+		return model.Admin{}, errors.New("id is required")
 	}
 
 	if req.Password == "" {
-		return errors.New("password is required")
+		return model.Admin{}, errors.New("password is required")
+	}
+
+	admin, err := mapRequestRegisterAdminToDomainAdmin(req)
+	if err != nil {
+		return model.Admin{}, err
+	}
+
+	if (admin.Role == model.RoleTeamLead) != (admin.TeamName != nil && *admin.TeamName != "") {
+		return model.Admin{}, errors.New("team_name is required for TEAM_LEAD and allowed only for it")
+	}
+
+	if (admin.Role == model.RoleMember) != (admin.UserID != nil && *admin.UserID != "") {
+		return model.Admin{}, errors.New("user_id is required for MEMBER and allowed only for it")
 	}
 
-	return nil
+	return admin, nil
 }
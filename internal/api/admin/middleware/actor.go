@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+const (
+	adminIDClaim  = "admin_id"
+	roleClaim     = "role"
+	teamNameClaim = "team_name"
+	userIDClaim   = "user_id"
+)
+
+// Actor attaches the account of a verified token to the request context,
+// so that changes made by the request are attributed to it and
+// access checks know its role. Requests without a valid token pass through unchanged.
+func Actor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, claims, err := jwtauth.FromContext(r.Context())
+		if err == nil && token != nil {
+			if principal, ok := principalFromClaims(claims); ok {
+				ctx := model.ContextWithActor(r.Context(), principal.ID)
+				ctx = model.ContextWithPrincipal(ctx, principal)
+				logging.SetCaller(ctx, principal)
+				r = r.WithContext(ctx)
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// principalFromClaims treats tokens without a role as admin ones:
+// they were issued before roles existed, when every account was an admin.
+func principalFromClaims(claims map[string]any) (model.Principal, bool) {
+	adminID, ok := claims[adminIDClaim].(string)
+	if !ok || adminID == "" {
+		return model.Principal{}, false
+	}
+
+	role := model.RoleAdmin
+	if rawRole, ok := claims[roleClaim].(string); ok {
+		parsed, err := model.ParseRole(rawRole)
+		if err != nil {
+			return model.Principal{}, false
+		}
+		role = parsed
+	}
+
+	principal := model.Principal{
+		ID:   adminID,
+		Role: role,
+	}
+	if teamName, ok := claims[teamNameClaim].(string); ok {
+		principal.TeamName = &teamName
+	}
+	if userID, ok := claims[userIDClaim].(string); ok {
+		principal.UserID = &userID
+	}
+
+	return principal, true
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+const HeaderAPIKey = "X-API-Key"
+
+type apiKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (model.APIKey, error)
+}
+
+// APIKey authenticates requests carrying the X-API-Key header,
+// which is accepted alongside a bearer token and takes precedence over it.
+// Requests without the header pass through unchanged, invalid keys are rejected.
+func APIKey(authenticator apiKeyAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderAPIKey)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			apiKey, err := authenticator.AuthenticateAPIKey(r.Context(), key)
+			if errors.Is(err, model.ErrInvalidAPIKey) {
+				httperr.WriteError(w, r, httperr.CodeUnauthorized, "invalid api key")
+				return
+			}
+			if err != nil {
+				httperr.WriteError(w, r, httperr.CodeInternal)
+				return
+			}
+
+			principal := model.Principal{
+				ID:     apiKey.ID,
+				Role:   model.RoleServiceBot,
+				APIKey: true,
+				Scopes: apiKey.Scopes,
+			}
+
+			ctx := model.ContextWithActor(r.Context(), principal.ID)
+			ctx = model.ContextWithPrincipal(ctx, principal)
+			logging.SetCaller(ctx, principal)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
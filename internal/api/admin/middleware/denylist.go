@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+type revocationChecker interface {
+	IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error)
+}
+
+// Denylist rejects verified tokens that were revoked by a logout,
+// a password change or a deletion of their account.
+// Accepted tokens are attached to the request context.
+// It must be used right after jwtauth.Verifier.
+func Denylist(checker revocationChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, _, err := jwtauth.FromContext(r.Context())
+			if err != nil || token == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			accessToken := accessTokenFromJWT(token)
+
+			revoked, err := checker.IsAccessTokenRevoked(r.Context(), accessToken)
+			if err != nil {
+				httperr.WriteError(w, r, httperr.CodeInternal)
+				return
+			}
+
+			if revoked {
+				httperr.WriteError(w, r, httperr.CodeUnauthorized, "token has been revoked")
+				return
+			}
+
+			ctx := model.ContextWithAccessToken(r.Context(), accessToken)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func accessTokenFromJWT(token jwt.Token) model.AccessToken {
+	accessToken := model.AccessToken{
+		ID:        token.JwtID(),
+		IssuedAt:  token.IssuedAt(),
+		ExpiresAt: token.Expiration(),
+	}
+	if adminID, ok := token.PrivateClaims()[adminIDClaim].(string); ok {
+		accessToken.AdminID = adminID
+	}
+
+	return accessToken
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// RequireRole lets through only requests whose principal has one of the roles.
+// API keys are rejected. It must be used after Actor.
+func RequireRole(roles ...model.Role) func(http.Handler) http.Handler {
+	return requireAccess(nil, roles)
+}
+
+// RequireAccess works like RequireRole, but also lets through API keys with the scope.
+func RequireAccess(scope model.APIKeyScope, roles ...model.Role) func(http.Handler) http.Handler {
+	return requireAccess(&scope, roles)
+}
+
+func requireAccess(scope *model.APIKeyScope, roles []model.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := model.PrincipalFromContext(r.Context())
+			if !ok {
+				httperr.WriteError(w, r, httperr.CodeUnauthorized)
+				return
+			}
+
+			allowed := slices.Contains(roles, principal.Role)
+			if principal.APIKey {
+				allowed = scope != nil && slices.Contains(principal.Scopes, *scope)
+			}
+
+			if !allowed {
+				httperr.WriteError(w, r, httperr.CodeForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
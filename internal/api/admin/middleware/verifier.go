@@ -0,0 +1,37 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/go-chi/jwtauth/v5"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+type tokenVerifier interface {
+	Verify(token string) (jwt.Token, error)
+}
+
+// Verifier works like jwtauth.Verifier, but checks tokens against a set of keys
+// chosen by the kid header, so tokens signed by a previous key stay valid during a rotation.
+// The result is stored the same way, so jwtauth.FromContext keeps working.
+func Verifier(verifier tokenVerifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString := jwtauth.TokenFromHeader(r)
+			if tokenString == "" {
+				tokenString = jwtauth.TokenFromCookie(r)
+			}
+
+			var (
+				token jwt.Token
+				err   = jwtauth.ErrNoTokenFound
+			)
+			if tokenString != "" {
+				token, err = verifier.Verify(tokenString)
+			}
+
+			ctx := jwtauth.NewContext(r.Context(), token, err)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package request
+
+type ChangeAdminPassword struct {
+	OldPassword string `json:"old_password"`
+	NewPassword string `json:"new_password"`
+}
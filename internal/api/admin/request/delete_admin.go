@@ -0,0 +1,5 @@
+package request
+
+type DeleteAdmin struct {
+	ID string `json:"id"`
+}
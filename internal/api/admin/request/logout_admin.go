@@ -0,0 +1,5 @@
+package request
+
+type LogoutAdmin struct {
+	RefreshToken string `json:"refresh_token"`
+}
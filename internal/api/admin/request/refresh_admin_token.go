@@ -0,0 +1,5 @@
+package request
+
+type RefreshAdminToken struct {
+	RefreshToken string `json:"refresh_token"`
+}
@@ -1,6 +1,9 @@
 package request
 
 type RegisterAdmin struct {
-	ID       string `json:"id"`
-	Password string `json:"password"`
+	ID       string  `json:"id"`
+	Password string  `json:"password"`
+	Role     string  `json:"role"`
+	TeamName *string `json:"team_name"`
+	UserID   *string `json:"user_id"`
 }
@@ -0,0 +1,6 @@
+package response
+
+type ChangeAdminPassword struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type DeleteAdmin struct {
+	ID string `json:"id"`
+}
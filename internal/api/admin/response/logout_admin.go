@@ -0,0 +1,5 @@
+package response
+
+type LogoutAdmin struct {
+	ID string `json:"id"`
+}
@@ -1,5 +1,8 @@
 package response
 
 type RegisterAdmin struct {
-	ID string `json:"id"`
+	ID       string  `json:"id"`
+	Role     string  `json:"role"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserID   *string `json:"user_id,omitempty"`
 }
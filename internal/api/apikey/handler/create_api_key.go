@@ -0,0 +1,70 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/apikey/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "apikey.CreateAPIKey"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var createRequest request.CreateAPIKey
+	if err := render.DecodeJSON(r.Body, &createRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	apiKey, err := validateCreateAPIKeyRequest(createRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	saved, key, err := h.service.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		logger.Error("creating api key",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAPIKeyErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	apiKeyResponse := mapDomainAPIKeyToResponseCreateAPIKey(saved, key)
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, apiKeyResponse)
+}
+
+func validateCreateAPIKeyRequest(req request.CreateAPIKey) (model.APIKey, error) {
+	if req.Name == "" {
+		return model.APIKey{}, errors.New("name is required")
+	}
+
+	if len(req.Scopes) == 0 {
+		return model.APIKey{}, errors.New("scopes are required")
+	}
+
+	return mapRequestCreateAPIKeyToDomainAPIKey(req)
+}
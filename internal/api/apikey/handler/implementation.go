@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+type service interface {
+	CreateAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, string, error)
+	ListAPIKeys(ctx context.Context) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) (model.APIKey, error)
+}
+
+type Handler struct {
+	service service
+	logger  *zap.Logger
+}
+
+func New(
+	service service,
+	logger *zap.Logger,
+) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	const op = "apikey.ListAPIKeys"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	ctx := r.Context()
+	apiKeys, err := h.service.ListAPIKeys(ctx)
+	if err != nil {
+		logger.Error("listing api keys",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAPIKeyErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	apiKeysResponse := mapDomainAPIKeysToResponseListAPIKeys(apiKeys)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, apiKeysResponse)
+}
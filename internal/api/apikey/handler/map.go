@@ -0,0 +1,61 @@
+package apikey
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/api/apikey/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/apikey/response"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func mapRequestCreateAPIKeyToDomainAPIKey(req request.CreateAPIKey) (model.APIKey, error) {
+	scopes, err := collection.MapWithError(req.Scopes, model.ParseAPIKeyScope)
+	if err != nil {
+		return model.APIKey{}, errors.New("unknown scope")
+	}
+
+	return model.APIKey{
+		Name:   req.Name,
+		Scopes: scopes,
+	}, nil
+}
+
+func mapDomainAPIKeyToResponseAPIKey(apiKey model.APIKey) response.APIKey {
+	return response.APIKey{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		Scopes:    collection.Map(apiKey.Scopes, model.APIKeyScope.String),
+		CreatedBy: apiKey.CreatedBy,
+		CreatedAt: apiKey.CreatedAt,
+		RevokedAt: apiKey.RevokedAt,
+	}
+}
+
+func mapDomainAPIKeyToResponseCreateAPIKey(apiKey model.APIKey, key string) response.CreateAPIKey {
+	return response.CreateAPIKey{
+		APIKey: mapDomainAPIKeyToResponseAPIKey(apiKey),
+		Key:    key,
+	}
+}
+
+func mapDomainAPIKeysToResponseListAPIKeys(apiKeys []model.APIKey) response.ListAPIKeys {
+	return response.ListAPIKeys{
+		APIKeys: collection.Map(apiKeys, mapDomainAPIKeyToResponseAPIKey),
+	}
+}
+
+func mapDomainAPIKeyToResponseRevokeAPIKey(apiKey model.APIKey) response.RevokeAPIKey {
+	return response.RevokeAPIKey{
+		APIKey: mapDomainAPIKeyToResponseAPIKey(apiKey),
+	}
+}
+
+func mapDomainAPIKeyErrorToCode(err error) httperr.ErrorCode {
+	switch {
+	case errors.Is(err, model.ErrAPIKeyDoesNotExist):
+		return httperr.CodeNotFound
+	default:
+		return httperr.CodeInternal
+	}
+}
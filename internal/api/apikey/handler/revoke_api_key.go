@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/apikey/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+	const op = "apikey.RevokeAPIKey"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var revokeRequest request.RevokeAPIKey
+	if err := render.DecodeJSON(r.Body, &revokeRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateRevokeAPIKeyRequest(revokeRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	apiKey, err := h.service.RevokeAPIKey(ctx, revokeRequest.ID)
+	if err != nil {
+		logger.Error("revoking api key",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainAPIKeyErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	apiKeyResponse := mapDomainAPIKeyToResponseRevokeAPIKey(apiKey)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, apiKeyResponse)
+}
+
+func validateRevokeAPIKeyRequest(req request.RevokeAPIKey) error {
+	if req.ID == "" {
+		return errors.New("id is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,6 @@
+package request
+
+type CreateAPIKey struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type RevokeAPIKey struct {
+	ID string `json:"id"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// CreateAPIKey is the only response that reveals the key.
+type CreateAPIKey struct {
+	APIKey APIKey `json:"api_key"`
+	Key    string `json:"key"`
+}
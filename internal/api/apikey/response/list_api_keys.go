@@ -0,0 +1,5 @@
+package response
+
+type ListAPIKeys struct {
+	APIKeys []APIKey `json:"api_keys"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type RevokeAPIKey struct {
+	APIKey APIKey `json:"api_key"`
+}
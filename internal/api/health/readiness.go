@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+const (
+	statusOK          = "ok"
+	statusUnavailable = "unavailable"
+)
+
+type readinessService interface {
+	CheckReadiness(ctx context.Context) model.Readiness
+}
+
+type Handler struct {
+	service readinessService
+	logger  *zap.Logger
+}
+
+func New(service readinessService, logger *zap.Logger) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+type readinessResponse struct {
+	Status       string             `json:"status"`
+	ShuttingDown bool               `json:"shutting_down"`
+	Database     string             `json:"database"`
+	Migrations   migrationsResponse `json:"migrations"`
+	Pool         poolResponse       `json:"pool"`
+}
+
+type migrationsResponse struct {
+	Status   string `json:"status"`
+	Expected int    `json:"expected_version"`
+	Applied  int    `json:"applied_version"`
+}
+
+type poolResponse struct {
+	AcquiredConns int32   `json:"acquired_connections"`
+	IdleConns     int32   `json:"idle_connections"`
+	TotalConns    int32   `json:"total_connections"`
+	MaxConns      int32   `json:"max_connections"`
+	Saturation    float64 `json:"saturation"`
+}
+
+// Readiness answers 503 while the database is unreachable, migrations
+// are behind the embedded ones or the app is shutting down,
+// so that the instance is taken out of load balancing.
+func (h *Handler) Readiness(w http.ResponseWriter, r *http.Request) {
+	const op = "Handler.Readiness"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	readiness := h.service.CheckReadiness(r.Context())
+
+	if readiness.DatabaseErr != nil {
+		logger.Warn("database is not ready", zap.String("op", op), zap.Error(readiness.DatabaseErr))
+	}
+	if readiness.MigrationErr != nil {
+		logger.Warn("migrations are not ready", zap.String("op", op), zap.Error(readiness.MigrationErr))
+	}
+
+	resp := readinessResponse{
+		Status:       checkStatus(readiness.Ready()),
+		ShuttingDown: readiness.ShuttingDown,
+		Database:     checkStatus(readiness.DatabaseErr == nil),
+		Migrations: migrationsResponse{
+			Status:   checkStatus(readiness.DatabaseErr == nil && readiness.MigrationsApplied()),
+			Expected: readiness.ExpectedMigrationVersion,
+			Applied:  readiness.AppliedMigrationVersion,
+		},
+		Pool: poolResponse{
+			AcquiredConns: readiness.Pool.AcquiredConns,
+			IdleConns:     readiness.Pool.IdleConns,
+			TotalConns:    readiness.Pool.TotalConns,
+			MaxConns:      readiness.Pool.MaxConns,
+			Saturation:    readiness.Pool.Saturation(),
+		},
+	}
+
+	status := http.StatusOK
+	if !readiness.Ready() {
+		status = http.StatusServiceUnavailable
+	}
+
+	render.Status(r, status)
+	render.JSON(w, r, resp)
+}
+
+func checkStatus(ok bool) string {
+	if ok {
+		return statusOK
+	}
+
+	return statusUnavailable
+}
@@ -5,29 +5,50 @@ import "net/http"
 type ErrorCode string
 
 const (
-	CodeBadRequest         ErrorCode = "BAD_REQUEST"
-	CodeInternal           ErrorCode = "INTERNAL"
-	CodeTeamExists         ErrorCode = "TEAM_EXISTS"
-	CodeNotFound           ErrorCode = "NOT_FOUND"
-	CodePrExists           ErrorCode = "PR_EXISTS"
-	CodePrMerged           ErrorCode = "PR_MERGED"
-	CodeInvalidCredentials ErrorCode = "INVALID_CREDENTIALS"
-	CodeAdminExists        ErrorCode = "ADMIN_EXISTS"
-	CodeUnauthorized       ErrorCode = "UNAUTHORIZED"
-	CodeNotAssigned        ErrorCode = "NOT_ASSIGNED"
-	CodeNoCandidate        ErrorCode = "NO_CANDIDATE"
+	CodeBadRequest              ErrorCode = "BAD_REQUEST"
+	CodeInternal                ErrorCode = "INTERNAL"
+	CodeTeamExists              ErrorCode = "TEAM_EXISTS"
+	CodeNotFound                ErrorCode = "NOT_FOUND"
+	CodePrExists                ErrorCode = "PR_EXISTS"
+	CodePrMerged                ErrorCode = "PR_MERGED"
+	CodeInvalidCredentials      ErrorCode = "INVALID_CREDENTIALS"
+	CodeAdminExists             ErrorCode = "ADMIN_EXISTS"
+	CodeUnauthorized            ErrorCode = "UNAUTHORIZED"
+	CodeNotAssigned             ErrorCode = "NOT_ASSIGNED"
+	CodeNoCandidate             ErrorCode = "NO_CANDIDATE"
+	CodeAlreadyMember           ErrorCode = "ALREADY_MEMBER"
+	CodeNotMember               ErrorCode = "NOT_MEMBER"
+	CodeLoginNotMapped          ErrorCode = "LOGIN_NOT_MAPPED"
+	CodeForbidden               ErrorCode = "FORBIDDEN"
+	CodeConflict                ErrorCode = "CONFLICT"
+	CodeIdempotencyKeyReused    ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	CodeReviewerNotInTeam       ErrorCode = "REVIEWER_NOT_IN_TEAM"
+	CodeReviewerIsAuthor        ErrorCode = "REVIEWER_IS_AUTHOR"
+	CodeReviewerAlreadyAssigned ErrorCode = "REVIEWER_ALREADY_ASSIGNED"
+	CodeReviewerInactive        ErrorCode = "REVIEWER_INACTIVE"
+	CodeTooManyReviewers        ErrorCode = "TOO_MANY_REVIEWERS"
+	CodeInvalidReviewersCount   ErrorCode = "INVALID_REVIEWERS_COUNT"
+	CodePayloadTooLarge         ErrorCode = "PAYLOAD_TOO_LARGE"
+	CodeUsersHaveHistory        ErrorCode = "USERS_HAVE_HISTORY"
 )
 
 func (c ErrorCode) HTTPStatus() int {
 	switch c {
-	case CodeBadRequest, CodeTeamExists:
+	case CodeBadRequest, CodeTeamExists, CodeTooManyReviewers, CodeInvalidReviewersCount:
 		return http.StatusBadRequest
-	case CodeNotFound, CodeNotAssigned:
+	case CodeNotFound, CodeNotAssigned, CodeNotMember, CodeLoginNotMapped:
 		return http.StatusNotFound
 	case CodeUnauthorized, CodeInvalidCredentials:
 		return http.StatusUnauthorized
+	case CodeForbidden:
+		return http.StatusForbidden
+	case CodeIdempotencyKeyReused:
+		return http.StatusUnprocessableEntity
+	case CodePayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
 	case CodePrExists, CodePrMerged,
-		CodeNoCandidate, CodeAdminExists:
+		CodeNoCandidate, CodeAdminExists, CodeAlreadyMember, CodeConflict, CodeUsersHaveHistory,
+		CodeReviewerNotInTeam, CodeReviewerIsAuthor, CodeReviewerAlreadyAssigned, CodeReviewerInactive:
 		return http.StatusConflict
 	default:
 		return http.StatusInternalServerError
@@ -56,6 +77,34 @@ func (c ErrorCode) DefaultMessage() string {
 		return "reviewer not assigned"
 	case CodeNoCandidate:
 		return "no candidate to reassign"
+	case CodeAlreadyMember:
+		return "user is already a team member"
+	case CodeNotMember:
+		return "user is not a team member"
+	case CodeLoginNotMapped:
+		return "git login is not mapped to a user"
+	case CodeForbidden:
+		return "access denied"
+	case CodeConflict:
+		return "resource was modified concurrently, retry the request"
+	case CodeIdempotencyKeyReused:
+		return "idempotency key is reused with a different request"
+	case CodeReviewerNotInTeam:
+		return "reviewer is not a member of the team"
+	case CodeReviewerIsAuthor:
+		return "author cannot review own pull request"
+	case CodeReviewerAlreadyAssigned:
+		return "reviewer is already assigned"
+	case CodeReviewerInactive:
+		return "reviewer is inactive"
+	case CodeTooManyReviewers:
+		return "too many preferred reviewers"
+	case CodeInvalidReviewersCount:
+		return "reviewers count is out of the team range"
+	case CodePayloadTooLarge:
+		return "request body is too large"
+	case CodeUsersHaveHistory:
+		return "users have merged pull requests"
 	default:
 		return "internal server error"
 	}
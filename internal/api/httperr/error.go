@@ -31,6 +31,7 @@ func WriteError(
 	msg ...string,
 ) {
 	errResp := NewError(code, msg...)
+	recordCode(r.Context(), code)
 
 	render.Status(r, code.HTTPStatus())
 	render.JSON(w, r, errResp)
@@ -0,0 +1,23 @@
+package httperr
+
+import "context"
+
+type codeRecorderKey struct{}
+
+// ContextWithCodeRecorder returns a context in which WriteError stores
+// the written code, so that middlewares can observe it after the handler.
+// Nested middlewares share the recorder of the outermost one.
+func ContextWithCodeRecorder(ctx context.Context) (context.Context, *ErrorCode) {
+	if code, ok := ctx.Value(codeRecorderKey{}).(*ErrorCode); ok {
+		return ctx, code
+	}
+
+	code := new(ErrorCode)
+	return context.WithValue(ctx, codeRecorderKey{}, code), code
+}
+
+func recordCode(ctx context.Context, code ErrorCode) {
+	if recorded, ok := ctx.Value(codeRecorderKey{}).(*ErrorCode); ok {
+		*recorded = code
+	}
+}
@@ -0,0 +1,230 @@
+package idempotency_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/idempotency"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	idempotencyservice "github.com/hizu77/avito-autumn-2025/internal/service/idempotency"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	idempotencystorage "github.com/hizu77/avito-autumn-2025/internal/storage/idempotency/memory"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testKey   = "key-1"
+	callerA   = "admin-a"
+	callerB   = "admin-b"
+	callerHdr = "X-Test-Caller"
+)
+
+// newRouter serves /pullRequest/create, counting the calls. The status
+// of the response is taken from the body, the caller from a header.
+// A body naming an error code is answered with that error.
+func newRouter(calls *atomic.Int32) *chi.Mux {
+	service := idempotencyservice.New(
+		idempotencystorage.New(memory.New()),
+		idempotencyservice.Config{TTL: time.Hour, LockTimeout: time.Minute},
+	)
+
+	router := chi.NewRouter()
+	router.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := model.ContextWithPrincipal(r.Context(), model.Principal{
+				ID:   r.Header.Get(callerHdr),
+				Role: model.RoleAdmin,
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	})
+	router.Use(idempotency.Middleware(service, zap.NewNop()))
+	router.Post("/pullRequest/create", func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+
+		var body bytes.Buffer
+		_, _ = body.ReadFrom(r.Body)
+		status, err := strconv.Atoi(body.String())
+		if err != nil {
+			httperr.WriteError(w, r, httperr.ErrorCode(body.String()))
+			return
+		}
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(int(n)) + `}`))
+	})
+
+	return router
+}
+
+func send(router http.Handler, caller string, key string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewBufferString(body))
+	req.Header.Set(callerHdr, caller)
+	if key != "" {
+		req.Header.Set(idempotency.HeaderIdempotencyKey, key)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		caller string
+		key    string
+		body   string
+	}
+
+	tests := []struct {
+		name         string
+		requests     []request
+		wantCalls    int32
+		wantStatus   int
+		wantBody     string
+		wantReplayed bool
+	}{
+		{
+			name: "retry is replayed",
+			requests: []request{
+				{caller: callerA, key: testKey, body: "201"},
+				{caller: callerA, key: testKey, body: "201"},
+			},
+			wantCalls:    1,
+			wantStatus:   http.StatusCreated,
+			wantBody:     `{"call":1}`,
+			wantReplayed: true,
+		},
+		{
+			name: "client errors are replayed",
+			requests: []request{
+				{caller: callerA, key: testKey, body: "409"},
+				{caller: callerA, key: testKey, body: "409"},
+			},
+			wantCalls:    1,
+			wantStatus:   http.StatusConflict,
+			wantBody:     `{"call":1}`,
+			wantReplayed: true,
+		},
+		{
+			name: "reused key with another body is rejected",
+			requests: []request{
+				{caller: callerA, key: testKey, body: "201"},
+				{caller: callerA, key: testKey, body: "200"},
+			},
+			wantCalls:  1,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody:   `{"error":{"code":"IDEMPOTENCY_KEY_REUSED","message":"idempotency key is reused with a different request"}}`,
+		},
+		{
+			name: "server errors are not stored",
+			requests: []request{
+				{caller: callerA, key: testKey, body: "500"},
+				{caller: callerA, key: testKey, body: "500"},
+			},
+			wantCalls:  2,
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   `{"call":2}`,
+		},
+		{
+			name: "concurrent modification conflicts are not stored",
+			requests: []request{
+				{caller: callerA, key: testKey, body: string(httperr.CodeConflict)},
+				{caller: callerA, key: testKey, body: string(httperr.CodeConflict)},
+			},
+			wantCalls:  2,
+			wantStatus: http.StatusConflict,
+			wantBody:   `{"error":{"code":"CONFLICT","message":"resource was modified concurrently, retry the request"}}`,
+		},
+		{
+			name: "keys are scoped by caller",
+			requests: []request{
+				{caller: callerA, key: testKey, body: "201"},
+				{caller: callerB, key: testKey, body: "201"},
+			},
+			wantCalls:  2,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"call":2}`,
+		},
+		{
+			name: "too large body is rejected",
+			requests: []request{
+				{caller: callerA, key: testKey, body: strings.Repeat("1", 1<<20+1)},
+			},
+			wantCalls:  0,
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantBody:   `{"error":{"code":"PAYLOAD_TOO_LARGE","message":"request body is too large"}}`,
+		},
+		{
+			name: "requests without a key are served",
+			requests: []request{
+				{caller: callerA, body: "201"},
+				{caller: callerA, body: "201"},
+			},
+			wantCalls:  2,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"call":2}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			router := newRouter(&calls)
+
+			var rec *httptest.ResponseRecorder
+			for _, req := range tt.requests {
+				rec = send(router, req.caller, req.key, req.body)
+			}
+
+			require.Equal(t, tt.wantCalls, calls.Load())
+			require.Equal(t, tt.wantStatus, rec.Code)
+			require.JSONEq(t, tt.wantBody, rec.Body.String())
+			require.Equal(t, tt.wantReplayed, rec.Header().Get(idempotency.HeaderIdempotentReplayed) == "true")
+		})
+	}
+}
+
+func TestMiddleware_PanicReleasesKey(t *testing.T) {
+	t.Parallel()
+
+	service := idempotencyservice.New(
+		idempotencystorage.New(memory.New()),
+		idempotencyservice.Config{TTL: time.Hour, LockTimeout: time.Minute},
+	)
+
+	var calls atomic.Int32
+	handler := idempotency.Middleware(service, zap.NewNop())(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls.Add(1) == 1 {
+			panic("boom")
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	newRequest := func() *http.Request {
+		req := httptest.NewRequest(http.MethodPost, "/team/add", bytes.NewBufferString(`{}`))
+		req.Header.Set(idempotency.HeaderIdempotencyKey, testKey)
+		return req.WithContext(model.ContextWithPrincipal(req.Context(), model.Principal{ID: callerA}))
+	}
+
+	require.Panics(t, func() { handler.ServeHTTP(httptest.NewRecorder(), newRequest()) })
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newRequest())
+	require.Equal(t, http.StatusCreated, rec.Code)
+	require.Equal(t, int32(2), calls.Load())
+}
@@ -0,0 +1,143 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxKeyLength = 255
+	maxBodySize  = 1 << 20
+)
+
+type keeper interface {
+	Begin(ctx context.Context, callerID string, key string, requestHash string) (*model.IdempotentResponse, error)
+	Complete(ctx context.Context, callerID string, key string, response model.IdempotentResponse) error
+	Abort(ctx context.Context, callerID string, key string) error
+}
+
+// Middleware replays the first response to a request carrying the
+// Idempotency-Key header for every retry with the same key, instead of
+// serving it again. Keys are scoped by the caller, a key reused with
+// another method, path or body is rejected. Server errors and concurrent
+// modification conflicts are not stored, so that a retry is served again.
+// The body is read in full to be hashed, so it is limited to maxBodySize.
+// Requests without the header pass through. It must be used after the
+// authentication middlewares.
+func Middleware(keeper keeper, logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			const op = "idempotency.Middleware"
+
+			key := r.Header.Get(HeaderIdempotencyKey)
+			principal, ok := model.PrincipalFromContext(r.Context())
+			if key == "" || !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			logger := logging.FromContext(r.Context(), logger).With(zap.String("op", op))
+
+			if len(key) > maxKeyLength {
+				httperr.WriteError(w, r, httperr.CodeBadRequest, "idempotency key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					logger.Warn("request body is too large", zap.Int64("limit", maxBytesErr.Limit))
+					httperr.WriteError(w, r, httperr.CodePayloadTooLarge)
+					return
+				}
+
+				httperr.WriteError(w, r, httperr.CodeBadRequest, "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			replay, err := keeper.Begin(r.Context(), principal.ID, key, hashRequest(r, body))
+			switch {
+			case errors.Is(err, model.ErrIdempotencyKeyReused):
+				httperr.WriteError(w, r, httperr.CodeIdempotencyKeyReused)
+				return
+			case errors.Is(err, model.ErrIdempotencyKeyInProgress):
+				httperr.WriteError(w, r, httperr.CodeConflict, "request with this idempotency key is in progress")
+				return
+			case err != nil:
+				logger.Error("failed to begin idempotent request", zap.Error(err))
+				httperr.WriteError(w, r, httperr.CodeInternal)
+				return
+			}
+
+			if replay != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set(HeaderIdempotentReplayed, "true")
+				w.WriteHeader(replay.StatusCode)
+				_, _ = w.Write(replay.Body)
+				return
+			}
+
+			// The outcome is stored even if the client has gone away,
+			// that is the case a retry comes for.
+			ctx := context.WithoutCancel(r.Context())
+			abort := func() {
+				if err := keeper.Abort(ctx, principal.ID, key); err != nil {
+					logger.Error("failed to abort idempotent request", zap.Error(err))
+				}
+			}
+
+			var buf bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&buf)
+
+			served := false
+			defer func() {
+				if !served {
+					abort()
+				}
+			}()
+
+			recordedCtx, code := httperr.ContextWithCodeRecorder(r.Context())
+			next.ServeHTTP(ww, r.WithContext(recordedCtx))
+			served = true
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError || *code == httperr.CodeConflict {
+				abort()
+				return
+			}
+
+			response := model.IdempotentResponse{StatusCode: status, Body: buf.Bytes()}
+			if err := keeper.Complete(ctx, principal.ID, key, response); err != nil {
+				logger.Error("failed to complete idempotent request", zap.Error(err))
+			}
+		})
+	}
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
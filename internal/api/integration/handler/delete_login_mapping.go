@@ -0,0 +1,72 @@
+package integration
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/response"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) DeleteLoginMapping(w http.ResponseWriter, r *http.Request) {
+	const op = "integration.DeleteLoginMapping"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var deleteRequest request.DeleteLoginMapping
+	if err := render.DecodeJSON(r.Body, &deleteRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	provider, err := validateDeleteLoginMappingRequest(deleteRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if err = h.service.DeleteLoginMapping(ctx, provider, deleteRequest.Login); err != nil {
+		logger.Error("deleting login mapping",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainIntegrationErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response.DeleteLoginMapping{
+		Provider: provider.String(),
+		Login:    deleteRequest.Login,
+	})
+}
+
+func validateDeleteLoginMappingRequest(req request.DeleteLoginMapping) (model.GitProvider, error) {
+	provider, err := model.ParseGitProvider(req.Provider)
+	if err != nil {
+		return "", errors.New("unknown provider")
+	}
+
+	if req.Login == "" {
+		return "", errors.New("login is required")
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	githubPullRequestEvent = "pull_request"
+)
+
+func (h *Handler) GitHubWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "integration.GitHubWebhook"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	body, ok := readWebhookBody(w, r, op, logger)
+	if !ok {
+		return
+	}
+
+	if !verifyGitHubSignature(h.githubSecret, r.Header.Get(githubSignatureHeader), body) {
+		logger.Warn("invalid signature", zap.String("op", op))
+
+		httperr.WriteError(w, r, httperr.CodeUnauthorized, "invalid signature")
+		return
+	}
+
+	if r.Header.Get(githubEventHeader) != githubPullRequestEvent {
+		writeIgnored(w, r)
+		return
+	}
+
+	var eventRequest request.GitHubPullRequestEvent
+	if err := json.Unmarshal(body, &eventRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	event, err := mapRequestGitHubEventToDomainEvent(eventRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, op, event)
+}
@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	gitlabMergeRequestEvent = "Merge Request Hook"
+)
+
+func (h *Handler) GitLabWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "integration.GitLabWebhook"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	if !verifyGitLabToken(h.gitlabToken, r.Header.Get(gitlabTokenHeader)) {
+		logger.Warn("invalid token", zap.String("op", op))
+
+		httperr.WriteError(w, r, httperr.CodeUnauthorized, "invalid token")
+		return
+	}
+
+	if r.Header.Get(gitlabEventHeader) != gitlabMergeRequestEvent {
+		writeIgnored(w, r)
+		return
+	}
+
+	body, ok := readWebhookBody(w, r, op, logger)
+	if !ok {
+		return
+	}
+
+	var eventRequest request.GitLabMergeRequestEvent
+	if err := json.Unmarshal(body, &eventRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	event, err := mapRequestGitLabEventToDomainEvent(eventRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, op, event)
+}
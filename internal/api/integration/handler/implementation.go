@@ -0,0 +1,36 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+type service interface {
+	HandlePullRequestEvent(ctx context.Context, event model.GitPullRequestEvent) (model.PullRequest, error)
+	SetLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error)
+	ListLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error)
+	DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error
+}
+
+type Handler struct {
+	service      service
+	githubSecret string
+	gitlabToken  string
+	logger       *zap.Logger
+}
+
+func New(
+	service service,
+	githubSecret string,
+	gitlabToken string,
+	logger *zap.Logger,
+) *Handler {
+	return &Handler{
+		service:      service,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+		logger:       logger,
+	}
+}
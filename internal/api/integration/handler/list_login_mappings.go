@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+const (
+	providerQueryParam = "provider"
+)
+
+func (h *Handler) ListLoginMappings(w http.ResponseWriter, r *http.Request) {
+	const op = "integration.ListLoginMappings"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var provider *model.GitProvider
+	if raw := r.URL.Query().Get(providerQueryParam); raw != "" {
+		parsed, err := model.ParseGitProvider(raw)
+		if err != nil {
+			logger.Error("parsing provider",
+				zap.String("op", op),
+				zap.Error(err),
+			)
+
+			httperr.WriteError(w, r, httperr.CodeBadRequest, "unknown provider")
+			return
+		}
+		provider = &parsed
+	}
+
+	ctx := r.Context()
+	mappings, err := h.service.ListLoginMappings(ctx, provider)
+	if err != nil {
+		logger.Error("listing login mappings",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainIntegrationErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mapDomainMappingsToResponseListLoginMappings(mappings))
+}
@@ -0,0 +1,136 @@
+package integration
+
+import (
+	"strconv"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/response"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+const (
+	githubActionOpened = "opened"
+	githubActionClosed = "closed"
+
+	gitlabObjectKindMergeRequest = "merge_request"
+	gitlabActionOpen             = "open"
+	gitlabActionMerge            = "merge"
+)
+
+// mapRequestGitHubEventToDomainEvent returns nil for actions the service ignores.
+// Pull requests are identified as "owner/repo#number".
+func mapRequestGitHubEventToDomainEvent(req request.GitHubPullRequestEvent) (*model.GitPullRequestEvent, error) {
+	var action model.GitPullRequestAction
+	switch {
+	case req.Action == githubActionOpened:
+		action = model.GitPullRequestActionOpened
+	case req.Action == githubActionClosed && req.PullRequest.Merged:
+		action = model.GitPullRequestActionMerged
+	default:
+		return nil, nil
+	}
+
+	if req.Repository.FullName == "" || req.PullRequest.Number <= 0 {
+		return nil, errors.New("repository.full_name and pull_request.number are required")
+	}
+	if action == model.GitPullRequestActionOpened && req.PullRequest.User.Login == "" {
+		return nil, errors.New("pull_request.user.login is required")
+	}
+
+	return &model.GitPullRequestEvent{
+		Provider:        model.GitProviderGithub,
+		Action:          action,
+		PullRequestID:   req.Repository.FullName + "#" + strconv.FormatInt(req.PullRequest.Number, 10),
+		PullRequestName: req.PullRequest.Title,
+		AuthorLogin:     req.PullRequest.User.Login,
+	}, nil
+}
+
+// mapRequestGitLabEventToDomainEvent returns nil for actions the service ignores.
+// Merge requests are identified as "group/project!iid".
+// GitLab reports the user who triggered the event, which is the author when a merge request is opened.
+func mapRequestGitLabEventToDomainEvent(req request.GitLabMergeRequestEvent) (*model.GitPullRequestEvent, error) {
+	if req.ObjectKind != gitlabObjectKindMergeRequest {
+		return nil, nil
+	}
+
+	var action model.GitPullRequestAction
+	switch req.ObjectAttributes.Action {
+	case gitlabActionOpen:
+		action = model.GitPullRequestActionOpened
+	case gitlabActionMerge:
+		action = model.GitPullRequestActionMerged
+	default:
+		return nil, nil
+	}
+
+	if req.Project.PathWithNamespace == "" || req.ObjectAttributes.IID <= 0 {
+		return nil, errors.New("project.path_with_namespace and object_attributes.iid are required")
+	}
+	if action == model.GitPullRequestActionOpened && req.User.Username == "" {
+		return nil, errors.New("user.username is required")
+	}
+
+	return &model.GitPullRequestEvent{
+		Provider:        model.GitProviderGitlab,
+		Action:          action,
+		PullRequestID:   req.Project.PathWithNamespace + "!" + strconv.FormatInt(req.ObjectAttributes.IID, 10),
+		PullRequestName: req.ObjectAttributes.Title,
+		AuthorLogin:     req.User.Username,
+	}, nil
+}
+
+func mapDomainEventToResponseGitEvent(event model.GitPullRequestEvent) response.GitEvent {
+	action := event.Action.String()
+
+	return response.GitEvent{
+		Status:        response.GitEventStatusProcessed,
+		Action:        &action,
+		PullRequestID: &event.PullRequestID,
+	}
+}
+
+func mapRequestSetLoginMappingToDomainMapping(req request.SetLoginMapping) (model.GitLoginMapping, error) {
+	provider, err := model.ParseGitProvider(req.Provider)
+	if err != nil {
+		return model.GitLoginMapping{}, errors.New("unknown provider")
+	}
+
+	return model.GitLoginMapping{
+		Provider: provider,
+		Login:    req.Login,
+		UserID:   req.UserID,
+	}, nil
+}
+
+func mapDomainMappingToResponseLoginMapping(mapping model.GitLoginMapping) response.LoginMapping {
+	return response.LoginMapping{
+		Provider: mapping.Provider.String(),
+		Login:    mapping.Login,
+		UserID:   mapping.UserID,
+	}
+}
+
+func mapDomainMappingsToResponseListLoginMappings(mappings []model.GitLoginMapping) response.ListLoginMappings {
+	return response.ListLoginMappings{
+		Mappings: collection.Map(mappings, mapDomainMappingToResponseLoginMapping),
+	}
+}
+
+func mapDomainIntegrationErrorToCode(err error) httperr.ErrorCode {
+	switch {
+	case errors.Is(err, model.ErrGitLoginNotMapped):
+		return httperr.CodeLoginNotMapped
+	case errors.Is(err, model.ErrPullRequestDoesNotExist),
+		errors.Is(err, model.ErrUserDoesNotExist),
+		errors.Is(err, model.ErrTeamDoesNotExist):
+		return httperr.CodeNotFound
+	case errors.Is(err, model.ErrPullRequestConflict):
+		return httperr.CodeConflict
+	default:
+		return httperr.CodeInternal
+	}
+}
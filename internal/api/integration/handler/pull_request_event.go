@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/response"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	maxWebhookBodySize = 5 << 20
+)
+
+// handlePullRequestEvent passes a mapped event to the service.
+// A nil event is an action the service does not react to.
+func (h *Handler) handlePullRequestEvent(
+	w http.ResponseWriter,
+	r *http.Request,
+	op string,
+	event *model.GitPullRequestEvent,
+) {
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	if event == nil {
+		writeIgnored(w, r)
+		return
+	}
+
+	ctx := r.Context()
+	if _, err := h.service.HandlePullRequestEvent(ctx, *event); err != nil {
+		logger.Error("handling pull request event",
+			zap.String("op", op),
+			zap.String("pull_request_id", event.PullRequestID),
+			zap.String("action", event.Action.String()),
+			zap.Error(err),
+		)
+
+		code := mapDomainIntegrationErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mapDomainEventToResponseGitEvent(*event))
+}
+
+func writeIgnored(w http.ResponseWriter, r *http.Request) {
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response.GitEvent{Status: response.GitEventStatusIgnored})
+}
+
+// readWebhookBody reads at most maxWebhookBodySize bytes of the body and
+// writes the error response if it fails. A larger body is rejected with
+// 413 instead of being cut, which would only surface as a bad signature
+// or broken JSON.
+func readWebhookBody(w http.ResponseWriter, r *http.Request, op string, logger *zap.Logger) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err == nil {
+		return body, true
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		logger.Warn("request body is too large",
+			zap.String("op", op),
+			zap.Int64("limit", maxBytesErr.Limit),
+		)
+
+		httperr.WriteError(w, r, httperr.CodePayloadTooLarge)
+		return nil, false
+	}
+
+	logger.Error("reading request body",
+		zap.String("op", op),
+		zap.Error(err),
+	)
+
+	httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid body")
+	return nil, false
+}
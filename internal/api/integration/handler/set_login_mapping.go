@@ -0,0 +1,81 @@
+package integration
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/integration/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) SetLoginMapping(w http.ResponseWriter, r *http.Request) {
+	const op = "integration.SetLoginMapping"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var setRequest request.SetLoginMapping
+	if err := render.DecodeJSON(r.Body, &setRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateSetLoginMappingRequest(setRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	mapping, err := mapRequestSetLoginMappingToDomainMapping(setRequest)
+	if err != nil {
+		logger.Error("mapping request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	saved, err := h.service.SetLoginMapping(ctx, mapping)
+	if err != nil {
+		logger.Error("setting login mapping",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainIntegrationErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mapDomainMappingToResponseLoginMapping(saved))
+}
+
+func validateSetLoginMappingRequest(req request.SetLoginMapping) error {
+	if req.Provider == "" {
+		return errors.New("provider is required")
+	}
+
+	if req.Login == "" {
+		return errors.New("login is required")
+	}
+
+	if req.UserID == "" {
+		return errors.New("user_id is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package integration
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	githubSignatureHeader = "X-Hub-Signature-256"
+	githubEventHeader     = "X-GitHub-Event"
+	gitlabTokenHeader     = "X-Gitlab-Token"
+	gitlabEventHeader     = "X-Gitlab-Event"
+
+	githubSignaturePrefix = "sha256="
+)
+
+// verifyGitHubSignature checks the "sha256=<hex HMAC-SHA256 of body>" header
+// GitHub sends when the webhook has a secret.
+func verifyGitHubSignature(secret string, signature string, body []byte) bool {
+	if secret == "" || !strings.HasPrefix(signature, githubSignaturePrefix) {
+		return false
+	}
+
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, githubSignaturePrefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// verifyGitLabToken checks the secret token GitLab sends as is.
+func verifyGitLabToken(secret string, token string) bool {
+	if secret == "" {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(token)) == 1
+}
@@ -0,0 +1,6 @@
+package request
+
+type DeleteLoginMapping struct {
+	Provider string `json:"provider"`
+	Login    string `json:"login"`
+}
@@ -0,0 +1,24 @@
+package request
+
+// GitHubPullRequestEvent is the part of the GitHub "pull_request" webhook payload
+// the service reads.
+type GitHubPullRequestEvent struct {
+	Action      string            `json:"action"`
+	PullRequest GitHubPullRequest `json:"pull_request"`
+	Repository  GitHubRepository  `json:"repository"`
+}
+
+type GitHubPullRequest struct {
+	Number int64      `json:"number"`
+	Title  string     `json:"title"`
+	Merged bool       `json:"merged"`
+	User   GitHubUser `json:"user"`
+}
+
+type GitHubUser struct {
+	Login string `json:"login"`
+}
+
+type GitHubRepository struct {
+	FullName string `json:"full_name"`
+}
@@ -0,0 +1,24 @@
+package request
+
+// GitLabMergeRequestEvent is the part of the GitLab "Merge Request Hook" payload
+// the service reads.
+type GitLabMergeRequestEvent struct {
+	ObjectKind       string                   `json:"object_kind"`
+	User             GitLabUser               `json:"user"`
+	Project          GitLabProject            `json:"project"`
+	ObjectAttributes GitLabMergeRequestObject `json:"object_attributes"`
+}
+
+type GitLabUser struct {
+	Username string `json:"username"`
+}
+
+type GitLabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+type GitLabMergeRequestObject struct {
+	IID    int64  `json:"iid"`
+	Title  string `json:"title"`
+	Action string `json:"action"`
+}
@@ -0,0 +1,12 @@
+package response
+
+const (
+	GitEventStatusProcessed = "processed"
+	GitEventStatusIgnored   = "ignored"
+)
+
+type GitEvent struct {
+	Status        string  `json:"status"`
+	Action        *string `json:"action,omitempty"`
+	PullRequestID *string `json:"pull_request_id,omitempty"`
+}
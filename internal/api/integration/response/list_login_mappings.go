@@ -0,0 +1,5 @@
+package response
+
+type ListLoginMappings struct {
+	Mappings []LoginMapping `json:"mappings"`
+}
@@ -0,0 +1,7 @@
+package response
+
+type LoginMapping struct {
+	Provider string `json:"provider"`
+	Login    string `json:"login"`
+	UserID   string `json:"user_id"`
+}
@@ -0,0 +1,30 @@
+package jwks
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+)
+
+const cacheControl = "public, max-age=300"
+
+type Handler struct {
+	keys jwk.Set
+}
+
+func New(keys jwk.Set) *Handler {
+	return &Handler{
+		keys: keys,
+	}
+}
+
+// PublicKeys serves the keys access tokens can be verified with.
+// Consumers may cache them for a short time, so a new key should be published
+// as a verification key before it starts signing tokens.
+func (h *Handler) PublicKeys(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", cacheControl)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, h.keys)
+}
@@ -0,0 +1,54 @@
+package logging
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// AccessLog writes a record per request with its route, status, error code,
+// latency and caller, and attaches the request logger to the context.
+// It must be used after RequestID.
+func AccessLog(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+
+			requestLogger := logger.With(zap.String("request_id", RequestIDFromContext(r.Context())))
+			if spanCtx := trace.SpanContextFromContext(r.Context()); spanCtx.IsValid() {
+				requestLogger = requestLogger.With(zap.String("trace_id", spanCtx.TraceID().String()))
+			}
+
+			ctx, e := contextWithEntry(r.Context(), requestLogger)
+			ctx, code := httperr.ContextWithCodeRecorder(ctx)
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r.WithContext(ctx))
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.String("route", chi.RouteContext(ctx).RoutePattern()),
+				zap.Int("status", status),
+				zap.Int("bytes", ww.BytesWritten()),
+				zap.Duration("latency", time.Since(start)),
+			}
+			if *code != "" {
+				fields = append(fields, zap.String("code", string(*code)))
+			}
+
+			// The caller fields are already part of the request logger.
+			e.logger.Info("http request", fields...)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+type entryKey struct{}
+
+// entry is shared by the middlewares of a request,
+// so that inner ones can enrich what the access log writes.
+type entry struct {
+	logger *zap.Logger
+}
+
+func contextWithEntry(ctx context.Context, logger *zap.Logger) (context.Context, *entry) {
+	e := &entry{logger: logger}
+	return context.WithValue(ctx, entryKey{}, e), e
+}
+
+// FromContext returns the logger of the request, which tags every record
+// with the request ID and the caller, or fallback outside of a request.
+func FromContext(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if e, ok := ctx.Value(entryKey{}).(*entry); ok {
+		return e.logger
+	}
+
+	return fallback
+}
+
+// SetCaller records the authenticated caller of the request
+// in the access log and in the request logger.
+func SetCaller(ctx context.Context, principal model.Principal) {
+	e, ok := ctx.Value(entryKey{}).(*entry)
+	if !ok {
+		return
+	}
+
+	e.logger = e.logger.With(callerFields(principal)...)
+}
+
+func callerFields(principal model.Principal) []zap.Field {
+	return []zap.Field{
+		zap.String("caller_id", principal.ID),
+		zap.String("caller_role", principal.Role.String()),
+		zap.Bool("caller_api_key", principal.APIKey),
+	}
+}
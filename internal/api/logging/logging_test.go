@@ -0,0 +1,113 @@
+package logging_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+const testRequestID = "req-1"
+
+func newRouter(logger *zap.Logger) *chi.Mux {
+	router := chi.NewRouter()
+	router.Use(logging.RequestID, logging.AccessLog(logger), logging.Recoverer)
+	router.Get("/team/get", func(w http.ResponseWriter, r *http.Request) {
+		logging.SetCaller(r.Context(), model.Principal{ID: "admin", Role: model.RoleAdmin})
+		logging.FromContext(r.Context(), zap.NewNop()).Info("handled", zap.String("op", "team.GetTeamByName"))
+		w.WriteHeader(http.StatusOK)
+	})
+	router.Post("/pullRequest/create", func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})
+
+	return router
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		requestID string
+		wantSame  bool
+	}{
+		{name: "propagated", requestID: testRequestID, wantSame: true},
+		{name: "generated", requestID: "", wantSame: false},
+		{name: "invalid replaced", requestID: "bad id\n", wantSame: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+			req.Header.Set(logging.HeaderRequestID, tt.requestID)
+			rec := httptest.NewRecorder()
+
+			newRouter(zap.NewNop()).ServeHTTP(rec, req)
+
+			got := rec.Header().Get(logging.HeaderRequestID)
+			require.NotEmpty(t, got)
+			if tt.wantSame {
+				require.Equal(t, tt.requestID, got)
+			} else {
+				require.NotEqual(t, tt.requestID, got)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil)
+	req.Header.Set(logging.HeaderRequestID, testRequestID)
+	newRouter(zap.New(core)).ServeHTTP(httptest.NewRecorder(), req)
+
+	handled := logs.FilterMessage("handled").All()
+	require.Len(t, handled, 1)
+	require.Equal(t, testRequestID, handled[0].ContextMap()["request_id"])
+	require.Equal(t, "admin", handled[0].ContextMap()["caller_id"])
+
+	access := logs.FilterMessage("http request").All()
+	require.Len(t, access, 1)
+	fields := access[0].ContextMap()
+	require.Equal(t, testRequestID, fields["request_id"])
+	require.Equal(t, "/team/get", fields["route"])
+	require.Equal(t, int64(http.StatusOK), fields["status"])
+	require.Equal(t, "admin", fields["caller_id"])
+	require.Equal(t, "ADMIN", fields["caller_role"])
+}
+
+func TestRecoverer(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	rec := httptest.NewRecorder()
+	newRouter(zap.New(core)).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil))
+
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	var body httperr.Error
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Equal(t, httperr.CodeInternal, body.Body.Code)
+
+	require.Len(t, logs.FilterMessage("handler panicked").All(), 1)
+
+	access := logs.FilterMessage("http request").All()
+	require.Len(t, access, 1)
+	require.Equal(t, string(httperr.CodeInternal), access[0].ContextMap()["code"])
+}
@@ -0,0 +1,37 @@
+package logging
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"go.uber.org/zap"
+)
+
+// Recoverer turns a panic of a handler into the INTERNAL error response
+// and logs it with the stack. It must be used after AccessLog.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+
+			// http.ErrAbortHandler aborts the response on purpose.
+			if err, ok := recovered.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(recovered)
+			}
+
+			FromContext(r.Context(), zap.L()).Error("handler panicked",
+				zap.String("panic", fmt.Sprint(recovered)),
+				zap.Stack("stack"),
+			)
+
+			httperr.WriteError(w, r, httperr.CodeInternal)
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/hizu77/avito-autumn-2025/internal/api/pull_requests/request"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,10 +13,11 @@ import (
 
 func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
 	const op = "pullrequest.CreatePullRequest"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var createPullRequestRequest request.CreatePullRequest
 	if err := render.DecodeJSON(r.Body, &createPullRequestRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +27,7 @@ func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateCreatePullRequestRequest(createPullRequestRequest); err != nil {
-		h.logger.Error("validate request",
+		logger.Error("validate request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -36,10 +38,11 @@ func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 	mappedPullRequest := mapRequestCreatePullRequestToDomainPullRequest(createPullRequestRequest)
+	mappedPreferences := mapRequestCreatePullRequestToDomainReviewerPreferences(createPullRequestRequest)
 
-	pullRequest, err := h.service.CreatePullRequest(ctx, mappedPullRequest)
+	pullRequest, err := h.service.CreatePullRequest(ctx, mappedPullRequest, mappedPreferences)
 	if err != nil {
-		h.logger.Error("creating pull request",
+		logger.Error("creating pull request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -68,5 +71,22 @@ func validateCreatePullRequestRequest(req request.CreatePullRequest) error {
 		return errors.New("author_id is required")
 	}
 
+	preferred := make(map[string]struct{}, len(req.PreferredReviewers))
+	for _, id := range req.PreferredReviewers {
+		if id == "" {
+			return errors.New("preferred_reviewers must not contain empty ids")
+		}
+		if _, ok := preferred[id]; ok {
+			return errors.Errorf("reviewer %q is preferred twice", id)
+		}
+		preferred[id] = struct{}{}
+	}
+
+	for _, id := range req.ExcludedReviewers {
+		if _, ok := preferred[id]; ok {
+			return errors.Errorf("reviewer %q is both preferred and excluded", id)
+		}
+	}
+
 	return nil
 }
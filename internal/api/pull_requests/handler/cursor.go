@@ -0,0 +1,49 @@
+package pullrequest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// cursor is the opaque next_cursor value handed to clients.
+type cursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+func encodeCursor(c model.PullRequestCursor) (string, error) {
+	raw, err := json.Marshal(cursor{
+		CreatedAt: c.CreatedAt,
+		ID:        c.ID,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "marshaling cursor")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodeCursor(encoded string) (model.PullRequestCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return model.PullRequestCursor{}, errors.Wrap(err, "decoding cursor")
+	}
+
+	var decoded cursor
+	if err = json.Unmarshal(raw, &decoded); err != nil {
+		return model.PullRequestCursor{}, errors.Wrap(err, "unmarshaling cursor")
+	}
+
+	if decoded.ID == "" || decoded.CreatedAt.IsZero() {
+		return model.PullRequestCursor{}, errors.New("incomplete cursor")
+	}
+
+	return model.PullRequestCursor{
+		CreatedAt: decoded.CreatedAt,
+		ID:        decoded.ID,
+	}, nil
+}
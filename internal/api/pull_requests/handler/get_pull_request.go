@@ -0,0 +1,45 @@
+package pullrequest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+	const op = "pullrequest.GetPullRequest"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	id := r.URL.Query().Get(idQueryParam)
+
+	if err := validatePullRequestID(id); err != nil {
+		logger.Error("validate pull request id",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	details, err := h.service.GetPullRequest(ctx, id)
+	if err != nil {
+		logger.Error("getting pull request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainPullRequestErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	pullRequestResponse := mapDomainPullRequestDetailsToResponseGetPullRequest(details)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, pullRequestResponse)
+}
@@ -0,0 +1,57 @@
+package pullrequest
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	idQueryParam = "pull_request_id"
+)
+
+func (h *Handler) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+	const op = "pullrequest.GetPullRequestHistory"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	id := r.URL.Query().Get(idQueryParam)
+
+	if err := validatePullRequestID(id); err != nil {
+		logger.Error("validate pull request id",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	events, err := h.service.GetPullRequestHistory(ctx, id)
+	if err != nil {
+		logger.Error("getting pull request history",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainPullRequestErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	historyResponse := mapDomainAssignmentEventsToResponseGetPullRequestHistory(id, events)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, historyResponse)
+}
+
+func validatePullRequestID(id string) error {
+	if id == "" {
+		return errors.New("invalid pull request id")
+	}
+	return nil
+}
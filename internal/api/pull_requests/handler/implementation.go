@@ -8,9 +8,21 @@ import (
 )
 
 type service interface {
-	CreatePullRequest(ctx context.Context, request model.PullRequest) (model.PullRequest, error)
+	CreatePullRequest(
+		ctx context.Context,
+		request model.PullRequest,
+		preferences model.ReviewerPreferences,
+	) (model.PullRequest, error)
 	MergePullRequest(ctx context.Context, id string) (model.PullRequest, error)
-	ReassignPullRequest(ctx context.Context, id string, reviewerID string) (model.ReassignedPullRequest, error)
+	ReassignPullRequest(
+		ctx context.Context,
+		id string,
+		reviewerID string,
+		newReviewerID *string,
+	) (model.ReassignedPullRequest, error)
+	GetPullRequestHistory(ctx context.Context, id string) ([]model.AssignmentEvent, error)
+	ListPullRequests(ctx context.Context, filter model.PullRequestFilter) (model.PullRequestPage, error)
+	GetPullRequest(ctx context.Context, id string) (model.PullRequestDetails, error)
 }
 
 type Handler struct {
@@ -0,0 +1,144 @@
+package pullrequest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	statusQueryParam     = "status"
+	authorIDQueryParam   = "author_id"
+	reviewerIDQueryParam = "reviewer_id"
+	teamNameQueryParam   = "team_name"
+	fromQueryParam       = "from"
+	toQueryParam         = "to"
+	cursorQueryParam     = "cursor"
+	limitQueryParam      = "limit"
+
+	maxListLimit = 100
+)
+
+func (h *Handler) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+	const op = "pullrequest.ListPullRequests"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	filter, err := parsePullRequestFilter(r.URL.Query())
+	if err != nil {
+		logger.Error("parsing filter",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	page, err := h.service.ListPullRequests(ctx, filter)
+	if err != nil {
+		logger.Error("listing pull requests",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainPullRequestErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	pageResponse, err := mapDomainPullRequestPageToResponseListPullRequests(page)
+	if err != nil {
+		logger.Error("mapping page",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeInternal)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, pageResponse)
+}
+
+func parsePullRequestFilter(query url.Values) (model.PullRequestFilter, error) {
+	var filter model.PullRequestFilter
+
+	if raw := query.Get(statusQueryParam); raw != "" {
+		status, err := model.ParseStatus(raw)
+		if err != nil {
+			return model.PullRequestFilter{}, errors.New("unknown status")
+		}
+		filter.Status = &status
+	}
+
+	if authorID := query.Get(authorIDQueryParam); authorID != "" {
+		filter.AuthorID = &authorID
+	}
+
+	if reviewerID := query.Get(reviewerIDQueryParam); reviewerID != "" {
+		filter.ReviewerID = &reviewerID
+	}
+
+	if teamName := query.Get(teamNameQueryParam); teamName != "" {
+		filter.TeamName = &teamName
+	}
+
+	from, err := parseTimeQueryParam(query, fromQueryParam)
+	if err != nil {
+		return model.PullRequestFilter{}, err
+	}
+
+	to, err := parseTimeQueryParam(query, toQueryParam)
+	if err != nil {
+		return model.PullRequestFilter{}, err
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return model.PullRequestFilter{}, errors.New("from must be before to")
+	}
+
+	filter.CreatedFrom = from
+	filter.CreatedTo = to
+
+	if raw := query.Get(cursorQueryParam); raw != "" {
+		after, err := decodeCursor(raw)
+		if err != nil {
+			return model.PullRequestFilter{}, errors.New("invalid cursor")
+		}
+		filter.After = &after
+	}
+
+	if raw := query.Get(limitQueryParam); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxListLimit {
+			return model.PullRequestFilter{}, errors.Errorf("limit must be between 1 and %d", maxListLimit)
+		}
+		filter.Limit = limit
+	}
+
+	return filter, nil
+}
+
+func parseTimeQueryParam(query url.Values, key string) (*time.Time, error) {
+	raw := query.Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, errors.Errorf("%s must be RFC3339 timestamp", key)
+	}
+
+	return &parsed, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hizu77/avito-autumn-2025/internal/api/pull_requests/request"
 	"github.com/hizu77/avito-autumn-2025/internal/api/pull_requests/response"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
 	"github.com/pkg/errors"
 )
 
@@ -18,13 +19,24 @@ func mapRequestCreatePullRequestToDomainPullRequest(req request.CreatePullReques
 	}
 }
 
+func mapRequestCreatePullRequestToDomainReviewerPreferences(
+	req request.CreatePullRequest,
+) model.ReviewerPreferences {
+	return model.ReviewerPreferences{
+		Count:     req.ReviewersCount,
+		Preferred: req.PreferredReviewers,
+		Excluded:  req.ExcludedReviewers,
+	}
+}
+
 func mapDomainPullRequestToResponsePullRequest(req model.PullRequest) response.PullRequest {
 	return response.PullRequest{
-		ID:        req.ID,
-		Name:      req.Name,
-		AuthorID:  req.AuthorID,
-		Status:    req.Status,
-		Reviewers: req.ReviewersIDs,
+		ID:                req.ID,
+		Name:              req.Name,
+		AuthorID:          req.AuthorID,
+		Status:            req.Status,
+		Reviewers:         req.ReviewersIDs,
+		NeedMoreReviewers: req.NeedMoreReviewers,
 	}
 }
 
@@ -44,12 +56,13 @@ func mapDomainPullRequestToResponseMergedPullRequest(
 		mergedAt = *req.MergedAt
 	}
 	return response.MergedPullRequest{
-		ID:        req.ID,
-		Name:      req.Name,
-		AuthorID:  req.AuthorID,
-		Status:    req.Status,
-		Reviewers: req.ReviewersIDs,
-		MergedAt:  mergedAt,
+		ID:                req.ID,
+		Name:              req.Name,
+		AuthorID:          req.AuthorID,
+		Status:            req.Status,
+		Reviewers:         req.ReviewersIDs,
+		NeedMoreReviewers: req.NeedMoreReviewers,
+		MergedAt:          mergedAt,
 	}
 }
 
@@ -67,11 +80,12 @@ func mapDomainReassignedPullRequestToResponsePullRequest(
 	req model.ReassignedPullRequest,
 ) response.PullRequest {
 	return response.PullRequest{
-		ID:        req.ID,
-		Name:      req.Name,
-		AuthorID:  req.AuthorID,
-		Status:    req.Status,
-		Reviewers: req.ReviewersIDs,
+		ID:                req.ID,
+		Name:              req.Name,
+		AuthorID:          req.AuthorID,
+		Status:            req.Status,
+		Reviewers:         req.ReviewersIDs,
+		NeedMoreReviewers: req.NeedMoreReviewers,
 	}
 }
 
@@ -86,8 +100,97 @@ func mapDomainReassignedPullRequestToResponseReassignPullRequest(
 	}
 }
 
+func mapDomainAssignmentEventToResponseAssignmentEvent(event model.AssignmentEvent) response.AssignmentEvent {
+	return response.AssignmentEvent{
+		Type:          event.Type.String(),
+		Actor:         event.Actor,
+		OldReviewerID: event.OldReviewerID,
+		NewReviewerID: event.NewReviewerID,
+		Reason:        event.Reason,
+		CreatedAt:     event.CreatedAt,
+	}
+}
+
+func mapDomainAssignmentEventsToResponseGetPullRequestHistory(
+	id string,
+	events []model.AssignmentEvent,
+) response.GetPullRequestHistory {
+	mappedEvents := collection.Map(events, mapDomainAssignmentEventToResponseAssignmentEvent)
+
+	return response.GetPullRequestHistory{
+		PullRequestID: id,
+		Events:        mappedEvents,
+	}
+}
+
+func mapDomainPullRequestToResponseListedPullRequest(req model.PullRequest) response.ListedPullRequest {
+	return response.ListedPullRequest{
+		ID:                req.ID,
+		Name:              req.Name,
+		AuthorID:          req.AuthorID,
+		Status:            req.Status,
+		Reviewers:         req.ReviewersIDs,
+		NeedMoreReviewers: req.NeedMoreReviewers,
+		CreatedAt:         req.CreatedAt,
+		MergedAt:          req.MergedAt,
+	}
+}
+
+func mapDomainPullRequestPageToResponseListPullRequests(
+	page model.PullRequestPage,
+) (response.ListPullRequests, error) {
+	mappedPullRequests := collection.Map(page.PullRequests, mapDomainPullRequestToResponseListedPullRequest)
+
+	var nextCursor *string
+	if page.Next != nil {
+		encoded, err := encodeCursor(*page.Next)
+		if err != nil {
+			return response.ListPullRequests{}, errors.Wrap(err, "encoding next cursor")
+		}
+		nextCursor = &encoded
+	}
+
+	return response.ListPullRequests{
+		PullRequests: mappedPullRequests,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+func mapDomainUserToResponsePullRequestUser(user model.User) response.PullRequestUser {
+	return response.PullRequestUser{
+		ID:       user.ID,
+		Name:     user.Name,
+		TeamName: user.TeamName,
+		IsActive: user.IsActive,
+	}
+}
+
+func mapDomainPullRequestDetailsToResponseGetPullRequest(
+	details model.PullRequestDetails,
+) response.GetPullRequest {
+	pr := details.PullRequest
+	mappedReviewers := collection.Map(details.Reviewers, mapDomainUserToResponsePullRequestUser)
+
+	return response.GetPullRequest{
+		PullRequest: response.PullRequestDetails{
+			ID:                pr.ID,
+			Name:              pr.Name,
+			AuthorID:          pr.AuthorID,
+			AuthorTeam:        details.Author.TeamName,
+			Author:            mapDomainUserToResponsePullRequestUser(details.Author),
+			Status:            pr.Status,
+			Reviewers:         mappedReviewers,
+			NeedMoreReviewers: pr.NeedMoreReviewers,
+			CreatedAt:         pr.CreatedAt,
+			MergedAt:          pr.MergedAt,
+		},
+	}
+}
+
 func mapDomainPullRequestErrorToCode(err error) httperr.ErrorCode {
 	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return httperr.CodeForbidden
 	case errors.Is(err, model.ErrPullRequestIsMerged):
 		return httperr.CodePrMerged
 	case errors.Is(err, model.ErrPullRequestAlreadyExists):
@@ -102,6 +205,20 @@ func mapDomainPullRequestErrorToCode(err error) httperr.ErrorCode {
 		return httperr.CodeNotAssigned
 	case errors.Is(err, model.ErrNoCandidate):
 		return httperr.CodeNoCandidate
+	case errors.Is(err, model.ErrPullRequestConflict):
+		return httperr.CodeConflict
+	case errors.Is(err, model.ErrReviewerNotInTeam):
+		return httperr.CodeReviewerNotInTeam
+	case errors.Is(err, model.ErrReviewerIsAuthor):
+		return httperr.CodeReviewerIsAuthor
+	case errors.Is(err, model.ErrReviewerAlreadyAssigned):
+		return httperr.CodeReviewerAlreadyAssigned
+	case errors.Is(err, model.ErrReviewerInactive):
+		return httperr.CodeReviewerInactive
+	case errors.Is(err, model.ErrTooManyReviewers):
+		return httperr.CodeTooManyReviewers
+	case errors.Is(err, model.ErrInvalidReviewersCount):
+		return httperr.CodeInvalidReviewersCount
 	default:
 		return httperr.CodeInternal
 	}
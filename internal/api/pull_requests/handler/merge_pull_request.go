@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/hizu77/avito-autumn-2025/internal/api/user/request"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,10 +13,11 @@ import (
 
 func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
 	const op = "pullrequest.MergePullRequest"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var mergePullRequestRequest request.MergePullRequest
 	if err := render.DecodeJSON(r.Body, &mergePullRequestRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +27,7 @@ func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateMergePullRequestRequest(mergePullRequestRequest); err != nil {
-		h.logger.Error("validating request",
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -37,7 +39,7 @@ func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	pullRequest, err := h.service.MergePullRequest(ctx, mergePullRequestRequest.ID)
 	if err != nil {
-		h.logger.Error("merging pull request",
+		logger.Error("merging pull request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/hizu77/avito-autumn-2025/internal/api/pull_requests/request"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,10 +13,11 @@ import (
 
 func (h *Handler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
 	const op = "pullrequest.ReassignPullRequest"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var reassignPullRequestRequest request.ReassignPullRequest
 	if err := render.DecodeJSON(r.Body, &reassignPullRequestRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +27,7 @@ func (h *Handler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateReassignPullRequestRequest(reassignPullRequestRequest); err != nil {
-		h.logger.Error("validating request",
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -39,9 +41,10 @@ func (h *Handler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
 		ctx,
 		reassignPullRequestRequest.ID,
 		reassignPullRequestRequest.OldReviewerID,
+		reassignPullRequestRequest.NewReviewerID,
 	)
 	if err != nil {
-		h.logger.Error("reassigning pull request",
+		logger.Error("reassigning pull request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -68,5 +71,9 @@ func validateReassignPullRequestRequest(req request.ReassignPullRequest) error {
 		return errors.New("old_reviewer_id is required")
 	}
 
+	if req.NewReviewerID != nil && *req.NewReviewerID == "" {
+		return errors.New("new_reviewer_id must not be empty")
+	}
+
 	return nil
 }
@@ -1,7 +1,10 @@
 package request
 
 type CreatePullRequest struct {
-	ID       string `json:"pull_request_id"`
-	Name     string `json:"pull_request_name"`
-	AuthorID string `json:"author_id"`
+	ID                 string   `json:"pull_request_id"`
+	Name               string   `json:"pull_request_name"`
+	AuthorID           string   `json:"author_id"`
+	ReviewersCount     *int     `json:"reviewers_count"`
+	PreferredReviewers []string `json:"preferred_reviewers"`
+	ExcludedReviewers  []string `json:"excluded_reviewers"`
 }
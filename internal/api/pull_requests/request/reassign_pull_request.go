@@ -1,6 +1,7 @@
 package request
 
 type ReassignPullRequest struct {
-	ID            string `json:"pull_request_id"`
-	OldReviewerID string `json:"old_reviewer_id"`
+	ID            string  `json:"pull_request_id"`
+	OldReviewerID string  `json:"old_reviewer_id"`
+	NewReviewerID *string `json:"new_reviewer_id"`
 }
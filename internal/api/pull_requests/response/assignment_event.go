@@ -0,0 +1,12 @@
+package response
+
+import "time"
+
+type AssignmentEvent struct {
+	Type          string    `json:"event_type"`
+	Actor         string    `json:"actor"`
+	OldReviewerID *string   `json:"old_reviewer_id"`
+	NewReviewerID *string   `json:"new_reviewer_id"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type GetPullRequest struct {
+	PullRequest PullRequestDetails `json:"pr"`
+}
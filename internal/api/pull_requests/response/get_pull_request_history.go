@@ -0,0 +1,6 @@
+package response
+
+type GetPullRequestHistory struct {
+	PullRequestID string            `json:"pull_request_id"`
+	Events        []AssignmentEvent `json:"events"`
+}
@@ -0,0 +1,6 @@
+package response
+
+type ListPullRequests struct {
+	PullRequests []ListedPullRequest `json:"pull_requests"`
+	NextCursor   *string             `json:"next_cursor"`
+}
@@ -7,10 +7,11 @@ import (
 )
 
 type MergedPullRequest struct {
-	ID        string       `json:"pull_request_id"`
-	Name      string       `json:"pull_request_name"`
-	AuthorID  string       `json:"author_id"`
-	Status    model.Status `json:"status"`
-	Reviewers []string     `json:"assigned_reviewers"`
-	MergedAt  time.Time    `json:"merged_at"`
+	ID                string       `json:"pull_request_id"`
+	Name              string       `json:"pull_request_name"`
+	AuthorID          string       `json:"author_id"`
+	Status            model.Status `json:"status"`
+	Reviewers         []string     `json:"assigned_reviewers"`
+	NeedMoreReviewers bool         `json:"need_more_reviewers"`
+	MergedAt          time.Time    `json:"merged_at"`
 }
@@ -3,9 +3,10 @@ package response
 import "github.com/hizu77/avito-autumn-2025/internal/model"
 
 type PullRequest struct {
-	ID        string       `json:"pull_request_id"`
-	Name      string       `json:"pull_request_name"`
-	AuthorID  string       `json:"author_id"`
-	Status    model.Status `json:"status"`
-	Reviewers []string     `json:"assigned_reviewers"`
+	ID                string       `json:"pull_request_id"`
+	Name              string       `json:"pull_request_name"`
+	AuthorID          string       `json:"author_id"`
+	Status            model.Status `json:"status"`
+	Reviewers         []string     `json:"assigned_reviewers"`
+	NeedMoreReviewers bool         `json:"need_more_reviewers"`
 }
@@ -0,0 +1,8 @@
+package response
+
+type PullRequestUser struct {
+	ID       string `json:"user_id"`
+	Name     string `json:"username"`
+	TeamName string `json:"team_name"`
+	IsActive bool   `json:"is_active"`
+}
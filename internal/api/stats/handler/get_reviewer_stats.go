@@ -0,0 +1,94 @@
+package stats
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	teamNameQueryParam = "team_name"
+	fromQueryParam     = "from"
+	toQueryParam       = "to"
+)
+
+func (h *Handler) GetReviewerStats(w http.ResponseWriter, r *http.Request) {
+	const op = "stats.GetReviewerStats"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	filter, err := parseStatsFilter(r.URL.Query())
+	if err != nil {
+		logger.Error("parsing filter",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	stats, err := h.service.GetReviewerStats(ctx, filter)
+	if err != nil {
+		logger.Error("getting reviewer stats",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeInternal)
+		return
+	}
+
+	mappedStats := mapDomainStatsToResponseGetReviewerStats(stats)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mappedStats)
+}
+
+func parseStatsFilter(query url.Values) (model.StatsFilter, error) {
+	var filter model.StatsFilter
+
+	if teamName := query.Get(teamNameQueryParam); teamName != "" {
+		filter.TeamName = &teamName
+	}
+
+	from, err := parseTimeQueryParam(query, fromQueryParam)
+	if err != nil {
+		return model.StatsFilter{}, err
+	}
+
+	to, err := parseTimeQueryParam(query, toQueryParam)
+	if err != nil {
+		return model.StatsFilter{}, err
+	}
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return model.StatsFilter{}, errors.New("from must be before to")
+	}
+
+	filter.From = from
+	filter.To = to
+
+	return filter, nil
+}
+
+func parseTimeQueryParam(query url.Values, key string) (*time.Time, error) {
+	raw := query.Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, errors.Errorf("%s must be RFC3339 timestamp", key)
+	}
+
+	return &parsed, nil
+}
@@ -0,0 +1,27 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+type service interface {
+	GetReviewerStats(ctx context.Context, filter model.StatsFilter) (model.Stats, error)
+}
+
+type Handler struct {
+	service service
+	logger  *zap.Logger
+}
+
+func New(
+	service service,
+	logger *zap.Logger,
+) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
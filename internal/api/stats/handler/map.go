@@ -0,0 +1,39 @@
+package stats
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/api/stats/response"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+)
+
+func mapDomainReviewerStatsToResponseReviewerStats(stats model.ReviewerStats) response.ReviewerStats {
+	return response.ReviewerStats{
+		UserID:         stats.UserID,
+		Username:       stats.Username,
+		TeamName:       stats.TeamName,
+		OpenCount:      stats.OpenCount,
+		MergedCount:    stats.MergedCount,
+		TotalCount:     stats.TotalCount,
+		ReassignedAway: stats.ReassignedAway,
+	}
+}
+
+func mapDomainPullRequestStatsToResponsePullRequestStats(stats model.PullRequestStats) response.PullRequestStats {
+	return response.PullRequestStats{
+		ID:             stats.ID,
+		Name:           stats.Name,
+		AuthorID:       stats.AuthorID,
+		Status:         stats.Status,
+		ReviewersCount: stats.ReviewersCount,
+	}
+}
+
+func mapDomainStatsToResponseGetReviewerStats(stats model.Stats) response.GetReviewerStats {
+	mappedReviewers := collection.Map(stats.Reviewers, mapDomainReviewerStatsToResponseReviewerStats)
+	mappedPullRequests := collection.Map(stats.PullRequests, mapDomainPullRequestStatsToResponsePullRequestStats)
+
+	return response.GetReviewerStats{
+		Reviewers:    mappedReviewers,
+		PullRequests: mappedPullRequests,
+	}
+}
@@ -0,0 +1,6 @@
+package response
+
+type GetReviewerStats struct {
+	Reviewers    []ReviewerStats    `json:"reviewers"`
+	PullRequests []PullRequestStats `json:"pull_requests"`
+}
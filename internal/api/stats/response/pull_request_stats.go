@@ -0,0 +1,11 @@
+package response
+
+import "github.com/hizu77/avito-autumn-2025/internal/model"
+
+type PullRequestStats struct {
+	ID             string       `json:"pull_request_id"`
+	Name           string       `json:"pull_request_name"`
+	AuthorID       string       `json:"author_id"`
+	Status         model.Status `json:"status"`
+	ReviewersCount int          `json:"reviewers_count"`
+}
@@ -0,0 +1,11 @@
+package response
+
+type ReviewerStats struct {
+	UserID         string `json:"user_id"`
+	Username       string `json:"username"`
+	TeamName       string `json:"team_name"`
+	OpenCount      int    `json:"open"`
+	MergedCount    int    `json:"merged"`
+	TotalCount     int    `json:"total"`
+	ReassignedAway int    `json:"reassigned_away"`
+}
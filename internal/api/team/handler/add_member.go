@@ -0,0 +1,77 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) AddMember(w http.ResponseWriter, r *http.Request) {
+	const op = "team.AddMember"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var addMemberRequest request.AddMember
+	if err := render.DecodeJSON(r.Body, &addMemberRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateAddMemberRequest(addMemberRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	user := mapRequestMemberToDomainUser(addMemberRequest.Member)
+	team, err := h.service.AddMember(ctx, addMemberRequest.TeamName, user)
+	if err != nil {
+		logger.Error("adding team member",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	teamResponse := mapDomainTeamToResponseAddMember(team)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamResponse)
+}
+
+func validateAddMemberRequest(req request.AddMember) error {
+	if req.TeamName == "" {
+		return errors.New("team_name is required")
+	}
+
+	if req.Member.ID == "" {
+		return errors.New("member id is required")
+	}
+
+	if req.Member.Name == "" {
+		return errors.New("member name is required")
+	}
+
+	if req.Member.Seniority < 0 {
+		return errors.New("member seniority must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) DeactivateTeam(w http.ResponseWriter, r *http.Request) {
+	const op = "team.DeactivateTeam"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var deactivateTeamRequest request.DeactivateTeam
+	if err := render.DecodeJSON(r.Body, &deactivateTeamRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateDeactivateTeamRequest(deactivateTeamRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	mappedDeactivation := mapRequestDeactivateTeamToDomainTeamDeactivation(deactivateTeamRequest)
+
+	deactivatedTeam, err := h.service.DeactivateTeam(ctx, mappedDeactivation)
+	if err != nil {
+		logger.Error("deactivating team",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	mappedResponse := mapDomainDeactivatedTeamToResponseDeactivateTeam(deactivatedTeam)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, mappedResponse)
+}
+
+func validateDeactivateTeamRequest(req request.DeactivateTeam) error {
+	if req.Name != nil && *req.Name == "" {
+		return errors.New("team_name must not be empty")
+	}
+
+	if req.Name == nil && len(req.UserIDs) == 0 {
+		return errors.New("team_name or user_ids is required")
+	}
+
+	for _, id := range req.UserIDs {
+		if id == "" {
+			return errors.New("user id is required")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) DeleteTeam(w http.ResponseWriter, r *http.Request) {
+	const op = "team.DeleteTeam"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var deleteTeamRequest request.DeleteTeam
+	if err := render.DecodeJSON(r.Body, &deleteTeamRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	deletion, err := validateDeleteTeamRequest(deleteTeamRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	deletedTeam, err := h.service.DeleteTeam(ctx, deletion)
+	if err != nil {
+		logger.Error("deleting team",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	deletedTeamResponse := mapDomainDeletedTeamToResponseDeleteTeam(deletion.TeamName, deletedTeam)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, deletedTeamResponse)
+}
+
+func validateDeleteTeamRequest(req request.DeleteTeam) (model.TeamDeletion, error) {
+	if req.Name == "" {
+		return model.TeamDeletion{}, errors.New("team_name is required")
+	}
+
+	usersPolicy, err := model.ParseTeamUsersPolicy(req.UsersPolicy)
+	if err != nil {
+		return model.TeamDeletion{}, errors.New("unknown users_policy")
+	}
+
+	pullRequestsPolicy, err := model.ParseOpenPullRequestsPolicy(req.PullRequestsPolicy)
+	if err != nil {
+		return model.TeamDeletion{}, errors.New("unknown pull_requests_policy")
+	}
+
+	if usersPolicy == model.TeamUsersPolicyMove {
+		if req.TargetTeamName == nil || *req.TargetTeamName == "" {
+			return model.TeamDeletion{}, errors.New("target_team_name is required to move users")
+		}
+
+		if *req.TargetTeamName == req.Name {
+			return model.TeamDeletion{}, errors.New("target_team_name must differ from team_name")
+		}
+	} else if req.TargetTeamName != nil {
+		return model.TeamDeletion{}, errors.New("target_team_name is allowed only to move users")
+	}
+
+	if usersPolicy == model.TeamUsersPolicyDelete && pullRequestsPolicy != model.OpenPullRequestsPolicyDelete {
+		return model.TeamDeletion{}, errors.New("deleting users requires deleting their open pull requests")
+	}
+
+	return model.TeamDeletion{
+		TeamName:           req.Name,
+		UsersPolicy:        usersPolicy,
+		TargetTeamName:     req.TargetTeamName,
+		PullRequestsPolicy: pullRequestsPolicy,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -15,11 +16,12 @@ const (
 
 func (h *Handler) GetTeamByName(w http.ResponseWriter, r *http.Request) {
 	const op = "team.GetTeamByName"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	name := r.URL.Query().Get(nameQueryParam)
 
 	if err := validateTeamName(name); err != nil {
-		h.logger.Error("validating name",
+		logger.Error("validating name",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -31,7 +33,7 @@ func (h *Handler) GetTeamByName(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	team, err := h.service.GetTeamByName(ctx, name)
 	if err != nil {
-		h.logger.Error("getting team",
+		logger.Error("getting team",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -10,6 +10,13 @@ import (
 type service interface {
 	SaveTeam(ctx context.Context, team model.Team) (model.Team, error)
 	GetTeamByName(ctx context.Context, name string) (model.Team, error)
+	DeactivateTeam(ctx context.Context, deactivation model.TeamDeactivation) (model.DeactivatedTeam, error)
+	SetReviewerStrategy(ctx context.Context, name string, strategy model.ReviewerStrategy) (model.Team, error)
+	SetTeamSettings(ctx context.Context, name string, settings model.TeamSettings) (model.Team, error)
+	AddMember(ctx context.Context, teamName string, user model.User) (model.Team, error)
+	RemoveMember(ctx context.Context, teamName string, userID string) (model.RemovedMember, error)
+	RenameTeam(ctx context.Context, oldName string, newName string) (model.Team, error)
+	DeleteTeam(ctx context.Context, deletion model.TeamDeletion) (model.DeletedTeam, error)
 }
 
 type Handler struct {
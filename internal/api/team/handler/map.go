@@ -11,9 +11,10 @@ import (
 
 func mapRequestMemberToDomainUser(member request.TeamMember) model.User {
 	return model.User{
-		ID:       member.ID,
-		Name:     member.Name,
-		IsActive: member.IsActive,
+		ID:        member.ID,
+		Name:      member.Name,
+		IsActive:  member.IsActive,
+		Seniority: member.Seniority,
 	}
 }
 
@@ -21,16 +22,18 @@ func mapRequestSaveTeamToDomainTeam(team request.SaveTeam) model.Team {
 	mappedUsers := collection.Map(team.Members, mapRequestMemberToDomainUser)
 
 	return model.Team{
-		Name:    team.Name,
-		Members: mappedUsers,
+		Name:             team.Name,
+		ReviewerStrategy: model.ReviewerStrategy(team.ReviewerStrategy),
+		Members:          mappedUsers,
 	}
 }
 
 func mapDomainUserToResponseMember(member model.User) response.TeamMember {
 	return response.TeamMember{
-		ID:       member.ID,
-		Name:     member.Name,
-		IsActive: member.IsActive,
+		ID:        member.ID,
+		Name:      member.Name,
+		IsActive:  member.IsActive,
+		Seniority: member.Seniority,
 	}
 }
 
@@ -38,8 +41,11 @@ func mapDomainTeamToResponseTeam(team model.Team) response.Team {
 	mappedUsers := collection.Map(team.Members, mapDomainUserToResponseMember)
 
 	return response.Team{
-		Name:    team.Name,
-		Members: mappedUsers,
+		Name:             team.Name,
+		ReviewerStrategy: team.ReviewerStrategy.String(),
+		MinReviewers:     team.Settings.MinReviewers,
+		MaxReviewers:     team.Settings.MaxReviewers,
+		Members:          mappedUsers,
 	}
 }
 
@@ -51,12 +57,121 @@ func mapDomainTeamToResponseSaveTeam(team model.Team) response.SaveTeam {
 	}
 }
 
+func mapDomainTeamToResponseSetReviewerStrategy(team model.Team) response.SetReviewerStrategy {
+	mappedTeam := mapDomainTeamToResponseTeam(team)
+
+	return response.SetReviewerStrategy{
+		Team: mappedTeam,
+	}
+}
+
+func mapRequestSetTeamSettingsToDomainTeamSettings(req request.SetTeamSettings) model.TeamSettings {
+	return model.TeamSettings{
+		MinReviewers: *req.MinReviewers,
+		MaxReviewers: *req.MaxReviewers,
+	}
+}
+
+func mapDomainTeamToResponseSetTeamSettings(team model.Team) response.SetTeamSettings {
+	mappedTeam := mapDomainTeamToResponseTeam(team)
+
+	return response.SetTeamSettings{
+		Team: mappedTeam,
+	}
+}
+
+func mapRequestDeactivateTeamToDomainTeamDeactivation(req request.DeactivateTeam) model.TeamDeactivation {
+	return model.TeamDeactivation{
+		TeamName: req.Name,
+		UserIDs:  req.UserIDs,
+	}
+}
+
+func mapDomainReviewerReplacementToResponseReviewerReplacement(
+	replacement model.ReviewerReplacement,
+) response.ReviewerReplacement {
+	return response.ReviewerReplacement{
+		PullRequestID: replacement.PullRequestID,
+		OldReviewerID: replacement.OldReviewerID,
+		NewReviewerID: replacement.NewReviewerID,
+	}
+}
+
+func mapDomainDeactivatedTeamToResponseDeactivateTeam(team model.DeactivatedTeam) response.DeactivateTeam {
+	mappedUsers := collection.Map(team.Users, mapDomainUserToResponseMember)
+	mappedReplacements := collection.Map(
+		team.Replacements,
+		mapDomainReviewerReplacementToResponseReviewerReplacement,
+	)
+
+	return response.DeactivateTeam{
+		Users:        mappedUsers,
+		Replacements: mappedReplacements,
+	}
+}
+
+func mapDomainTeamToResponseAddMember(team model.Team) response.AddMember {
+	mappedTeam := mapDomainTeamToResponseTeam(team)
+
+	return response.AddMember{
+		Team: mappedTeam,
+	}
+}
+
+func mapDomainRemovedMemberToResponseRemoveMember(member model.RemovedMember) response.RemoveMember {
+	mappedUser := mapDomainUserToResponseMember(member.User)
+	mappedReplacements := collection.Map(
+		member.Replacements,
+		mapDomainReviewerReplacementToResponseReviewerReplacement,
+	)
+
+	return response.RemoveMember{
+		User:         mappedUser,
+		Replacements: mappedReplacements,
+	}
+}
+
+func mapDomainTeamToResponseRenameTeam(team model.Team) response.RenameTeam {
+	mappedTeam := mapDomainTeamToResponseTeam(team)
+
+	return response.RenameTeam{
+		Team: mappedTeam,
+	}
+}
+
+func mapDomainDeletedTeamToResponseDeleteTeam(name string, team model.DeletedTeam) response.DeleteTeam {
+	mappedUsers := collection.Map(team.Users, mapDomainUserToResponseMember)
+	mappedUnassignments := collection.Map(
+		team.Unassignments,
+		mapDomainReviewerReplacementToResponseReviewerReplacement,
+	)
+
+	return response.DeleteTeam{
+		Name:                  name,
+		Users:                 mappedUsers,
+		DeletedPullRequestIDs: team.DeletedPullRequestIDs,
+		Unassignments:         mappedUnassignments,
+	}
+}
+
 func mapDomainTeamErrorToCode(err error) httperr.ErrorCode {
 	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return httperr.CodeForbidden
 	case errors.Is(err, model.ErrTeamAlreadyExists):
 		return httperr.CodeTeamExists
 	case errors.Is(err, model.ErrTeamDoesNotExist):
 		return httperr.CodeNotFound
+	case errors.Is(err, model.ErrUserDoesNotExist):
+		return httperr.CodeNotFound
+	case errors.Is(err, model.ErrUserAlreadyMember):
+		return httperr.CodeAlreadyMember
+	case errors.Is(err, model.ErrUserNotMember):
+		return httperr.CodeNotMember
+	case errors.Is(err, model.ErrPullRequestConflict):
+		return httperr.CodeConflict
+	case errors.Is(err, model.ErrUsersHaveHistory):
+		return httperr.CodeUsersHaveHistory
 	default:
 		return httperr.CodeInternal
 	}
@@ -0,0 +1,68 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	const op = "team.RemoveMember"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var removeMemberRequest request.RemoveMember
+	if err := render.DecodeJSON(r.Body, &removeMemberRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateRemoveMemberRequest(removeMemberRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	removedMember, err := h.service.RemoveMember(ctx, removeMemberRequest.TeamName, removeMemberRequest.UserID)
+	if err != nil {
+		logger.Error("removing team member",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	removedMemberResponse := mapDomainRemovedMemberToResponseRemoveMember(removedMember)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, removedMemberResponse)
+}
+
+func validateRemoveMemberRequest(req request.RemoveMember) error {
+	if req.TeamName == "" {
+		return errors.New("team_name is required")
+	}
+
+	if req.UserID == "" {
+		return errors.New("user_id is required")
+	}
+
+	return nil
+}
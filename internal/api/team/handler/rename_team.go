@@ -0,0 +1,72 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RenameTeam(w http.ResponseWriter, r *http.Request) {
+	const op = "team.RenameTeam"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var renameTeamRequest request.RenameTeam
+	if err := render.DecodeJSON(r.Body, &renameTeamRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateRenameTeamRequest(renameTeamRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	team, err := h.service.RenameTeam(ctx, renameTeamRequest.Name, renameTeamRequest.NewName)
+	if err != nil {
+		logger.Error("renaming team",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	teamResponse := mapDomainTeamToResponseRenameTeam(team)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamResponse)
+}
+
+func validateRenameTeamRequest(req request.RenameTeam) error {
+	if req.Name == "" {
+		return errors.New("team_name is required")
+	}
+
+	if req.NewName == "" {
+		return errors.New("new_team_name is required")
+	}
+
+	if req.Name == req.NewName {
+		return errors.New("new_team_name must differ from team_name")
+	}
+
+	return nil
+}
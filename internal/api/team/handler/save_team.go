@@ -5,17 +5,20 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
 
 func (h *Handler) SaveTeam(w http.ResponseWriter, r *http.Request) {
 	const op = "team.SaveTeam"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var saveTeamRequest request.SaveTeam
 	if err := render.DecodeJSON(r.Body, &saveTeamRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +28,7 @@ func (h *Handler) SaveTeam(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateSaveTeamRequest(saveTeamRequest); err != nil {
-		h.logger.Error("validating request",
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -39,7 +42,7 @@ func (h *Handler) SaveTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.service.SaveTeam(ctx, mappedTeam)
 	if err != nil {
-		h.logger.Error("saving team",
+		logger.Error("saving team",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -60,6 +63,12 @@ func validateSaveTeamRequest(req request.SaveTeam) error {
 		return errors.New("name is required")
 	}
 
+	if req.ReviewerStrategy != "" {
+		if _, err := model.ParseReviewerStrategy(req.ReviewerStrategy); err != nil {
+			return errors.New("unknown reviewer strategy")
+		}
+	}
+
 	if len(req.Members) == 0 {
 		return errors.New("members are required")
 	}
@@ -72,6 +81,10 @@ func validateSaveTeamRequest(req request.SaveTeam) error {
 		if member.Name == "" {
 			return errors.New("member name is required")
 		}
+
+		if member.Seniority < 0 {
+			return errors.New("member seniority must not be negative")
+		}
 	}
 
 	return nil
@@ -0,0 +1,71 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) SetReviewerStrategy(w http.ResponseWriter, r *http.Request) {
+	const op = "team.SetReviewerStrategy"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var setStrategyRequest request.SetReviewerStrategy
+	if err := render.DecodeJSON(r.Body, &setStrategyRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	strategy, err := validateSetReviewerStrategyRequest(setStrategyRequest)
+	if err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	team, err := h.service.SetReviewerStrategy(ctx, setStrategyRequest.Name, strategy)
+	if err != nil {
+		logger.Error("setting reviewer strategy",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	teamResponse := mapDomainTeamToResponseSetReviewerStrategy(team)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamResponse)
+}
+
+func validateSetReviewerStrategyRequest(req request.SetReviewerStrategy) (model.ReviewerStrategy, error) {
+	if req.Name == "" {
+		return "", errors.New("team_name is required")
+	}
+
+	strategy, err := model.ParseReviewerStrategy(req.ReviewerStrategy)
+	if err != nil {
+		return "", errors.New("unknown reviewer strategy")
+	}
+
+	return strategy, nil
+}
@@ -0,0 +1,86 @@
+package team
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/team/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) SetTeamSettings(w http.ResponseWriter, r *http.Request) {
+	const op = "team.SetTeamSettings"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var setSettingsRequest request.SetTeamSettings
+	if err := render.DecodeJSON(r.Body, &setSettingsRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateSetTeamSettingsRequest(setSettingsRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	mappedSettings := mapRequestSetTeamSettingsToDomainTeamSettings(setSettingsRequest)
+
+	team, err := h.service.SetTeamSettings(ctx, setSettingsRequest.Name, mappedSettings)
+	if err != nil {
+		logger.Error("setting team settings",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainTeamErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	teamResponse := mapDomainTeamToResponseSetTeamSettings(team)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, teamResponse)
+}
+
+func validateSetTeamSettingsRequest(req request.SetTeamSettings) error {
+	if req.Name == "" {
+		return errors.New("team_name is required")
+	}
+
+	if req.MinReviewers == nil {
+		return errors.New("min_reviewers is required")
+	}
+
+	if req.MaxReviewers == nil {
+		return errors.New("max_reviewers is required")
+	}
+
+	if *req.MinReviewers < 0 {
+		return errors.New("min_reviewers must not be negative")
+	}
+
+	if *req.MaxReviewers < 1 {
+		return errors.New("max_reviewers must be positive")
+	}
+
+	if *req.MinReviewers > *req.MaxReviewers {
+		return errors.New("min_reviewers must not exceed max_reviewers")
+	}
+
+	return nil
+}
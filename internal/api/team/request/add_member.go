@@ -0,0 +1,6 @@
+package request
+
+type AddMember struct {
+	TeamName string     `json:"team_name"`
+	Member   TeamMember `json:"member"`
+}
@@ -0,0 +1,6 @@
+package request
+
+type DeactivateTeam struct {
+	Name    *string  `json:"team_name"`
+	UserIDs []string `json:"user_ids"`
+}
@@ -0,0 +1,8 @@
+package request
+
+type DeleteTeam struct {
+	Name               string  `json:"team_name"`
+	UsersPolicy        string  `json:"users_policy"`
+	TargetTeamName     *string `json:"target_team_name"`
+	PullRequestsPolicy string  `json:"pull_requests_policy"`
+}
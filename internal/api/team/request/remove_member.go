@@ -0,0 +1,6 @@
+package request
+
+type RemoveMember struct {
+	TeamName string `json:"team_name"`
+	UserID   string `json:"user_id"`
+}
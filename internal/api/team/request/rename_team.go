@@ -0,0 +1,6 @@
+package request
+
+type RenameTeam struct {
+	Name    string `json:"team_name"`
+	NewName string `json:"new_team_name"`
+}
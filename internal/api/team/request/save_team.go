@@ -1,6 +1,7 @@
 package request
 
 type SaveTeam struct {
-	Name    string       `json:"team_name"`
-	Members []TeamMember `json:"members"`
+	Name             string       `json:"team_name"`
+	ReviewerStrategy string       `json:"reviewer_strategy"`
+	Members          []TeamMember `json:"members"`
 }
@@ -0,0 +1,6 @@
+package request
+
+type SetReviewerStrategy struct {
+	Name             string `json:"team_name"`
+	ReviewerStrategy string `json:"reviewer_strategy"`
+}
@@ -0,0 +1,7 @@
+package request
+
+type SetTeamSettings struct {
+	Name         string `json:"team_name"`
+	MinReviewers *int   `json:"min_reviewers"`
+	MaxReviewers *int   `json:"max_reviewers"`
+}
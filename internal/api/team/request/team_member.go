@@ -1,7 +1,8 @@
 package request
 
 type TeamMember struct {
-	ID       string `json:"user_id"`
-	Name     string `json:"username"`
-	IsActive bool   `json:"is_active"`
+	ID        string `json:"user_id"`
+	Name      string `json:"username"`
+	IsActive  bool   `json:"is_active"`
+	Seniority int    `json:"seniority"`
 }
@@ -0,0 +1,5 @@
+package response
+
+type AddMember struct {
+	Team Team `json:"team"`
+}
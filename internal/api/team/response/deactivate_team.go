@@ -0,0 +1,6 @@
+package response
+
+type DeactivateTeam struct {
+	Users        []TeamMember          `json:"deactivated_users"`
+	Replacements []ReviewerReplacement `json:"reassigned_pull_requests"`
+}
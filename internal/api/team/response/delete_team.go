@@ -0,0 +1,8 @@
+package response
+
+type DeleteTeam struct {
+	Name                  string                `json:"team_name"`
+	Users                 []TeamMember          `json:"users"`
+	DeletedPullRequestIDs []string              `json:"deleted_pull_request_ids"`
+	Unassignments         []ReviewerReplacement `json:"unassigned_reviews"`
+}
@@ -0,0 +1,6 @@
+package response
+
+type RemoveMember struct {
+	User         TeamMember            `json:"removed_user"`
+	Replacements []ReviewerReplacement `json:"reassigned_pull_requests"`
+}
@@ -0,0 +1,5 @@
+package response
+
+type RenameTeam struct {
+	Team Team `json:"team"`
+}
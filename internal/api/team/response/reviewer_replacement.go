@@ -0,0 +1,7 @@
+package response
+
+type ReviewerReplacement struct {
+	PullRequestID string  `json:"pull_request_id"`
+	OldReviewerID string  `json:"old_reviewer_id"`
+	NewReviewerID *string `json:"new_reviewer_id"`
+}
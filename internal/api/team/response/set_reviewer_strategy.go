@@ -0,0 +1,5 @@
+package response
+
+type SetReviewerStrategy struct {
+	Team Team `json:"team"`
+}
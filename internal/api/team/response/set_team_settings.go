@@ -0,0 +1,5 @@
+package response
+
+type SetTeamSettings struct {
+	Team Team `json:"team"`
+}
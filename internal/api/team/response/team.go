@@ -1,6 +1,9 @@
 package response
 
 type Team struct {
-	Name    string       `json:"team_name"`
-	Members []TeamMember `json:"members"`
+	Name             string       `json:"team_name"`
+	ReviewerStrategy string       `json:"reviewer_strategy"`
+	MinReviewers     int          `json:"min_reviewers"`
+	MaxReviewers     int          `json:"max_reviewers"`
+	Members          []TeamMember `json:"members"`
 }
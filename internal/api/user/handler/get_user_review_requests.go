@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 )
@@ -15,11 +16,12 @@ const (
 
 func (h *Handler) GetUserReviewRequests(w http.ResponseWriter, r *http.Request) {
 	const op = "users.GetUserReviewRequests"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	id := r.URL.Query().Get(idQueryParam)
 
 	if err := validateUserID(id); err != nil {
-		h.logger.Error("validate user id",
+		logger.Error("validate user id",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -31,7 +33,7 @@ func (h *Handler) GetUserReviewRequests(w http.ResponseWriter, r *http.Request)
 	ctx := r.Context()
 	requests, err := h.service.GetUserReviewRequests(ctx, id)
 	if err != nil {
-		h.logger.Error("getting user review requests",
+		logger.Error("getting user review requests",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -9,6 +9,7 @@ import (
 
 type service interface {
 	SetActive(ctx context.Context, id string, active bool) (model.User, error)
+	SetSeniority(ctx context.Context, id string, seniority int) (model.User, error)
 	GetUserReviewRequests(ctx context.Context, id string) ([]model.PullRequest, error)
 }
 
@@ -10,10 +10,11 @@ import (
 
 func mapDomainUserToResponseUser(user model.User) response.User {
 	return response.User{
-		ID:       user.ID,
-		Name:     user.Name,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		ID:        user.ID,
+		Name:      user.Name,
+		TeamName:  user.TeamName,
+		IsActive:  user.IsActive,
+		Seniority: user.Seniority,
 	}
 }
 
@@ -25,6 +26,14 @@ func mapDomainUserToResponseSetActive(user model.User) response.SetActive {
 	}
 }
 
+func mapDomainUserToResponseSetSeniority(user model.User) response.SetSeniority {
+	mappedUser := mapDomainUserToResponseUser(user)
+
+	return response.SetSeniority{
+		User: mappedUser,
+	}
+}
+
 func mapDomainPullRequestToResponseUserReviewRequest(request model.PullRequest) response.ReviewRequest {
 	return response.ReviewRequest{
 		ID:       request.ID,
@@ -48,8 +57,12 @@ func mapDomainPullRequestsToResponseGetUserReviewRequests(
 
 func mapDomainUserErrorToCode(err error) httperr.ErrorCode {
 	switch {
+	case errors.Is(err, model.ErrForbidden):
+		return httperr.CodeForbidden
 	case errors.Is(err, model.ErrUserDoesNotExist):
 		return httperr.CodeNotFound
+	case errors.Is(err, model.ErrPullRequestConflict):
+		return httperr.CodeConflict
 	default:
 		return httperr.CodeInternal
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/render"
 	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	"github.com/hizu77/avito-autumn-2025/internal/api/user/request"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -12,10 +13,11 @@ import (
 
 func (h *Handler) SetActive(w http.ResponseWriter, r *http.Request) {
 	const op = "users.SetActive"
+	logger := logging.FromContext(r.Context(), h.logger)
 
 	var setActiveRequest request.SetActive
 	if err := render.DecodeJSON(r.Body, &setActiveRequest); err != nil {
-		h.logger.Error("decoding request body",
+		logger.Error("decoding request body",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -25,7 +27,7 @@ func (h *Handler) SetActive(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := validateSetActiveRequest(setActiveRequest); err != nil {
-		h.logger.Error("validating request",
+		logger.Error("validating request",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -37,7 +39,7 @@ func (h *Handler) SetActive(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	user, err := h.service.SetActive(ctx, setActiveRequest.ID, setActiveRequest.IsActive)
 	if err != nil {
-		h.logger.Error("setting active user",
+		logger.Error("setting active user",
 			zap.String("op", op),
 			zap.Error(err),
 		)
@@ -0,0 +1,68 @@
+package users
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/user/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) SetSeniority(w http.ResponseWriter, r *http.Request) {
+	const op = "users.SetSeniority"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var setSeniorityRequest request.SetSeniority
+	if err := render.DecodeJSON(r.Body, &setSeniorityRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateSetSeniorityRequest(setSeniorityRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	user, err := h.service.SetSeniority(ctx, setSeniorityRequest.ID, setSeniorityRequest.Seniority)
+	if err != nil {
+		logger.Error("setting user seniority",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainUserErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	userResponse := mapDomainUserToResponseSetSeniority(user)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, userResponse)
+}
+
+func validateSetSeniorityRequest(req request.SetSeniority) error {
+	if req.ID == "" {
+		return errors.New("id is required")
+	}
+
+	if req.Seniority < 1 {
+		return errors.New("seniority must be positive")
+	}
+
+	return nil
+}
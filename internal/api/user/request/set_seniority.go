@@ -0,0 +1,6 @@
+package request
+
+type SetSeniority struct {
+	ID        string `json:"user_id"`
+	Seniority int    `json:"seniority"`
+}
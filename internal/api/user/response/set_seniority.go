@@ -0,0 +1,5 @@
+package response
+
+type SetSeniority struct {
+	User User `json:"user"`
+}
@@ -1,8 +1,9 @@
 package response
 
 type User struct {
-	ID       string `json:"user_id"`
-	Name     string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"is_active"`
+	ID        string `json:"user_id"`
+	Name      string `json:"username"`
+	TeamName  string `json:"team_name"`
+	IsActive  bool   `json:"is_active"`
+	Seniority int    `json:"seniority"`
 }
@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/webhook/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/webhook/response"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "webhook.DeleteWebhook"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var deleteRequest request.DeleteWebhook
+	if err := render.DecodeJSON(r.Body, &deleteRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateDeleteWebhookRequest(deleteRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	if err := h.service.DeleteWebhook(ctx, deleteRequest.ID); err != nil {
+		logger.Error("deleting webhook",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainWebhookErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, response.DeleteWebhook{ID: deleteRequest.ID})
+}
+
+func validateDeleteWebhookRequest(req request.DeleteWebhook) error {
+	if req.ID <= 0 {
+		return errors.New("webhook_id is required")
+	}
+
+	return nil
+}
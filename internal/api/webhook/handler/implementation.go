@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"go.uber.org/zap"
+)
+
+type service interface {
+	RegisterWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error)
+	ListWebhooks(ctx context.Context) ([]model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+}
+
+type Handler struct {
+	service service
+	logger  *zap.Logger
+}
+
+func New(
+	service service,
+	logger *zap.Logger,
+) *Handler {
+	return &Handler{
+		service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	const op = "webhook.ListWebhooks"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	ctx := r.Context()
+	webhooks, err := h.service.ListWebhooks(ctx)
+	if err != nil {
+		logger.Error("listing webhooks",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainWebhookErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	webhooksResponse := mapDomainWebhooksToResponseListWebhooks(webhooks)
+
+	render.Status(r, http.StatusOK)
+	render.JSON(w, r, webhooksResponse)
+}
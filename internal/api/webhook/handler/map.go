@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/webhook/request"
+	"github.com/hizu77/avito-autumn-2025/internal/api/webhook/response"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func mapRequestRegisterWebhookToDomainWebhook(req request.RegisterWebhook) model.Webhook {
+	return model.Webhook{
+		URL:      req.URL,
+		TeamName: req.TeamName,
+		Secret:   req.Secret,
+	}
+}
+
+func mapDomainWebhookToResponseWebhook(webhook model.Webhook) response.Webhook {
+	return response.Webhook{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		TeamName:  webhook.TeamName,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func mapDomainWebhookToResponseRegisterWebhook(webhook model.Webhook) response.RegisterWebhook {
+	return response.RegisterWebhook{
+		Webhook: mapDomainWebhookToResponseWebhook(webhook),
+		Secret:  webhook.Secret,
+	}
+}
+
+func mapDomainWebhooksToResponseListWebhooks(webhooks []model.Webhook) response.ListWebhooks {
+	return response.ListWebhooks{
+		Webhooks: collection.Map(webhooks, mapDomainWebhookToResponseWebhook),
+	}
+}
+
+func mapDomainWebhookErrorToCode(err error) httperr.ErrorCode {
+	switch {
+	case errors.Is(err, model.ErrWebhookDoesNotExist):
+		return httperr.CodeNotFound
+	case errors.Is(err, model.ErrTeamDoesNotExist):
+		return httperr.CodeNotFound
+	default:
+		return httperr.CodeInternal
+	}
+}
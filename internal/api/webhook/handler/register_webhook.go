@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/render"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
+	"github.com/hizu77/avito-autumn-2025/internal/api/webhook/request"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func (h *Handler) RegisterWebhook(w http.ResponseWriter, r *http.Request) {
+	const op = "webhook.RegisterWebhook"
+	logger := logging.FromContext(r.Context(), h.logger)
+
+	var registerRequest request.RegisterWebhook
+	if err := render.DecodeJSON(r.Body, &registerRequest); err != nil {
+		logger.Error("decoding request body",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, "invalid json body")
+		return
+	}
+
+	if err := validateRegisterWebhookRequest(registerRequest); err != nil {
+		logger.Error("validating request",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		httperr.WriteError(w, r, httperr.CodeBadRequest, err.Error())
+		return
+	}
+
+	ctx := r.Context()
+	webhook := mapRequestRegisterWebhookToDomainWebhook(registerRequest)
+	saved, err := h.service.RegisterWebhook(ctx, webhook)
+	if err != nil {
+		logger.Error("registering webhook",
+			zap.String("op", op),
+			zap.Error(err),
+		)
+
+		code := mapDomainWebhookErrorToCode(err)
+		httperr.WriteError(w, r, code)
+		return
+	}
+
+	webhookResponse := mapDomainWebhookToResponseRegisterWebhook(saved)
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, webhookResponse)
+}
+
+func validateRegisterWebhookRequest(req request.RegisterWebhook) error {
+	if req.URL == "" {
+		return errors.New("url is required")
+	}
+
+	parsed, err := url.ParseRequestURI(req.URL)
+	if err != nil || parsed.Host == "" || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return errors.New("url must be an absolute http or https url")
+	}
+
+	if req.TeamName != nil && *req.TeamName == "" {
+		return errors.New("team_name must not be empty")
+	}
+
+	return nil
+}
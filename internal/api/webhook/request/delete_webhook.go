@@ -0,0 +1,5 @@
+package request
+
+type DeleteWebhook struct {
+	ID int64 `json:"webhook_id"`
+}
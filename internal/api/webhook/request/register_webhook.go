@@ -0,0 +1,7 @@
+package request
+
+type RegisterWebhook struct {
+	URL      string  `json:"url"`
+	TeamName *string `json:"team_name"`
+	Secret   string  `json:"secret"`
+}
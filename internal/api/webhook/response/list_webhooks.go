@@ -0,0 +1,5 @@
+package response
+
+type ListWebhooks struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
@@ -0,0 +1,7 @@
+package response
+
+// RegisterWebhook is the only response that reveals the signing secret.
+type RegisterWebhook struct {
+	Webhook Webhook `json:"webhook"`
+	Secret  string  `json:"secret"`
+}
@@ -0,0 +1,10 @@
+package response
+
+import "time"
+
+type Webhook struct {
+	ID        int64     `json:"webhook_id"`
+	URL       string    `json:"url"`
+	TeamName  *string   `json:"team_name"`
+	CreatedAt time.Time `json:"created_at"`
+}
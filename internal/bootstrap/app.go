@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -24,7 +25,9 @@ type App struct {
 	mux    *chi.Mux
 	logger *zap.Logger
 
-	httpAddr string
+	httpAddr      string
+	shutdownDelay time.Duration
+	shuttingDown  atomic.Bool
 }
 
 func InitApp(
@@ -35,12 +38,18 @@ func InitApp(
 	mux := chi.NewRouter()
 
 	return &App{
-		mux:      mux,
-		logger:   logger,
-		httpAddr: httpAddr,
+		mux:           mux,
+		logger:        logger,
+		httpAddr:      httpAddr,
+		shutdownDelay: cfg.HTTP.ShutdownDelay,
 	}
 }
 
+// ShuttingDown reports whether the app callbacks of the closer have started.
+func (a *App) ShuttingDown() bool {
+	return a.shuttingDown.Load()
+}
+
 func (a *App) Run(ctx context.Context) error {
 	eg, _ := errgroup.WithContext(ctx)
 
@@ -63,6 +72,10 @@ func (a *App) Run(ctx context.Context) error {
 	if err := closer.AddCallback(
 		CloserGroupApp,
 		func() error {
+			a.shuttingDown.Store(true)
+			a.logger.Info("draining before http server shutdown", zap.Duration("delay", a.shutdownDelay))
+			time.Sleep(a.shutdownDelay)
+
 			shutdownCtx, cancel := context.WithTimeout(
 				context.Background(),
 				ShutdownTimeout,
@@ -2,61 +2,116 @@ package bootstrap
 
 import (
 	"context"
+	"net/http"
 
-	"github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
-	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/jwtauth/v5"
 	"github.com/hizu77/avito-autumn-2025/config"
+	"github.com/hizu77/avito-autumn-2025/db"
 	adminhandler "github.com/hizu77/avito-autumn-2025/internal/api/admin/handler"
 	middleware "github.com/hizu77/avito-autumn-2025/internal/api/admin/middleware"
+	apikeyhandler "github.com/hizu77/avito-autumn-2025/internal/api/apikey/handler"
 	"github.com/hizu77/avito-autumn-2025/internal/api/health"
+	"github.com/hizu77/avito-autumn-2025/internal/api/idempotency"
+	integrationhandler "github.com/hizu77/avito-autumn-2025/internal/api/integration/handler"
+	"github.com/hizu77/avito-autumn-2025/internal/api/jwks"
+	"github.com/hizu77/avito-autumn-2025/internal/api/logging"
 	pullrequesthandler "github.com/hizu77/avito-autumn-2025/internal/api/pull_requests/handler"
+	statshandler "github.com/hizu77/avito-autumn-2025/internal/api/stats/handler"
 	teamhandler "github.com/hizu77/avito-autumn-2025/internal/api/team/handler"
 	userhandler "github.com/hizu77/avito-autumn-2025/internal/api/user/handler"
+	webhookhandler "github.com/hizu77/avito-autumn-2025/internal/api/webhook/handler"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	adminservice "github.com/hizu77/avito-autumn-2025/internal/service/admin"
+	apikeyservice "github.com/hizu77/avito-autumn-2025/internal/service/apikey"
+	healthservice "github.com/hizu77/avito-autumn-2025/internal/service/health"
+	idempotencyservice "github.com/hizu77/avito-autumn-2025/internal/service/idempotency"
+	integrationservice "github.com/hizu77/avito-autumn-2025/internal/service/integration"
 	pullrequestservice "github.com/hizu77/avito-autumn-2025/internal/service/pull_request"
+	statsservice "github.com/hizu77/avito-autumn-2025/internal/service/stats"
 	teamservice "github.com/hizu77/avito-autumn-2025/internal/service/team"
 	userservice "github.com/hizu77/avito-autumn-2025/internal/service/user"
-	adminstorage "github.com/hizu77/avito-autumn-2025/internal/storage/admin/postgres"
-	pullrequeststorage "github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/postgres"
-	teamstorage "github.com/hizu77/avito-autumn-2025/internal/storage/team/postgres"
-	userstorage "github.com/hizu77/avito-autumn-2025/internal/storage/user/postgres"
-	"github.com/jackc/pgx/v5/pgxpool"
+	webhookservice "github.com/hizu77/avito-autumn-2025/internal/service/webhook"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 	"github.com/pkg/errors"
 )
 
 func InitHandlers(
 	ctx context.Context,
 	app *App,
-	pool *pgxpool.Pool,
+	storages *Storages,
 	cfg *config.Config,
 ) error {
-	secret := []byte(cfg.Secret)
-	tokenAuth := jwtauth.New("HS256", secret, nil)
+	appMetrics, err := InitMetrics(storages.Pool)
+	if err != nil {
+		return errors.Wrap(err, "failed to init metrics")
+	}
 
-	trManager := manager.Must(pgxv5.NewDefaultFactory(pool))
-	trGetter := pgxv5.DefaultCtxGetter
+	// Panics are recovered innermost, so that the outer middlewares
+	// see them as INTERNAL errors. Middlewares precede all routes.
+	app.mux.Use(
+		tracing.Middleware,
+		appMetrics.Middleware,
+		logging.RequestID,
+		logging.AccessLog(app.logger),
+		logging.Recoverer,
+	)
 
-	adminStorage := adminstorage.New(pool, trGetter)
-	userStorage := userstorage.New(pool, trGetter)
-	teamStorage := teamstorage.New(pool, trGetter)
-	pullRequestStorage := pullrequeststorage.New(pool, trGetter)
+	keys, err := InitJWTKeys(cfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to init jwt keys")
+	}
+
+	migrationVersion, err := db.LatestMigrationVersion()
+	if err != nil {
+		return errors.Wrap(err, "failed to get migration version")
+	}
 
-	adminService := adminservice.New(adminStorage, secret)
-	userService := userservice.New(userStorage, pullRequestStorage)
-	teamService := teamservice.New(userStorage, teamStorage, trManager)
+	adminService := adminservice.New(storages.Admin, storages.TrManager, keys.SigningKey())
+	apiKeyService := apikeyservice.New(storages.APIKey)
+	webhookService := webhookservice.New(storages.Webhook)
 	pullRequestService := pullrequestservice.New(
-		teamStorage,
-		pullRequestStorage,
-		trManager,
+		storages.Team,
+		storages.PullRequest,
+		webhookService,
+		appMetrics,
+		storages.TrManager,
 	)
+	userService := userservice.New(
+		storages.User,
+		storages.PullRequest,
+		pullRequestService,
+		storages.TrManager,
+	)
+	teamService := teamservice.New(
+		storages.User,
+		storages.Team,
+		pullRequestService,
+		pullRequestService,
+		storages.TrManager,
+	)
+	statsService := statsservice.New(storages.PullRequest)
+	healthService := healthservice.New(storages.Health, app, migrationVersion)
+	integrationService := integrationservice.New(storages.Integration, pullRequestService)
+	idempotencyService := idempotencyservice.New(storages.Idempotency, idempotencyservice.Config{
+		TTL:         cfg.Idempotency.TTL,
+		LockTimeout: cfg.Idempotency.LockTimeout,
+	})
 
 	adminHandler := adminhandler.New(adminService, app.logger)
+	apiKeyHandler := apikeyhandler.New(apiKeyService, app.logger)
 	userHandler := userhandler.New(userService, app.logger)
 	teamHandler := teamhandler.New(teamService, app.logger)
 	pullRequestHandler := pullrequesthandler.New(pullRequestService, app.logger)
+	statsHandler := statshandler.New(statsService, app.logger)
+	webhookHandler := webhookhandler.New(webhookService, app.logger)
+	jwksHandler := jwks.New(keys.PublicKeys())
+	healthHandler := health.New(healthService, app.logger)
+	integrationHandler := integrationhandler.New(
+		integrationService,
+		cfg.Integrations.GitHubSecret,
+		cfg.Integrations.GitLabToken,
+		app.logger,
+	)
 
 	if err := ensureDefaultAdmin(
 		ctx,
@@ -67,37 +122,152 @@ func InitHandlers(
 		return errors.Wrap(err, "failed to ensure default admin")
 	}
 
+	if err := InitWebhookDispatcher(
+		ctx,
+		storages.Webhook,
+		storages.TrManager,
+		cfg.Webhook,
+		app.logger,
+	); err != nil {
+		return errors.Wrap(err, "failed to init webhook dispatcher")
+	}
+
+	if err := InitIdempotencyCleaner(
+		ctx,
+		idempotencyService,
+		cfg.Idempotency,
+		app.logger,
+	); err != nil {
+		return errors.Wrap(err, "failed to init idempotency cleaner")
+	}
+
+	authenticated := []func(http.Handler) http.Handler{
+		middleware.Verifier(keys),
+		middleware.Denylist(adminService),
+		middleware.Actor,
+		middleware.APIKey(apiKeyService),
+	}
+
+	// Team leads are additionally limited to their own team
+	// and members to their own reviews by the services.
+	// API keys are let through only where a scope is given.
+	allRoles := []model.Role{model.RoleAdmin, model.RoleTeamLead, model.RoleMember, model.RoleServiceBot}
+	adminOnly := middleware.RequireRole(model.RoleAdmin)
+	anyRole := middleware.RequireRole(allRoles...)
+	teamReaders := middleware.RequireAccess(model.APIKeyScopeTeamRead, allRoles...)
+	teamManagers := middleware.RequireAccess(model.APIKeyScopeTeamWrite, model.RoleAdmin, model.RoleTeamLead)
+	reviewReaders := middleware.RequireAccess(model.APIKeyScopeUserRead, allRoles...)
+	userManagers := middleware.RequireAccess(model.APIKeyScopeUserAdmin, model.RoleAdmin, model.RoleTeamLead)
+	pullRequestReaders := middleware.RequireAccess(model.APIKeyScopePrRead, allRoles...)
+	pullRequestWriters := middleware.RequireAccess(
+		model.APIKeyScopePrWrite,
+		model.RoleAdmin,
+		model.RoleTeamLead,
+		model.RoleServiceBot,
+	)
+	reviewerReassigners := middleware.RequireAccess(model.APIKeyScopePrWrite, allRoles...)
+
+	// Retries of these with the same Idempotency-Key replay the first response.
+	idempotent := idempotency.Middleware(idempotencyService, app.logger)
+
 	app.mux.Route("/admins", func(r chi.Router) {
 		r.Post("/login", adminHandler.LoginAdmin)
+		r.Post("/refresh", adminHandler.RefreshAdminToken)
 
 		r.Group(func(r chi.Router) {
-			r.Use(jwtauth.Verifier(tokenAuth))
-			r.Use(middleware.Authenticator)
-			r.Post("/register", adminHandler.RegisterAdmin)
+			r.Use(authenticated...)
+			r.With(anyRole).Post("/logout", adminHandler.LogoutAdmin)
+			r.With(anyRole).Post("/changePassword", adminHandler.ChangeAdminPassword)
+			r.Group(func(r chi.Router) {
+				r.Use(adminOnly)
+				r.Post("/register", adminHandler.RegisterAdmin)
+				r.Delete("/", adminHandler.DeleteAdmin)
+			})
 		})
 	})
 
 	app.mux.Route("/team", func(r chi.Router) {
-		r.Post("/add", teamHandler.SaveTeam)
-		r.Get("/get", teamHandler.GetTeamByName)
+		r.Use(authenticated...)
+		r.With(teamReaders).Get("/get", teamHandler.GetTeamByName)
+		r.Group(func(r chi.Router) {
+			r.Use(teamManagers)
+			r.With(idempotent).Post("/add", teamHandler.SaveTeam)
+			r.Post("/setReviewerStrategy", teamHandler.SetReviewerStrategy)
+			r.Post("/addMember", teamHandler.AddMember)
+			r.Post("/removeMember", teamHandler.RemoveMember)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(adminOnly)
+			r.Post("/deactivate", teamHandler.DeactivateTeam)
+			r.Post("/rename", teamHandler.RenameTeam)
+			r.Post("/setSettings", teamHandler.SetTeamSettings)
+			r.Delete("/", teamHandler.DeleteTeam)
+		})
 	})
 
 	app.mux.Route("/users", func(r chi.Router) {
-		r.Get("/getReview", userHandler.GetUserReviewRequests)
+		r.Use(authenticated...)
+		r.With(reviewReaders).Get("/getReview", userHandler.GetUserReviewRequests)
 		r.Group(func(r chi.Router) {
-			r.Use(jwtauth.Verifier(tokenAuth))
-			r.Use(middleware.Authenticator)
+			r.Use(userManagers)
 			r.Post("/setIsActive", userHandler.SetActive)
+			r.Post("/setSeniority", userHandler.SetSeniority)
 		})
 	})
 
 	app.mux.Route("/pullRequest", func(r chi.Router) {
-		r.Post("/create", pullRequestHandler.CreatePullRequest)
-		r.Post("/merge", pullRequestHandler.MergePullRequest)
-		r.Post("/reassign", pullRequestHandler.ReassignPullRequest)
+		r.Use(authenticated...)
+		r.Group(func(r chi.Router) {
+			r.Use(pullRequestWriters)
+			r.With(idempotent).Post("/create", pullRequestHandler.CreatePullRequest)
+			r.Post("/merge", pullRequestHandler.MergePullRequest)
+		})
+		r.With(reviewerReassigners, idempotent).Post("/reassign", pullRequestHandler.ReassignPullRequest)
+		r.Group(func(r chi.Router) {
+			r.Use(pullRequestReaders)
+			r.Get("/history", pullRequestHandler.GetPullRequestHistory)
+			r.Get("/get", pullRequestHandler.GetPullRequest)
+			r.Get("/list", pullRequestHandler.ListPullRequests)
+		})
+	})
+
+	app.mux.Route("/stats", func(r chi.Router) {
+		r.Use(authenticated...)
+		r.With(pullRequestReaders).Get("/reviewers", statsHandler.GetReviewerStats)
+	})
+
+	app.mux.Route("/apiKeys", func(r chi.Router) {
+		r.Use(authenticated...)
+		r.Use(adminOnly)
+		r.Post("/create", apiKeyHandler.CreateAPIKey)
+		r.Get("/list", apiKeyHandler.ListAPIKeys)
+		r.Post("/revoke", apiKeyHandler.RevokeAPIKey)
+	})
+
+	app.mux.Route("/webhooks", func(r chi.Router) {
+		r.Use(authenticated...)
+		r.Use(adminOnly)
+		r.Post("/register", webhookHandler.RegisterWebhook)
+		r.Get("/list", webhookHandler.ListWebhooks)
+		r.Post("/delete", webhookHandler.DeleteWebhook)
+	})
+
+	app.mux.Route("/integrations", func(r chi.Router) {
+		r.Post("/github/webhook", integrationHandler.GitHubWebhook)
+		r.Post("/gitlab/webhook", integrationHandler.GitLabWebhook)
+		r.Group(func(r chi.Router) {
+			r.Use(authenticated...)
+			r.Use(adminOnly)
+			r.Post("/mappings/set", integrationHandler.SetLoginMapping)
+			r.Get("/mappings/list", integrationHandler.ListLoginMappings)
+			r.Post("/mappings/delete", integrationHandler.DeleteLoginMapping)
+		})
 	})
 
 	app.mux.Get("/health", health.Liveness)
+	app.mux.Get("/ready", healthHandler.Readiness)
+	app.mux.Method(http.MethodGet, "/metrics", appMetrics.Handler())
+	app.mux.Get("/.well-known/jwks.json", jwksHandler.PublicKeys)
 
 	return nil
 }
@@ -108,7 +278,7 @@ func ensureDefaultAdmin(
 	id string,
 	password string,
 ) error {
-	_, err := service.RegisterAdmin(ctx, id, password)
+	_, err := service.RegisterAdmin(ctx, model.Admin{ID: id, Role: model.RoleAdmin}, password)
 	if errors.Is(err, model.ErrAdminAlreadyExists) {
 		return nil
 	}
@@ -0,0 +1,35 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/config"
+	idempotencyservice "github.com/hizu77/avito-autumn-2025/internal/service/idempotency"
+	"github.com/hizu77/avito-autumn-2025/pkg/closer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func InitIdempotencyCleaner(
+	ctx context.Context,
+	service *idempotencyservice.Service,
+	cfg config.Idempotency,
+	logger *zap.Logger,
+) error {
+	cleaner := idempotencyservice.NewCleaner(service, cfg.CleanupInterval, logger)
+
+	if err := closer.AddCallback(
+		CloserGroupApp,
+		func() error {
+			cleaner.Stop()
+			logger.Info("idempotency cleaner stopped")
+			return nil
+		},
+	); err != nil {
+		return errors.Wrap(err, "idempotency cleaner callback")
+	}
+
+	cleaner.Start(ctx)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package bootstrap
+
+import (
+	"github.com/hizu77/avito-autumn-2025/config"
+	"github.com/hizu77/avito-autumn-2025/pkg/jwtkeys"
+	"github.com/pkg/errors"
+)
+
+// InitJWTKeys loads the keys access tokens are signed and verified with.
+// HS256 keeps using the shared admin secret.
+func InitJWTKeys(cfg *config.Config) (*jwtkeys.Ring, error) {
+	if cfg.JWT.Algorithm == jwtkeys.AlgorithmHS256 {
+		ring, err := jwtkeys.NewSymmetric([]byte(cfg.Admin.Secret))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create symmetric keys")
+		}
+
+		return ring, nil
+	}
+
+	if cfg.JWT.SigningKeyFile == "" {
+		return nil, errors.Errorf("JWT_SIGNING_KEY_FILE is required for %s", cfg.JWT.Algorithm)
+	}
+
+	ring, err := jwtkeys.Load(
+		cfg.JWT.Algorithm,
+		cfg.JWT.SigningKeyFile,
+		cfg.JWT.VerificationKeyFiles,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load keys")
+	}
+
+	return ring, nil
+}
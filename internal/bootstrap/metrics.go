@@ -0,0 +1,22 @@
+package bootstrap
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/metrics"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+// InitMetrics creates the metrics of the app including the pool statistics.
+// The pool is nil with the in-memory storage.
+func InitMetrics(pool *pgxpool.Pool) (*metrics.Metrics, error) {
+	appMetrics := metrics.New()
+	if pool == nil {
+		return appMetrics, nil
+	}
+
+	if err := appMetrics.RegisterPool(pool); err != nil {
+		return nil, errors.Wrap(err, "register pool metrics")
+	}
+
+	return appMetrics, nil
+}
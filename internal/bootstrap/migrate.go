@@ -0,0 +1,47 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/db"
+	"github.com/hizu77/avito-autumn-2025/pkg/migrator"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// InitMigrator creates a migrator over the migrations embedded in the binary.
+func InitMigrator(pool *pgxpool.Pool) (*migrator.Migrator, error) {
+	migrations, err := db.Migrations()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load migrations")
+	}
+
+	return migrator.New(pool, migrations), nil
+}
+
+// MigrateOnStart applies pending migrations before the app starts.
+func MigrateOnStart(
+	ctx context.Context,
+	pool *pgxpool.Pool,
+	logger *zap.Logger,
+) error {
+	m, err := InitMigrator(pool)
+	if err != nil {
+		return errors.Wrap(err, "init migrator")
+	}
+
+	applied, err := m.Up(ctx)
+	for _, migration := range applied {
+		logger.Info(
+			"migration applied",
+			zap.Int("version", migration.Version),
+			zap.String("description", migration.Description),
+		)
+	}
+	if err != nil {
+		return errors.Wrap(err, "apply migrations")
+	}
+
+	return nil
+}
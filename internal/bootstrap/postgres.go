@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"context"
 
+	"github.com/exaring/otelpgx"
 	"github.com/hizu77/avito-autumn-2025/pkg/closer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
@@ -14,9 +15,19 @@ func InitPostgres(
 	connectionString string,
 	logger *zap.Logger,
 ) (*pgxpool.Pool, error) {
-	pool, err := pgxpool.New(
+	if connectionString == "" {
+		return nil, errors.New("postgres url is not set")
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(connectionString)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse postgres config")
+	}
+	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithTrimSQLInSpanName())
+
+	pool, err := pgxpool.NewWithConfig(
 		ctx,
-		connectionString,
+		poolConfig,
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "init postgres")
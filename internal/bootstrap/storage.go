@@ -0,0 +1,201 @@
+package bootstrap
+
+import (
+	"context"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/avito-tech/go-transaction-manager/trm/v2/manager"
+	"github.com/hizu77/avito-autumn-2025/db"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	adminmemory "github.com/hizu77/avito-autumn-2025/internal/storage/admin/memory"
+	adminstorage "github.com/hizu77/avito-autumn-2025/internal/storage/admin/postgres"
+	apikeymemory "github.com/hizu77/avito-autumn-2025/internal/storage/apikey/memory"
+	apikeystorage "github.com/hizu77/avito-autumn-2025/internal/storage/apikey/postgres"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	healthmemory "github.com/hizu77/avito-autumn-2025/internal/storage/health/memory"
+	healthstorage "github.com/hizu77/avito-autumn-2025/internal/storage/health/postgres"
+	idempotencymemory "github.com/hizu77/avito-autumn-2025/internal/storage/idempotency/memory"
+	idempotencystorage "github.com/hizu77/avito-autumn-2025/internal/storage/idempotency/postgres"
+	integrationmemory "github.com/hizu77/avito-autumn-2025/internal/storage/integration/memory"
+	integrationstorage "github.com/hizu77/avito-autumn-2025/internal/storage/integration/postgres"
+	pullrequestmemory "github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/memory"
+	pullrequeststorage "github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/postgres"
+	teammemory "github.com/hizu77/avito-autumn-2025/internal/storage/team/memory"
+	teamstorage "github.com/hizu77/avito-autumn-2025/internal/storage/team/postgres"
+	usermemory "github.com/hizu77/avito-autumn-2025/internal/storage/user/memory"
+	userstorage "github.com/hizu77/avito-autumn-2025/internal/storage/user/postgres"
+	webhookmemory "github.com/hizu77/avito-autumn-2025/internal/storage/webhook/memory"
+	webhookstorage "github.com/hizu77/avito-autumn-2025/internal/storage/webhook/postgres"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+const (
+	StorageDriverPostgres = "postgres"
+	StorageDriverMemory   = "memory"
+)
+
+// The storage interfaces are the union of what the services need,
+// every storage driver implements all of them.
+type (
+	adminStorage interface {
+		DeleteAdmin(ctx context.Context, id string) error
+		GetAdmin(ctx context.Context, id string) (model.Admin, error)
+		InsertAdmin(ctx context.Context, admin model.Admin) (model.Admin, error)
+		InsertRefreshToken(ctx context.Context, token model.RefreshToken) error
+		InsertRevokedAccessToken(ctx context.Context, token model.AccessToken) error
+		IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error)
+		RevokeAdminRefreshTokens(ctx context.Context, adminID string, revokedAt time.Time) error
+		RevokeRefreshToken(ctx context.Context, tokenHash string, revokedAt time.Time) (model.RefreshToken, error)
+		UpdatePassword(ctx context.Context, id string, passwordHash string, changedAt time.Time) error
+	}
+
+	apiKeyStorage interface {
+		GetAPIKey(ctx context.Context, id string) (model.APIKey, error)
+		GetAPIKeys(ctx context.Context) ([]model.APIKey, error)
+		InsertAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, error)
+		RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) (model.APIKey, error)
+	}
+
+	idempotencyStorage interface {
+		DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+		DeleteIdempotencyKey(ctx context.Context, callerID string, key string) error
+		ReserveIdempotencyKey(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error)
+		SaveIdempotentResponse(
+			ctx context.Context,
+			callerID string,
+			key string,
+			response model.IdempotentResponse,
+			expiresAt time.Time,
+		) error
+	}
+
+	healthStorage interface {
+		Ping(ctx context.Context) error
+		GetPoolStats() model.PoolStats
+		GetMigrationVersion(ctx context.Context) (int, error)
+	}
+
+	integrationStorage interface {
+		DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error
+		GetLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error)
+		GetUserIDByLogin(ctx context.Context, provider model.GitProvider, login string) (string, error)
+		SaveLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error)
+	}
+
+	pullRequestStorage interface {
+		DeleteOpenPullRequestsByAuthors(ctx context.Context, authorIDs []string) ([]string, error)
+		GetAssignmentEvents(ctx context.Context, pullRequestID string) ([]model.AssignmentEvent, error)
+		GetOpenPullRequestsByReviewers(ctx context.Context, ids []string) ([]model.PullRequest, error)
+		GetOpenReviewCounts(ctx context.Context, reviewerIDs []string) (map[string]int, error)
+		GetPullRequestByID(ctx context.Context, id string) (model.PullRequest, error)
+		GetPullRequestReviewers(ctx context.Context, id string) ([]model.User, error)
+		GetPullRequestStats(ctx context.Context, filter model.StatsFilter) ([]model.PullRequestStats, error)
+		GetPullRequestsByReviewer(ctx context.Context, id string) ([]model.PullRequest, error)
+		GetPullRequestsNeedingReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error)
+		GetReviewerStats(ctx context.Context, filter model.StatsFilter) ([]model.ReviewerStats, error)
+		HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error)
+		InsertAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) ([]model.AssignmentEvent, error)
+		InsertPullRequest(ctx context.Context, request model.PullRequest) (model.PullRequest, error)
+		ListPullRequests(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error)
+		RefreshNeedMoreReviewers(ctx context.Context, teamName string, minReviewers int) error
+		RemoveOpenReviewers(ctx context.Context, reviewerIDs []string) ([]model.ReviewerReplacement, error)
+		ReplaceReviewers(ctx context.Context, reassignments []model.Reassignment) ([]model.Reassignment, error)
+		UpdatePullRequestInfo(ctx context.Context, req model.PullRequest) (model.PullRequest, error)
+		UpdatePullRequestReviewers(ctx context.Context, req model.PullRequest) (model.PullRequest, error)
+	}
+
+	teamStorage interface {
+		DeleteTeam(ctx context.Context, name string) error
+		GetTeamByName(ctx context.Context, name string) (model.Team, error)
+		GetTeamByUserID(ctx context.Context, userID string) (model.Team, error)
+		InsertMembershipChanges(ctx context.Context, changes []model.MembershipChange) error
+		RenameTeam(ctx context.Context, oldName string, newName string) error
+		SaveTeam(ctx context.Context, team model.Team) (model.Team, error)
+		SaveTeamSettings(ctx context.Context, name string, settings model.TeamSettings) error
+		UpdateReviewerStrategy(ctx context.Context, name string, strategy model.ReviewerStrategy) error
+		UpdateRoundRobinCursor(ctx context.Context, name string, userID string) error
+	}
+
+	userStorage interface {
+		DeactivateUsers(ctx context.Context, teamName *string, ids []string) ([]model.User, error)
+		DeleteUsers(ctx context.Context, ids []string) error
+		GetUsersByIDs(ctx context.Context, ids []string) ([]model.User, error)
+		MoveUsers(ctx context.Context, fromTeam string, toTeam *string) ([]model.User, error)
+		SaveUsers(ctx context.Context, users []model.User) ([]model.User, error)
+		UpdateActivity(ctx context.Context, id string, activity bool) (model.User, error)
+		UpdateSeniority(ctx context.Context, id string, seniority int) (model.User, error)
+		UpdateTeam(ctx context.Context, id string, teamName *string) (model.User, error)
+	}
+
+	webhookStorage interface {
+		DeleteWebhook(ctx context.Context, id int64) error
+		GetWebhookSubscriptions(ctx context.Context, pullRequestIDs []string) ([]model.WebhookSubscription, error)
+		GetWebhooks(ctx context.Context) ([]model.Webhook, error)
+		InsertDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+		InsertWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error)
+		ClaimPendingDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error)
+		UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+	}
+)
+
+// Storages are the storages of one driver with its transaction manager.
+// Pool is nil unless the driver is postgres.
+type Storages struct {
+	Pool        *pgxpool.Pool
+	TrManager   trm.Manager
+	Admin       adminStorage
+	APIKey      apiKeyStorage
+	Health      healthStorage
+	Idempotency idempotencyStorage
+	Integration integrationStorage
+	PullRequest pullRequestStorage
+	Team        teamStorage
+	User        userStorage
+	Webhook     webhookStorage
+}
+
+func InitPostgresStorages(pool *pgxpool.Pool) *Storages {
+	trGetter := pgxv5.DefaultCtxGetter
+
+	return &Storages{
+		Pool:        pool,
+		TrManager:   tracing.NewManager(manager.Must(pgxv5.NewDefaultFactory(pool))),
+		Admin:       adminstorage.New(pool, trGetter),
+		APIKey:      apikeystorage.New(pool, trGetter),
+		Health:      healthstorage.New(pool, trGetter),
+		Idempotency: idempotencystorage.New(pool, trGetter),
+		Integration: integrationstorage.New(pool, trGetter),
+		PullRequest: pullrequeststorage.New(pool, trGetter),
+		Team:        teamstorage.New(pool, trGetter),
+		User:        userstorage.New(pool, trGetter),
+		Webhook:     webhookstorage.New(pool, trGetter),
+	}
+}
+
+// InitMemoryStorages keeps all data in the process, it is lost on exit.
+// Its schema is reported as up to date with the embedded migrations.
+func InitMemoryStorages() (*Storages, error) {
+	migrationVersion, err := db.LatestMigrationVersion()
+	if err != nil {
+		return nil, errors.Wrap(err, "get migration version")
+	}
+
+	memoryDB := memory.New()
+
+	return &Storages{
+		TrManager:   tracing.NewManager(memory.NewManager(memoryDB)),
+		Admin:       adminmemory.New(memoryDB),
+		APIKey:      apikeymemory.New(memoryDB),
+		Health:      healthmemory.New(migrationVersion),
+		Idempotency: idempotencymemory.New(memoryDB),
+		Integration: integrationmemory.New(memoryDB),
+		PullRequest: pullrequestmemory.New(memoryDB),
+		Team:        teammemory.New(memoryDB),
+		User:        usermemory.New(memoryDB),
+		Webhook:     webhookmemory.New(memoryDB),
+	}, nil
+}
@@ -0,0 +1,97 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/config"
+	"github.com/hizu77/avito-autumn-2025/pkg/closer"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.uber.org/zap"
+)
+
+const (
+	TracingExporterNone   = "none"
+	TracingExporterStdout = "stdout"
+	TracingExporterOTLP   = "otlp"
+)
+
+// InitTracing registers the global tracer provider. It must be called
+// before InitPostgres and InitHandlers, which pick the provider up.
+// Spans left in the batch are flushed when connections are closed.
+func InitTracing(
+	ctx context.Context,
+	cfg config.Tracing,
+	logger *zap.Logger,
+) error {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if cfg.Exporter == TracingExporterNone {
+		return nil
+	}
+
+	exporter, err := newSpanExporter(ctx, cfg)
+	if err != nil {
+		return errors.Wrap(err, "create span exporter")
+	}
+
+	res, err := resource.New(
+		ctx,
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(semconv.ServiceName(cfg.ServiceName)),
+	)
+	if err != nil {
+		return errors.Wrap(err, "create tracing resource")
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	err = closer.AddCallback(
+		CloserGroupConnections,
+		func() error {
+			logger.Info("flushing spans")
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+			defer cancel()
+
+			return errors.Wrap(provider.Shutdown(shutdownCtx), "shutting down tracer provider")
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "tracing callback")
+	}
+
+	logger.Info("tracing enabled", zap.String("exporter", cfg.Exporter))
+
+	return nil
+}
+
+func newSpanExporter(ctx context.Context, cfg config.Tracing) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case TracingExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case TracingExporterOTLP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, errors.Errorf("unsupported exporter %q", cfg.Exporter)
+	}
+}
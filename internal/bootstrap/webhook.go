@@ -0,0 +1,51 @@
+package bootstrap
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/hizu77/avito-autumn-2025/config"
+	webhookservice "github.com/hizu77/avito-autumn-2025/internal/service/webhook"
+	"github.com/hizu77/avito-autumn-2025/pkg/closer"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+func InitWebhookDispatcher(
+	ctx context.Context,
+	storage webhookStorage,
+	trManager trm.Manager,
+	cfg config.Webhook,
+	logger *zap.Logger,
+) error {
+	dispatcher := webhookservice.NewDispatcher(
+		storage,
+		&http.Client{Timeout: cfg.Timeout},
+		trManager,
+		webhookservice.DispatcherConfig{
+			PollInterval: cfg.PollInterval,
+			BatchSize:    cfg.BatchSize,
+			MaxAttempts:  cfg.MaxAttempts,
+			RetryBackoff: cfg.RetryBackoff,
+			MaxBackoff:   cfg.MaxBackoff,
+			LeaseTimeout: cfg.LeaseTimeout,
+		},
+		logger,
+	)
+
+	if err := closer.AddCallback(
+		CloserGroupApp,
+		func() error {
+			dispatcher.Stop()
+			logger.Info("webhook dispatcher stopped")
+			return nil
+		},
+	); err != nil {
+		return errors.Wrap(err, "webhook dispatcher callback")
+	}
+
+	dispatcher.Start(ctx)
+
+	return nil
+}
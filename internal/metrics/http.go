@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+)
+
+// unmatchedRoute labels requests that did not match any route,
+// so that arbitrary paths do not create new series.
+const unmatchedRoute = "unmatched"
+
+// Middleware records the count and the latency of requests by chi route
+// pattern. Requests are additionally labelled with the status and
+// the error code written by httperr.WriteError, empty on success.
+// It must be registered on the root router.
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		ctx, code := httperr.ContextWithCodeRecorder(r.Context())
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+
+		route := unmatchedRoute
+		if routeCtx := chi.RouteContext(ctx); routeCtx != nil && routeCtx.RoutePattern() != "" {
+			route = routeCtx.RoutePattern()
+		}
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+
+		m.httpRequests.
+			WithLabelValues(r.Method, route, strconv.Itoa(status), string(*code)).
+			Inc()
+		m.httpDuration.
+			WithLabelValues(r.Method, route).
+			Observe(time.Since(start).Seconds())
+	})
+}
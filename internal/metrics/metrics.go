@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer_service"
+
+// Metrics owns the registry exposed on /metrics together with
+// the HTTP, business and database collectors registered in it.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	pullRequestsCreated  prometheus.Counter
+	pullRequestsMerged   prometheus.Counter
+	reviewerReassignment *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	registry := prometheus.NewRegistry()
+
+	m := &Metrics{
+		registry: registry,
+		httpRequests: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "http",
+				Name:      "requests_total",
+				Help:      "Number of handled HTTP requests.",
+			},
+			[]string{"method", "route", "status", "code"},
+		),
+		// 0.3s is a bucket boundary to measure the latency SLI.
+		httpDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "http",
+				Name:      "request_duration_seconds",
+				Help:      "Duration of handled HTTP requests.",
+				Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.2, 0.3, 0.5, 1, 2.5, 5},
+			},
+			[]string{"method", "route"},
+		),
+		pullRequestsCreated: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "pull_requests",
+				Name:      "created_total",
+				Help:      "Number of created pull requests.",
+			},
+		),
+		pullRequestsMerged: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "pull_requests",
+				Name:      "merged_total",
+				Help:      "Number of merged pull requests.",
+			},
+		),
+		reviewerReassignment: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "pull_requests",
+				Name:      "reassignments_total",
+				Help:      "Number of requested reviewer reassignments by result.",
+			},
+			[]string{"result"},
+		),
+	}
+
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.pullRequestsCreated,
+		m.pullRequestsMerged,
+		m.reviewerReassignment,
+	)
+
+	m.reviewerReassignment.WithLabelValues(reassignmentResultReassigned)
+	m.reviewerReassignment.WithLabelValues(reassignmentResultNoCandidate)
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
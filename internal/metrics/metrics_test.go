@@ -0,0 +1,52 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	"github.com/hizu77/avito-autumn-2025/internal/metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	m := metrics.New()
+
+	router := chi.NewRouter()
+	router.Use(m.Middleware)
+	router.Get("/pullRequest/get", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	router.Post("/pullRequest/reassign", func(w http.ResponseWriter, r *http.Request) {
+		httperr.WriteError(w, r, httperr.CodeNoCandidate)
+	})
+	router.Method(http.MethodGet, "/metrics", m.Handler())
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id=pr-1", nil),
+		httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", nil),
+		httptest.NewRequest(http.MethodGet, "/unknown/path", nil),
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	m.PullRequestCreated()
+	m.NoReassignmentCandidate()
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	require.Contains(t, body, `reviewer_service_http_requests_total{code="",method="GET",route="/pullRequest/get",status="200"} 1`)
+	require.Contains(t, body, `reviewer_service_http_requests_total{code="NO_CANDIDATE",method="POST",route="/pullRequest/reassign",status="409"} 1`)
+	require.Contains(t, body, `reviewer_service_http_requests_total{code="",method="GET",route="unmatched",status="404"} 1`)
+	require.Contains(t, body, `reviewer_service_http_request_duration_seconds_bucket{method="GET",route="/pullRequest/get",le="0.3"} 1`)
+	require.Contains(t, body, `reviewer_service_pull_requests_created_total 1`)
+	require.Contains(t, body, `reviewer_service_pull_requests_reassignments_total{result="no_candidate"} 1`)
+	require.Contains(t, body, `reviewer_service_pull_requests_reassignments_total{result="reassigned"} 0`)
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RegisterPool exposes the statistics of the database connection pool.
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool) error {
+	return m.registry.Register(newPoolCollector(pool))
+}
+
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	newConnsCount        *prometheus.Desc
+	maxLifetimeDestroys  *prometheus.Desc
+	maxIdleDestroys      *prometheus.Desc
+}
+
+func newPoolCollector(pool *pgxpool.Pool) *poolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db_pool", name),
+			help,
+			nil,
+			nil,
+		)
+	}
+
+	return &poolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_connections", "Number of currently acquired connections."),
+		idleConns:            desc("idle_connections", "Number of currently idle connections."),
+		constructingConns:    desc("constructing_connections", "Number of connections being established."),
+		totalConns:           desc("total_connections", "Total number of connections in the pool."),
+		maxConns:             desc("max_connections", "Maximum size of the pool."),
+		acquireCount:         desc("acquires_total", "Number of successful acquires."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Total time spent on successful acquires."),
+		emptyAcquireCount:    desc("empty_acquires_total", "Number of acquires that waited for a connection."),
+		canceledAcquireCount: desc("canceled_acquires_total", "Number of acquires canceled by a context."),
+		newConnsCount:        desc("new_connections_total", "Number of established connections."),
+		maxLifetimeDestroys:  desc("max_lifetime_destroys_total", "Number of connections closed by max lifetime."),
+		maxIdleDestroys:      desc("max_idle_destroys_total", "Number of connections closed by max idle time."),
+	}
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	gauge := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value)
+	}
+	counter := func(desc *prometheus.Desc, value float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, value)
+	}
+
+	gauge(c.acquiredConns, float64(stat.AcquiredConns()))
+	gauge(c.idleConns, float64(stat.IdleConns()))
+	gauge(c.constructingConns, float64(stat.ConstructingConns()))
+	gauge(c.totalConns, float64(stat.TotalConns()))
+	gauge(c.maxConns, float64(stat.MaxConns()))
+	counter(c.acquireCount, float64(stat.AcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(c.emptyAcquireCount, float64(stat.EmptyAcquireCount()))
+	counter(c.canceledAcquireCount, float64(stat.CanceledAcquireCount()))
+	counter(c.newConnsCount, float64(stat.NewConnsCount()))
+	counter(c.maxLifetimeDestroys, float64(stat.MaxLifetimeDestroyCount()))
+	counter(c.maxIdleDestroys, float64(stat.MaxIdleDestroyCount()))
+}
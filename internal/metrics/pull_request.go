@@ -0,0 +1,23 @@
+package metrics
+
+const (
+	reassignmentResultReassigned  = "reassigned"
+	reassignmentResultNoCandidate = "no_candidate"
+)
+
+func (m *Metrics) PullRequestCreated() {
+	m.pullRequestsCreated.Inc()
+}
+
+func (m *Metrics) PullRequestMerged() {
+	m.pullRequestsMerged.Inc()
+}
+
+func (m *Metrics) ReviewerReassigned() {
+	m.reviewerReassignment.WithLabelValues(reassignmentResultReassigned).Inc()
+}
+
+// NoReassignmentCandidate counts reassignments rejected with NO_CANDIDATE.
+func (m *Metrics) NoReassignmentCandidate() {
+	m.reviewerReassignment.WithLabelValues(reassignmentResultNoCandidate).Inc()
+}
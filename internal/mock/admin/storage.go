@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/hizu77/avito-autumn-2025/internal/model"
@@ -35,6 +36,20 @@ func (m *AdminStorage) EXPECT() *AdminStorageMockRecorder {
 	return m.recorder
 }
 
+// DeleteAdmin mocks base method.
+func (m *AdminStorage) DeleteAdmin(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *AdminStorageMockRecorder) DeleteAdmin(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*AdminStorage)(nil).DeleteAdmin), ctx, id)
+}
+
 // GetAdmin mocks base method.
 func (m *AdminStorage) GetAdmin(ctx context.Context, id string) (model.Admin, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +79,89 @@ func (mr *AdminStorageMockRecorder) InsertAdmin(ctx, admin interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAdmin", reflect.TypeOf((*AdminStorage)(nil).InsertAdmin), ctx, admin)
 }
+
+// InsertRefreshToken mocks base method.
+func (m *AdminStorage) InsertRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRefreshToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRefreshToken indicates an expected call of InsertRefreshToken.
+func (mr *AdminStorageMockRecorder) InsertRefreshToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRefreshToken", reflect.TypeOf((*AdminStorage)(nil).InsertRefreshToken), ctx, token)
+}
+
+// InsertRevokedAccessToken mocks base method.
+func (m *AdminStorage) InsertRevokedAccessToken(ctx context.Context, token model.AccessToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRevokedAccessToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRevokedAccessToken indicates an expected call of InsertRevokedAccessToken.
+func (mr *AdminStorageMockRecorder) InsertRevokedAccessToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRevokedAccessToken", reflect.TypeOf((*AdminStorage)(nil).InsertRevokedAccessToken), ctx, token)
+}
+
+// IsAccessTokenRevoked mocks base method.
+func (m *AdminStorage) IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccessTokenRevoked", ctx, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAccessTokenRevoked indicates an expected call of IsAccessTokenRevoked.
+func (mr *AdminStorageMockRecorder) IsAccessTokenRevoked(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccessTokenRevoked", reflect.TypeOf((*AdminStorage)(nil).IsAccessTokenRevoked), ctx, token)
+}
+
+// RevokeAdminRefreshTokens mocks base method.
+func (m *AdminStorage) RevokeAdminRefreshTokens(ctx context.Context, adminID string, revokedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAdminRefreshTokens", ctx, adminID, revokedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAdminRefreshTokens indicates an expected call of RevokeAdminRefreshTokens.
+func (mr *AdminStorageMockRecorder) RevokeAdminRefreshTokens(ctx, adminID, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAdminRefreshTokens", reflect.TypeOf((*AdminStorage)(nil).RevokeAdminRefreshTokens), ctx, adminID, revokedAt)
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *AdminStorage) RevokeRefreshToken(ctx context.Context, tokenHash string, revokedAt time.Time) (model.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", ctx, tokenHash, revokedAt)
+	ret0, _ := ret[0].(model.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *AdminStorageMockRecorder) RevokeRefreshToken(ctx, tokenHash, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*AdminStorage)(nil).RevokeRefreshToken), ctx, tokenHash, revokedAt)
+}
+
+// UpdatePassword mocks base method.
+func (m *AdminStorage) UpdatePassword(ctx context.Context, id, passwordHash string, changedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, passwordHash, changedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *AdminStorageMockRecorder) UpdatePassword(ctx, id, passwordHash, changedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*AdminStorage)(nil).UpdatePassword), ctx, id, passwordHash, changedAt)
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// APIKeyStorage is a mock of storage interface.
+type APIKeyStorage struct {
+	ctrl     *gomock.Controller
+	recorder *APIKeyStorageMockRecorder
+}
+
+// APIKeyStorageMockRecorder is the mock recorder for APIKeyStorage.
+type APIKeyStorageMockRecorder struct {
+	mock *APIKeyStorage
+}
+
+// NewAPIKeyStorage creates a new mock instance.
+func NewAPIKeyStorage(ctrl *gomock.Controller) *APIKeyStorage {
+	mock := &APIKeyStorage{ctrl: ctrl}
+	mock.recorder = &APIKeyStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *APIKeyStorage) EXPECT() *APIKeyStorageMockRecorder {
+	return m.recorder
+}
+
+// GetAPIKey mocks base method.
+func (m *APIKeyStorage) GetAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", ctx, id)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *APIKeyStorageMockRecorder) GetAPIKey(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*APIKeyStorage)(nil).GetAPIKey), ctx, id)
+}
+
+// GetAPIKeys mocks base method.
+func (m *APIKeyStorage) GetAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeys", ctx)
+	ret0, _ := ret[0].([]model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeys indicates an expected call of GetAPIKeys.
+func (mr *APIKeyStorageMockRecorder) GetAPIKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeys", reflect.TypeOf((*APIKeyStorage)(nil).GetAPIKeys), ctx)
+}
+
+// InsertAPIKey mocks base method.
+func (m *APIKeyStorage) InsertAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAPIKey", ctx, apiKey)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAPIKey indicates an expected call of InsertAPIKey.
+func (mr *APIKeyStorageMockRecorder) InsertAPIKey(ctx, apiKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAPIKey", reflect.TypeOf((*APIKeyStorage)(nil).InsertAPIKey), ctx, apiKey)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *APIKeyStorage) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) (model.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id, revokedAt)
+	ret0, _ := ret[0].(model.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *APIKeyStorageMockRecorder) RevokeAPIKey(ctx, id, revokedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*APIKeyStorage)(nil).RevokeAPIKey), ctx, id, revokedAt)
+}
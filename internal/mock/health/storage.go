@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// HealthStorage is a mock of storage interface.
+type HealthStorage struct {
+	ctrl     *gomock.Controller
+	recorder *HealthStorageMockRecorder
+}
+
+// HealthStorageMockRecorder is the mock recorder for HealthStorage.
+type HealthStorageMockRecorder struct {
+	mock *HealthStorage
+}
+
+// NewHealthStorage creates a new mock instance.
+func NewHealthStorage(ctrl *gomock.Controller) *HealthStorage {
+	mock := &HealthStorage{ctrl: ctrl}
+	mock.recorder = &HealthStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *HealthStorage) EXPECT() *HealthStorageMockRecorder {
+	return m.recorder
+}
+
+// GetMigrationVersion mocks base method.
+func (m *HealthStorage) GetMigrationVersion(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMigrationVersion", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMigrationVersion indicates an expected call of GetMigrationVersion.
+func (mr *HealthStorageMockRecorder) GetMigrationVersion(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMigrationVersion", reflect.TypeOf((*HealthStorage)(nil).GetMigrationVersion), ctx)
+}
+
+// GetPoolStats mocks base method.
+func (m *HealthStorage) GetPoolStats() model.PoolStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolStats")
+	ret0, _ := ret[0].(model.PoolStats)
+	return ret0
+}
+
+// GetPoolStats indicates an expected call of GetPoolStats.
+func (mr *HealthStorageMockRecorder) GetPoolStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolStats", reflect.TypeOf((*HealthStorage)(nil).GetPoolStats))
+}
+
+// Ping mocks base method.
+func (m *HealthStorage) Ping(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *HealthStorageMockRecorder) Ping(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*HealthStorage)(nil).Ping), ctx)
+}
+
+// ShutdownState is a mock of shutdownState interface.
+type ShutdownState struct {
+	ctrl     *gomock.Controller
+	recorder *ShutdownStateMockRecorder
+}
+
+// ShutdownStateMockRecorder is the mock recorder for ShutdownState.
+type ShutdownStateMockRecorder struct {
+	mock *ShutdownState
+}
+
+// NewShutdownState creates a new mock instance.
+func NewShutdownState(ctrl *gomock.Controller) *ShutdownState {
+	mock := &ShutdownState{ctrl: ctrl}
+	mock.recorder = &ShutdownStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ShutdownState) EXPECT() *ShutdownStateMockRecorder {
+	return m.recorder
+}
+
+// ShuttingDown mocks base method.
+func (m *ShutdownState) ShuttingDown() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShuttingDown")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ShuttingDown indicates an expected call of ShuttingDown.
+func (mr *ShutdownStateMockRecorder) ShuttingDown() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShuttingDown", reflect.TypeOf((*ShutdownState)(nil).ShuttingDown))
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// IdempotencyStorage is a mock of storage interface.
+type IdempotencyStorage struct {
+	ctrl     *gomock.Controller
+	recorder *IdempotencyStorageMockRecorder
+}
+
+// IdempotencyStorageMockRecorder is the mock recorder for IdempotencyStorage.
+type IdempotencyStorageMockRecorder struct {
+	mock *IdempotencyStorage
+}
+
+// NewIdempotencyStorage creates a new mock instance.
+func NewIdempotencyStorage(ctrl *gomock.Controller) *IdempotencyStorage {
+	mock := &IdempotencyStorage{ctrl: ctrl}
+	mock.recorder = &IdempotencyStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *IdempotencyStorage) EXPECT() *IdempotencyStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteExpiredIdempotencyKeys mocks base method.
+func (m *IdempotencyStorage) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredIdempotencyKeys", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredIdempotencyKeys indicates an expected call of DeleteExpiredIdempotencyKeys.
+func (mr *IdempotencyStorageMockRecorder) DeleteExpiredIdempotencyKeys(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredIdempotencyKeys", reflect.TypeOf((*IdempotencyStorage)(nil).DeleteExpiredIdempotencyKeys), ctx, now)
+}
+
+// DeleteIdempotencyKey mocks base method.
+func (m *IdempotencyStorage) DeleteIdempotencyKey(ctx context.Context, callerID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKey", ctx, callerID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyKey indicates an expected call of DeleteIdempotencyKey.
+func (mr *IdempotencyStorageMockRecorder) DeleteIdempotencyKey(ctx, callerID, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKey", reflect.TypeOf((*IdempotencyStorage)(nil).DeleteIdempotencyKey), ctx, callerID, key)
+}
+
+// ReserveIdempotencyKey mocks base method.
+func (m *IdempotencyStorage) ReserveIdempotencyKey(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIdempotencyKey", ctx, record)
+	ret0, _ := ret[0].(model.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReserveIdempotencyKey indicates an expected call of ReserveIdempotencyKey.
+func (mr *IdempotencyStorageMockRecorder) ReserveIdempotencyKey(ctx, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIdempotencyKey", reflect.TypeOf((*IdempotencyStorage)(nil).ReserveIdempotencyKey), ctx, record)
+}
+
+// SaveIdempotentResponse mocks base method.
+func (m *IdempotencyStorage) SaveIdempotentResponse(ctx context.Context, callerID, key string, response model.IdempotentResponse, expiresAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveIdempotentResponse", ctx, callerID, key, response, expiresAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveIdempotentResponse indicates an expected call of SaveIdempotentResponse.
+func (mr *IdempotencyStorageMockRecorder) SaveIdempotentResponse(ctx, callerID, key, response, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveIdempotentResponse", reflect.TypeOf((*IdempotencyStorage)(nil).SaveIdempotentResponse), ctx, callerID, key, response, expiresAt)
+}
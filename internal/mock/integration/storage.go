@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// IntegrationStorage is a mock of storage interface.
+type IntegrationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *IntegrationStorageMockRecorder
+}
+
+// IntegrationStorageMockRecorder is the mock recorder for IntegrationStorage.
+type IntegrationStorageMockRecorder struct {
+	mock *IntegrationStorage
+}
+
+// NewIntegrationStorage creates a new mock instance.
+func NewIntegrationStorage(ctrl *gomock.Controller) *IntegrationStorage {
+	mock := &IntegrationStorage{ctrl: ctrl}
+	mock.recorder = &IntegrationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *IntegrationStorage) EXPECT() *IntegrationStorageMockRecorder {
+	return m.recorder
+}
+
+// DeleteLoginMapping mocks base method.
+func (m *IntegrationStorage) DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoginMapping", ctx, provider, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLoginMapping indicates an expected call of DeleteLoginMapping.
+func (mr *IntegrationStorageMockRecorder) DeleteLoginMapping(ctx, provider, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoginMapping", reflect.TypeOf((*IntegrationStorage)(nil).DeleteLoginMapping), ctx, provider, login)
+}
+
+// GetLoginMappings mocks base method.
+func (m *IntegrationStorage) GetLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginMappings", ctx, provider)
+	ret0, _ := ret[0].([]model.GitLoginMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginMappings indicates an expected call of GetLoginMappings.
+func (mr *IntegrationStorageMockRecorder) GetLoginMappings(ctx, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginMappings", reflect.TypeOf((*IntegrationStorage)(nil).GetLoginMappings), ctx, provider)
+}
+
+// GetUserIDByLogin mocks base method.
+func (m *IntegrationStorage) GetUserIDByLogin(ctx context.Context, provider model.GitProvider, login string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByLogin", ctx, provider, login)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByLogin indicates an expected call of GetUserIDByLogin.
+func (mr *IntegrationStorageMockRecorder) GetUserIDByLogin(ctx, provider, login interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByLogin", reflect.TypeOf((*IntegrationStorage)(nil).GetUserIDByLogin), ctx, provider, login)
+}
+
+// SaveLoginMapping mocks base method.
+func (m *IntegrationStorage) SaveLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLoginMapping", ctx, mapping)
+	ret0, _ := ret[0].(model.GitLoginMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveLoginMapping indicates an expected call of SaveLoginMapping.
+func (mr *IntegrationStorageMockRecorder) SaveLoginMapping(ctx, mapping interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLoginMapping", reflect.TypeOf((*IntegrationStorage)(nil).SaveLoginMapping), ctx, mapping)
+}
+
+// PullRequestService is a mock of pullRequestService interface.
+type PullRequestService struct {
+	ctrl     *gomock.Controller
+	recorder *PullRequestServiceMockRecorder
+}
+
+// PullRequestServiceMockRecorder is the mock recorder for PullRequestService.
+type PullRequestServiceMockRecorder struct {
+	mock *PullRequestService
+}
+
+// NewPullRequestService creates a new mock instance.
+func NewPullRequestService(ctrl *gomock.Controller) *PullRequestService {
+	mock := &PullRequestService{ctrl: ctrl}
+	mock.recorder = &PullRequestServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PullRequestService) EXPECT() *PullRequestServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePullRequest mocks base method.
+func (m *PullRequestService) CreatePullRequest(ctx context.Context, request model.PullRequest, preferences model.ReviewerPreferences) (model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePullRequest", ctx, request, preferences)
+	ret0, _ := ret[0].(model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePullRequest indicates an expected call of CreatePullRequest.
+func (mr *PullRequestServiceMockRecorder) CreatePullRequest(ctx, request, preferences interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePullRequest", reflect.TypeOf((*PullRequestService)(nil).CreatePullRequest), ctx, request, preferences)
+}
+
+// GetPullRequest mocks base method.
+func (m *PullRequestService) GetPullRequest(ctx context.Context, id string) (model.PullRequestDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequest", ctx, id)
+	ret0, _ := ret[0].(model.PullRequestDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequest indicates an expected call of GetPullRequest.
+func (mr *PullRequestServiceMockRecorder) GetPullRequest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequest", reflect.TypeOf((*PullRequestService)(nil).GetPullRequest), ctx, id)
+}
+
+// MergePullRequest mocks base method.
+func (m *PullRequestService) MergePullRequest(ctx context.Context, id string) (model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergePullRequest", ctx, id)
+	ret0, _ := ret[0].(model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergePullRequest indicates an expected call of MergePullRequest.
+func (mr *PullRequestServiceMockRecorder) MergePullRequest(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*PullRequestService)(nil).MergePullRequest), ctx, id)
+}
@@ -35,6 +35,21 @@ func (m *TeamStorage) EXPECT() *TeamStorageMockRecorder {
 	return m.recorder
 }
 
+// GetTeamByName mocks base method.
+func (m *TeamStorage) GetTeamByName(ctx context.Context, name string) (model.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamByName", ctx, name)
+	ret0, _ := ret[0].(model.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamByName indicates an expected call of GetTeamByName.
+func (mr *TeamStorageMockRecorder) GetTeamByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*TeamStorage)(nil).GetTeamByName), ctx, name)
+}
+
 // GetTeamByUserID mocks base method.
 func (m *TeamStorage) GetTeamByUserID(ctx context.Context, userID string) (model.Team, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +65,20 @@ func (mr *TeamStorageMockRecorder) GetTeamByUserID(ctx, userID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByUserID", reflect.TypeOf((*TeamStorage)(nil).GetTeamByUserID), ctx, userID)
 }
 
+// UpdateRoundRobinCursor mocks base method.
+func (m *TeamStorage) UpdateRoundRobinCursor(ctx context.Context, name, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoundRobinCursor", ctx, name, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRoundRobinCursor indicates an expected call of UpdateRoundRobinCursor.
+func (mr *TeamStorageMockRecorder) UpdateRoundRobinCursor(ctx, name, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoundRobinCursor", reflect.TypeOf((*TeamStorage)(nil).UpdateRoundRobinCursor), ctx, name, userID)
+}
+
 // PullRequestStorage is a mock of pullRequestStorage interface.
 type PullRequestStorage struct {
 	ctrl     *gomock.Controller
@@ -73,6 +102,66 @@ func (m *PullRequestStorage) EXPECT() *PullRequestStorageMockRecorder {
 	return m.recorder
 }
 
+// DeleteOpenPullRequestsByAuthors mocks base method.
+func (m *PullRequestStorage) DeleteOpenPullRequestsByAuthors(ctx context.Context, authorIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOpenPullRequestsByAuthors", ctx, authorIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOpenPullRequestsByAuthors indicates an expected call of DeleteOpenPullRequestsByAuthors.
+func (mr *PullRequestStorageMockRecorder) DeleteOpenPullRequestsByAuthors(ctx, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenPullRequestsByAuthors", reflect.TypeOf((*PullRequestStorage)(nil).DeleteOpenPullRequestsByAuthors), ctx, authorIDs)
+}
+
+// GetAssignmentEvents mocks base method.
+func (m *PullRequestStorage) GetAssignmentEvents(ctx context.Context, pullRequestID string) ([]model.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentEvents", ctx, pullRequestID)
+	ret0, _ := ret[0].([]model.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentEvents indicates an expected call of GetAssignmentEvents.
+func (mr *PullRequestStorageMockRecorder) GetAssignmentEvents(ctx, pullRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentEvents", reflect.TypeOf((*PullRequestStorage)(nil).GetAssignmentEvents), ctx, pullRequestID)
+}
+
+// GetOpenPullRequestsByReviewers mocks base method.
+func (m *PullRequestStorage) GetOpenPullRequestsByReviewers(ctx context.Context, ids []string) ([]model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPullRequestsByReviewers", ctx, ids)
+	ret0, _ := ret[0].([]model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPullRequestsByReviewers indicates an expected call of GetOpenPullRequestsByReviewers.
+func (mr *PullRequestStorageMockRecorder) GetOpenPullRequestsByReviewers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPullRequestsByReviewers", reflect.TypeOf((*PullRequestStorage)(nil).GetOpenPullRequestsByReviewers), ctx, ids)
+}
+
+// GetOpenReviewCounts mocks base method.
+func (m *PullRequestStorage) GetOpenReviewCounts(ctx context.Context, reviewerIDs []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReviewCounts", ctx, reviewerIDs)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReviewCounts indicates an expected call of GetOpenReviewCounts.
+func (mr *PullRequestStorageMockRecorder) GetOpenReviewCounts(ctx, reviewerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReviewCounts", reflect.TypeOf((*PullRequestStorage)(nil).GetOpenReviewCounts), ctx, reviewerIDs)
+}
+
 // GetPullRequestByID mocks base method.
 func (m *PullRequestStorage) GetPullRequestByID(ctx context.Context, id string) (model.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +177,66 @@ func (mr *PullRequestStorageMockRecorder) GetPullRequestByID(ctx, id interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestByID", reflect.TypeOf((*PullRequestStorage)(nil).GetPullRequestByID), ctx, id)
 }
 
+// GetPullRequestReviewers mocks base method.
+func (m *PullRequestStorage) GetPullRequestReviewers(ctx context.Context, id string) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestReviewers", ctx, id)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestReviewers indicates an expected call of GetPullRequestReviewers.
+func (mr *PullRequestStorageMockRecorder) GetPullRequestReviewers(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestReviewers", reflect.TypeOf((*PullRequestStorage)(nil).GetPullRequestReviewers), ctx, id)
+}
+
+// GetPullRequestsNeedingReviewers mocks base method.
+func (m *PullRequestStorage) GetPullRequestsNeedingReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestsNeedingReviewers", ctx, teamName)
+	ret0, _ := ret[0].([]model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestsNeedingReviewers indicates an expected call of GetPullRequestsNeedingReviewers.
+func (mr *PullRequestStorageMockRecorder) GetPullRequestsNeedingReviewers(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsNeedingReviewers", reflect.TypeOf((*PullRequestStorage)(nil).GetPullRequestsNeedingReviewers), ctx, teamName)
+}
+
+// HasMergedPullRequests mocks base method.
+func (m *PullRequestStorage) HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMergedPullRequests", ctx, userIDs)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMergedPullRequests indicates an expected call of HasMergedPullRequests.
+func (mr *PullRequestStorageMockRecorder) HasMergedPullRequests(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMergedPullRequests", reflect.TypeOf((*PullRequestStorage)(nil).HasMergedPullRequests), ctx, userIDs)
+}
+
+// InsertAssignmentEvents mocks base method.
+func (m *PullRequestStorage) InsertAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAssignmentEvents", ctx, events)
+	ret0, _ := ret[0].([]model.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertAssignmentEvents indicates an expected call of InsertAssignmentEvents.
+func (mr *PullRequestStorageMockRecorder) InsertAssignmentEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAssignmentEvents", reflect.TypeOf((*PullRequestStorage)(nil).InsertAssignmentEvents), ctx, events)
+}
+
 // InsertPullRequest mocks base method.
 func (m *PullRequestStorage) InsertPullRequest(ctx context.Context, request model.PullRequest) (model.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +252,65 @@ func (mr *PullRequestStorageMockRecorder) InsertPullRequest(ctx, request interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPullRequest", reflect.TypeOf((*PullRequestStorage)(nil).InsertPullRequest), ctx, request)
 }
 
+// ListPullRequests mocks base method.
+func (m *PullRequestStorage) ListPullRequests(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", ctx, filter)
+	ret0, _ := ret[0].([]model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *PullRequestStorageMockRecorder) ListPullRequests(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*PullRequestStorage)(nil).ListPullRequests), ctx, filter)
+}
+
+// RefreshNeedMoreReviewers mocks base method.
+func (m *PullRequestStorage) RefreshNeedMoreReviewers(ctx context.Context, teamName string, minReviewers int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshNeedMoreReviewers", ctx, teamName, minReviewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshNeedMoreReviewers indicates an expected call of RefreshNeedMoreReviewers.
+func (mr *PullRequestStorageMockRecorder) RefreshNeedMoreReviewers(ctx, teamName, minReviewers interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshNeedMoreReviewers", reflect.TypeOf((*PullRequestStorage)(nil).RefreshNeedMoreReviewers), ctx, teamName, minReviewers)
+}
+
+// RemoveOpenReviewers mocks base method.
+func (m *PullRequestStorage) RemoveOpenReviewers(ctx context.Context, reviewerIDs []string) ([]model.ReviewerReplacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOpenReviewers", ctx, reviewerIDs)
+	ret0, _ := ret[0].([]model.ReviewerReplacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveOpenReviewers indicates an expected call of RemoveOpenReviewers.
+func (mr *PullRequestStorageMockRecorder) RemoveOpenReviewers(ctx, reviewerIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOpenReviewers", reflect.TypeOf((*PullRequestStorage)(nil).RemoveOpenReviewers), ctx, reviewerIDs)
+}
+
+// ReplaceReviewers mocks base method.
+func (m *PullRequestStorage) ReplaceReviewers(ctx context.Context, reassignments []model.Reassignment) ([]model.Reassignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceReviewers", ctx, reassignments)
+	ret0, _ := ret[0].([]model.Reassignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceReviewers indicates an expected call of ReplaceReviewers.
+func (mr *PullRequestStorageMockRecorder) ReplaceReviewers(ctx, reassignments interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceReviewers", reflect.TypeOf((*PullRequestStorage)(nil).ReplaceReviewers), ctx, reassignments)
+}
+
 // UpdatePullRequestInfo mocks base method.
 func (m *PullRequestStorage) UpdatePullRequestInfo(ctx context.Context, req model.PullRequest) (model.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -132,3 +340,111 @@ func (mr *PullRequestStorageMockRecorder) UpdatePullRequestReviewers(ctx, req in
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequestReviewers", reflect.TypeOf((*PullRequestStorage)(nil).UpdatePullRequestReviewers), ctx, req)
 }
+
+// Notifier is a mock of notifier interface.
+type Notifier struct {
+	ctrl     *gomock.Controller
+	recorder *NotifierMockRecorder
+}
+
+// NotifierMockRecorder is the mock recorder for Notifier.
+type NotifierMockRecorder struct {
+	mock *Notifier
+}
+
+// NewNotifier creates a new mock instance.
+func NewNotifier(ctrl *gomock.Controller) *Notifier {
+	mock := &Notifier{ctrl: ctrl}
+	mock.recorder = &NotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Notifier) EXPECT() *NotifierMockRecorder {
+	return m.recorder
+}
+
+// EnqueueAssignmentEvents mocks base method.
+func (m *Notifier) EnqueueAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueAssignmentEvents", ctx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueAssignmentEvents indicates an expected call of EnqueueAssignmentEvents.
+func (mr *NotifierMockRecorder) EnqueueAssignmentEvents(ctx, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueAssignmentEvents", reflect.TypeOf((*Notifier)(nil).EnqueueAssignmentEvents), ctx, events)
+}
+
+// Recorder is a mock of recorder interface.
+type Recorder struct {
+	ctrl     *gomock.Controller
+	recorder *RecorderMockRecorder
+}
+
+// RecorderMockRecorder is the mock recorder for Recorder.
+type RecorderMockRecorder struct {
+	mock *Recorder
+}
+
+// NewRecorder creates a new mock instance.
+func NewRecorder(ctrl *gomock.Controller) *Recorder {
+	mock := &Recorder{ctrl: ctrl}
+	mock.recorder = &RecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Recorder) EXPECT() *RecorderMockRecorder {
+	return m.recorder
+}
+
+// NoReassignmentCandidate mocks base method.
+func (m *Recorder) NoReassignmentCandidate() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NoReassignmentCandidate")
+}
+
+// NoReassignmentCandidate indicates an expected call of NoReassignmentCandidate.
+func (mr *RecorderMockRecorder) NoReassignmentCandidate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoReassignmentCandidate", reflect.TypeOf((*Recorder)(nil).NoReassignmentCandidate))
+}
+
+// PullRequestCreated mocks base method.
+func (m *Recorder) PullRequestCreated() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PullRequestCreated")
+}
+
+// PullRequestCreated indicates an expected call of PullRequestCreated.
+func (mr *RecorderMockRecorder) PullRequestCreated() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestCreated", reflect.TypeOf((*Recorder)(nil).PullRequestCreated))
+}
+
+// PullRequestMerged mocks base method.
+func (m *Recorder) PullRequestMerged() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PullRequestMerged")
+}
+
+// PullRequestMerged indicates an expected call of PullRequestMerged.
+func (mr *RecorderMockRecorder) PullRequestMerged() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestMerged", reflect.TypeOf((*Recorder)(nil).PullRequestMerged))
+}
+
+// ReviewerReassigned mocks base method.
+func (m *Recorder) ReviewerReassigned() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReviewerReassigned")
+}
+
+// ReviewerReassigned indicates an expected call of ReviewerReassigned.
+func (mr *RecorderMockRecorder) ReviewerReassigned() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewerReassigned", reflect.TypeOf((*Recorder)(nil).ReviewerReassigned))
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// StatsStorage is a mock of storage interface.
+type StatsStorage struct {
+	ctrl     *gomock.Controller
+	recorder *StatsStorageMockRecorder
+}
+
+// StatsStorageMockRecorder is the mock recorder for StatsStorage.
+type StatsStorageMockRecorder struct {
+	mock *StatsStorage
+}
+
+// NewStatsStorage creates a new mock instance.
+func NewStatsStorage(ctrl *gomock.Controller) *StatsStorage {
+	mock := &StatsStorage{ctrl: ctrl}
+	mock.recorder = &StatsStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *StatsStorage) EXPECT() *StatsStorageMockRecorder {
+	return m.recorder
+}
+
+// GetPullRequestStats mocks base method.
+func (m *StatsStorage) GetPullRequestStats(ctx context.Context, filter model.StatsFilter) ([]model.PullRequestStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestStats", ctx, filter)
+	ret0, _ := ret[0].([]model.PullRequestStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestStats indicates an expected call of GetPullRequestStats.
+func (mr *StatsStorageMockRecorder) GetPullRequestStats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestStats", reflect.TypeOf((*StatsStorage)(nil).GetPullRequestStats), ctx, filter)
+}
+
+// GetReviewerStats mocks base method.
+func (m *StatsStorage) GetReviewerStats(ctx context.Context, filter model.StatsFilter) ([]model.ReviewerStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewerStats", ctx, filter)
+	ret0, _ := ret[0].([]model.ReviewerStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewerStats indicates an expected call of GetReviewerStats.
+func (mr *StatsStorageMockRecorder) GetReviewerStats(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewerStats", reflect.TypeOf((*StatsStorage)(nil).GetReviewerStats), ctx, filter)
+}
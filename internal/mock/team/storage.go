@@ -35,6 +35,65 @@ func (m *UserStorage) EXPECT() *UserStorageMockRecorder {
 	return m.recorder
 }
 
+// DeactivateUsers mocks base method.
+func (m *UserStorage) DeactivateUsers(ctx context.Context, teamName *string, ids []string) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUsers", ctx, teamName, ids)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUsers indicates an expected call of DeactivateUsers.
+func (mr *UserStorageMockRecorder) DeactivateUsers(ctx, teamName, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUsers", reflect.TypeOf((*UserStorage)(nil).DeactivateUsers), ctx, teamName, ids)
+}
+
+// DeleteUsers mocks base method.
+func (m *UserStorage) DeleteUsers(ctx context.Context, ids []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUsers", ctx, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUsers indicates an expected call of DeleteUsers.
+func (mr *UserStorageMockRecorder) DeleteUsers(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUsers", reflect.TypeOf((*UserStorage)(nil).DeleteUsers), ctx, ids)
+}
+
+// GetUsersByIDs mocks base method.
+func (m *UserStorage) GetUsersByIDs(ctx context.Context, ids []string) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersByIDs", ctx, ids)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersByIDs indicates an expected call of GetUsersByIDs.
+func (mr *UserStorageMockRecorder) GetUsersByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersByIDs", reflect.TypeOf((*UserStorage)(nil).GetUsersByIDs), ctx, ids)
+}
+
+// MoveUsers mocks base method.
+func (m *UserStorage) MoveUsers(ctx context.Context, fromTeam string, toTeam *string) ([]model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveUsers", ctx, fromTeam, toTeam)
+	ret0, _ := ret[0].([]model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveUsers indicates an expected call of MoveUsers.
+func (mr *UserStorageMockRecorder) MoveUsers(ctx, fromTeam, toTeam interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUsers", reflect.TypeOf((*UserStorage)(nil).MoveUsers), ctx, fromTeam, toTeam)
+}
+
 // SaveUsers mocks base method.
 func (m *UserStorage) SaveUsers(ctx context.Context, users []model.User) ([]model.User, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +109,21 @@ func (mr *UserStorageMockRecorder) SaveUsers(ctx, users interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUsers", reflect.TypeOf((*UserStorage)(nil).SaveUsers), ctx, users)
 }
 
+// UpdateTeam mocks base method.
+func (m *UserStorage) UpdateTeam(ctx context.Context, id string, teamName *string) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, id, teamName)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *UserStorageMockRecorder) UpdateTeam(ctx, id, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*UserStorage)(nil).UpdateTeam), ctx, id, teamName)
+}
+
 // TeamStorage is a mock of teamStorage interface.
 type TeamStorage struct {
 	ctrl     *gomock.Controller
@@ -73,6 +147,20 @@ func (m *TeamStorage) EXPECT() *TeamStorageMockRecorder {
 	return m.recorder
 }
 
+// DeleteTeam mocks base method.
+func (m *TeamStorage) DeleteTeam(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTeam", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTeam indicates an expected call of DeleteTeam.
+func (mr *TeamStorageMockRecorder) DeleteTeam(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTeam", reflect.TypeOf((*TeamStorage)(nil).DeleteTeam), ctx, name)
+}
+
 // GetTeamByName mocks base method.
 func (m *TeamStorage) GetTeamByName(ctx context.Context, name string) (model.Team, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +176,34 @@ func (mr *TeamStorageMockRecorder) GetTeamByName(ctx, name interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByName", reflect.TypeOf((*TeamStorage)(nil).GetTeamByName), ctx, name)
 }
 
+// InsertMembershipChanges mocks base method.
+func (m *TeamStorage) InsertMembershipChanges(ctx context.Context, changes []model.MembershipChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertMembershipChanges", ctx, changes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMembershipChanges indicates an expected call of InsertMembershipChanges.
+func (mr *TeamStorageMockRecorder) InsertMembershipChanges(ctx, changes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMembershipChanges", reflect.TypeOf((*TeamStorage)(nil).InsertMembershipChanges), ctx, changes)
+}
+
+// RenameTeam mocks base method.
+func (m *TeamStorage) RenameTeam(ctx context.Context, oldName, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *TeamStorageMockRecorder) RenameTeam(ctx, oldName, newName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*TeamStorage)(nil).RenameTeam), ctx, oldName, newName)
+}
+
 // SaveTeam mocks base method.
 func (m *TeamStorage) SaveTeam(ctx context.Context, team model.Team) (model.Team, error) {
 	m.ctrl.T.Helper()
@@ -102,3 +218,152 @@ func (mr *TeamStorageMockRecorder) SaveTeam(ctx, team interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeam", reflect.TypeOf((*TeamStorage)(nil).SaveTeam), ctx, team)
 }
+
+// SaveTeamSettings mocks base method.
+func (m *TeamStorage) SaveTeamSettings(ctx context.Context, name string, settings model.TeamSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTeamSettings", ctx, name, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTeamSettings indicates an expected call of SaveTeamSettings.
+func (mr *TeamStorageMockRecorder) SaveTeamSettings(ctx, name, settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTeamSettings", reflect.TypeOf((*TeamStorage)(nil).SaveTeamSettings), ctx, name, settings)
+}
+
+// UpdateReviewerStrategy mocks base method.
+func (m *TeamStorage) UpdateReviewerStrategy(ctx context.Context, name string, strategy model.ReviewerStrategy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReviewerStrategy", ctx, name, strategy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReviewerStrategy indicates an expected call of UpdateReviewerStrategy.
+func (mr *TeamStorageMockRecorder) UpdateReviewerStrategy(ctx, name, strategy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReviewerStrategy", reflect.TypeOf((*TeamStorage)(nil).UpdateReviewerStrategy), ctx, name, strategy)
+}
+
+// ReviewerAssigner is a mock of reviewerAssigner interface.
+type ReviewerAssigner struct {
+	ctrl     *gomock.Controller
+	recorder *ReviewerAssignerMockRecorder
+}
+
+// ReviewerAssignerMockRecorder is the mock recorder for ReviewerAssigner.
+type ReviewerAssignerMockRecorder struct {
+	mock *ReviewerAssigner
+}
+
+// NewReviewerAssigner creates a new mock instance.
+func NewReviewerAssigner(ctrl *gomock.Controller) *ReviewerAssigner {
+	mock := &ReviewerAssigner{ctrl: ctrl}
+	mock.recorder = &ReviewerAssignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ReviewerAssigner) EXPECT() *ReviewerAssignerMockRecorder {
+	return m.recorder
+}
+
+// ReassignReviewers mocks base method.
+func (m *ReviewerAssigner) ReassignReviewers(ctx context.Context, reviewerIDs []string, reason string) ([]model.ReviewerReplacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReassignReviewers", ctx, reviewerIDs, reason)
+	ret0, _ := ret[0].([]model.ReviewerReplacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReassignReviewers indicates an expected call of ReassignReviewers.
+func (mr *ReviewerAssignerMockRecorder) ReassignReviewers(ctx, reviewerIDs, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewers", reflect.TypeOf((*ReviewerAssigner)(nil).ReassignReviewers), ctx, reviewerIDs, reason)
+}
+
+// TopUpReviewers mocks base method.
+func (m *ReviewerAssigner) TopUpReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpReviewers", ctx, teamName)
+	ret0, _ := ret[0].([]model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUpReviewers indicates an expected call of TopUpReviewers.
+func (mr *ReviewerAssignerMockRecorder) TopUpReviewers(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpReviewers", reflect.TypeOf((*ReviewerAssigner)(nil).TopUpReviewers), ctx, teamName)
+}
+
+// UnassignReviewers mocks base method.
+func (m *ReviewerAssigner) UnassignReviewers(ctx context.Context, reviewerIDs []string, reason string) ([]model.ReviewerReplacement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignReviewers", ctx, reviewerIDs, reason)
+	ret0, _ := ret[0].([]model.ReviewerReplacement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignReviewers indicates an expected call of UnassignReviewers.
+func (mr *ReviewerAssignerMockRecorder) UnassignReviewers(ctx, reviewerIDs, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignReviewers", reflect.TypeOf((*ReviewerAssigner)(nil).UnassignReviewers), ctx, reviewerIDs, reason)
+}
+
+// PullRequestRemover is a mock of pullRequestRemover interface.
+type PullRequestRemover struct {
+	ctrl     *gomock.Controller
+	recorder *PullRequestRemoverMockRecorder
+}
+
+// PullRequestRemoverMockRecorder is the mock recorder for PullRequestRemover.
+type PullRequestRemoverMockRecorder struct {
+	mock *PullRequestRemover
+}
+
+// NewPullRequestRemover creates a new mock instance.
+func NewPullRequestRemover(ctrl *gomock.Controller) *PullRequestRemover {
+	mock := &PullRequestRemover{ctrl: ctrl}
+	mock.recorder = &PullRequestRemoverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *PullRequestRemover) EXPECT() *PullRequestRemoverMockRecorder {
+	return m.recorder
+}
+
+// DeleteOpenPullRequestsByAuthors mocks base method.
+func (m *PullRequestRemover) DeleteOpenPullRequestsByAuthors(ctx context.Context, authorIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOpenPullRequestsByAuthors", ctx, authorIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOpenPullRequestsByAuthors indicates an expected call of DeleteOpenPullRequestsByAuthors.
+func (mr *PullRequestRemoverMockRecorder) DeleteOpenPullRequestsByAuthors(ctx, authorIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenPullRequestsByAuthors", reflect.TypeOf((*PullRequestRemover)(nil).DeleteOpenPullRequestsByAuthors), ctx, authorIDs)
+}
+
+// HasMergedPullRequests mocks base method.
+func (m *PullRequestRemover) HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasMergedPullRequests", ctx, userIDs)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasMergedPullRequests indicates an expected call of HasMergedPullRequests.
+func (mr *PullRequestRemoverMockRecorder) HasMergedPullRequests(ctx, userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasMergedPullRequests", reflect.TypeOf((*PullRequestRemover)(nil).HasMergedPullRequests), ctx, userIDs)
+}
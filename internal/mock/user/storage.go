@@ -50,6 +50,21 @@ func (mr *UserStorageMockRecorder) UpdateActivity(ctx, id, activity interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*UserStorage)(nil).UpdateActivity), ctx, id, activity)
 }
 
+// UpdateSeniority mocks base method.
+func (m *UserStorage) UpdateSeniority(ctx context.Context, id string, seniority int) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeniority", ctx, id, seniority)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSeniority indicates an expected call of UpdateSeniority.
+func (mr *UserStorageMockRecorder) UpdateSeniority(ctx, id, seniority interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeniority", reflect.TypeOf((*UserStorage)(nil).UpdateSeniority), ctx, id, seniority)
+}
+
 // PullRequestStorage is a mock of pullRequestStorage interface.
 type PullRequestStorage struct {
 	ctrl     *gomock.Controller
@@ -87,3 +102,41 @@ func (mr *PullRequestStorageMockRecorder) GetPullRequestsByReviewer(ctx, id inte
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestsByReviewer", reflect.TypeOf((*PullRequestStorage)(nil).GetPullRequestsByReviewer), ctx, id)
 }
+
+// ReviewerAssigner is a mock of reviewerAssigner interface.
+type ReviewerAssigner struct {
+	ctrl     *gomock.Controller
+	recorder *ReviewerAssignerMockRecorder
+}
+
+// ReviewerAssignerMockRecorder is the mock recorder for ReviewerAssigner.
+type ReviewerAssignerMockRecorder struct {
+	mock *ReviewerAssigner
+}
+
+// NewReviewerAssigner creates a new mock instance.
+func NewReviewerAssigner(ctrl *gomock.Controller) *ReviewerAssigner {
+	mock := &ReviewerAssigner{ctrl: ctrl}
+	mock.recorder = &ReviewerAssignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *ReviewerAssigner) EXPECT() *ReviewerAssignerMockRecorder {
+	return m.recorder
+}
+
+// TopUpReviewers mocks base method.
+func (m *ReviewerAssigner) TopUpReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TopUpReviewers", ctx, teamName)
+	ret0, _ := ret[0].([]model.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TopUpReviewers indicates an expected call of TopUpReviewers.
+func (mr *ReviewerAssignerMockRecorder) TopUpReviewers(ctx, teamName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TopUpReviewers", reflect.TypeOf((*ReviewerAssigner)(nil).TopUpReviewers), ctx, teamName)
+}
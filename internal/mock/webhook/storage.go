@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: implementation.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// WebhookStorage is a mock of storage interface.
+type WebhookStorage struct {
+	ctrl     *gomock.Controller
+	recorder *WebhookStorageMockRecorder
+}
+
+// WebhookStorageMockRecorder is the mock recorder for WebhookStorage.
+type WebhookStorageMockRecorder struct {
+	mock *WebhookStorage
+}
+
+// NewWebhookStorage creates a new mock instance.
+func NewWebhookStorage(ctrl *gomock.Controller) *WebhookStorage {
+	mock := &WebhookStorage{ctrl: ctrl}
+	mock.recorder = &WebhookStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *WebhookStorage) EXPECT() *WebhookStorageMockRecorder {
+	return m.recorder
+}
+
+// ClaimPendingDeliveries mocks base method.
+func (m *WebhookStorage) ClaimPendingDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPendingDeliveries", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPendingDeliveries indicates an expected call of ClaimPendingDeliveries.
+func (mr *WebhookStorageMockRecorder) ClaimPendingDeliveries(ctx, now, leaseUntil, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPendingDeliveries", reflect.TypeOf((*WebhookStorage)(nil).ClaimPendingDeliveries), ctx, now, leaseUntil, limit)
+}
+
+// DeleteWebhook mocks base method.
+func (m *WebhookStorage) DeleteWebhook(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *WebhookStorageMockRecorder) DeleteWebhook(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*WebhookStorage)(nil).DeleteWebhook), ctx, id)
+}
+
+// GetWebhookSubscriptions mocks base method.
+func (m *WebhookStorage) GetWebhookSubscriptions(ctx context.Context, pullRequestIDs []string) ([]model.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookSubscriptions", ctx, pullRequestIDs)
+	ret0, _ := ret[0].([]model.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhookSubscriptions indicates an expected call of GetWebhookSubscriptions.
+func (mr *WebhookStorageMockRecorder) GetWebhookSubscriptions(ctx, pullRequestIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookSubscriptions", reflect.TypeOf((*WebhookStorage)(nil).GetWebhookSubscriptions), ctx, pullRequestIDs)
+}
+
+// GetWebhooks mocks base method.
+func (m *WebhookStorage) GetWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", ctx)
+	ret0, _ := ret[0].([]model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *WebhookStorageMockRecorder) GetWebhooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*WebhookStorage)(nil).GetWebhooks), ctx)
+}
+
+// InsertDeliveries mocks base method.
+func (m *WebhookStorage) InsertDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDeliveries", ctx, deliveries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDeliveries indicates an expected call of InsertDeliveries.
+func (mr *WebhookStorageMockRecorder) InsertDeliveries(ctx, deliveries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDeliveries", reflect.TypeOf((*WebhookStorage)(nil).InsertDeliveries), ctx, deliveries)
+}
+
+// InsertWebhook mocks base method.
+func (m *WebhookStorage) InsertWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhook", ctx, webhook)
+	ret0, _ := ret[0].(model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWebhook indicates an expected call of InsertWebhook.
+func (mr *WebhookStorageMockRecorder) InsertWebhook(ctx, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhook", reflect.TypeOf((*WebhookStorage)(nil).InsertWebhook), ctx, webhook)
+}
+
+// UpdateDelivery mocks base method.
+func (m *WebhookStorage) UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *WebhookStorageMockRecorder) UpdateDelivery(ctx, delivery interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*WebhookStorage)(nil).UpdateDelivery), ctx, delivery)
+}
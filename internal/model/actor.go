@@ -0,0 +1,23 @@
+package model
+
+import "context"
+
+// ActorAnonymous is recorded when a change is made without a known caller.
+const ActorAnonymous = "anonymous"
+
+type actorContextKey struct{}
+
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+// ActorFromContext returns the caller who initiated the request
+// or ActorAnonymous if none was attached.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := ctx.Value(actorContextKey{}).(string)
+	if !ok || actor == "" {
+		return ActorAnonymous
+	}
+
+	return actor
+}
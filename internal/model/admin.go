@@ -8,7 +8,12 @@ var (
 	ErrInvalidAdminPassword = errors.New("invalid password")
 )
 
+// Admin is an account that can log in to the API.
+// TeamName is set for team leads and UserID for members.
 type Admin struct {
 	ID           string
 	PasswordHash string
+	Role         Role
+	TeamName     *string
+	UserID       *string
 }
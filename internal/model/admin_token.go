@@ -0,0 +1,48 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrAdminDeletesSelf    = errors.New("admin cannot delete itself")
+)
+
+// TokenPair is issued on login and on every refresh.
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// RefreshToken is a long-lived token exchanged for a new TokenPair.
+// Only its hash is stored.
+type RefreshToken struct {
+	TokenHash string
+	AdminID   string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// AccessToken identifies a verified access token.
+// ID is empty for tokens issued before token ids were introduced.
+type AccessToken struct {
+	ID        string
+	AdminID   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+type accessTokenContextKey struct{}
+
+func ContextWithAccessToken(ctx context.Context, token AccessToken) context.Context {
+	return context.WithValue(ctx, accessTokenContextKey{}, token)
+}
+
+func AccessTokenFromContext(ctx context.Context) (AccessToken, bool) {
+	token, ok := ctx.Value(accessTokenContextKey{}).(AccessToken)
+	return token, ok
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrAPIKeyDoesNotExist = errors.New("api key does not exist")
+	ErrInvalidAPIKey      = errors.New("invalid api key")
+)
+
+// APIKey lets non-interactive clients call the API without logging in.
+// Only the bcrypt hash of the secret part of the key is stored.
+type APIKey struct {
+	ID        string
+	Name      string
+	KeyHash   string
+	Scopes    []APIKeyScope
+	CreatedBy *string
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
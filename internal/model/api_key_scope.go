@@ -0,0 +1,7 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// APIKeyScope decides which endpoints an API key may call.
+// ENUM(PrRead=pr:read, PrWrite=pr:write, TeamRead=team:read, TeamWrite=team:write, UserRead=user:read, UserAdmin=user:admin)
+type APIKeyScope string
@@ -0,0 +1,59 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+import "time"
+
+// AssignmentEventType is a kind of reviewer assignment change.
+// ENUM(Assigned=ASSIGNED, Reassigned=REASSIGNED, Unassigned=UNASSIGNED, Merged=MERGED)
+type AssignmentEventType string
+
+const (
+	ReasonPullRequestCreated  = "pull request created"
+	ReasonReassignRequested   = "reassign requested"
+	ReasonReviewerDeactivated = "reviewer deactivated"
+	ReasonReviewerLeftTeam    = "reviewer left team"
+	ReasonTeammateAvailable   = "teammate became available"
+	ReasonPullRequestMerged   = "pull request merged"
+	ReasonTeamDeleted         = "team deleted"
+)
+
+type AssignmentEvent struct {
+	PullRequestID string
+	Type          AssignmentEventType
+	Actor         string
+	OldReviewerID *string
+	NewReviewerID *string
+	Reason        string
+	CreatedAt     time.Time
+}
+
+// I use this in collection.Map
+
+func (e AssignmentEvent) GetPullRequestID() string {
+	return e.PullRequestID
+}
+
+func (e AssignmentEvent) GetType() AssignmentEventType {
+	return e.Type
+}
+
+func (e AssignmentEvent) GetActor() string {
+	return e.Actor
+}
+
+func (e AssignmentEvent) GetOldReviewerID() *string {
+	return e.OldReviewerID
+}
+
+func (e AssignmentEvent) GetNewReviewerID() *string {
+	return e.NewReviewerID
+}
+
+func (e AssignmentEvent) GetReason() string {
+	return e.Reason
+}
+
+func (e AssignmentEvent) GetCreatedAt() time.Time {
+	return e.CreatedAt
+}
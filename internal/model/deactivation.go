@@ -0,0 +1,17 @@
+package model
+
+type TeamDeactivation struct {
+	TeamName *string
+	UserIDs  []string
+}
+
+type ReviewerReplacement struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID *string
+}
+
+type DeactivatedTeam struct {
+	Users        []User
+	Replacements []ReviewerReplacement
+}
@@ -0,0 +1,24 @@
+package model
+
+import "errors"
+
+var (
+	ErrGitLoginNotMapped = errors.New("git login is not mapped to a user")
+)
+
+// GitLoginMapping links an account on a git hosting to a user.
+type GitLoginMapping struct {
+	Provider GitProvider
+	Login    string
+	UserID   string
+}
+
+// GitPullRequestEvent is a pull request webhook of a git hosting
+// reduced to what the service needs.
+type GitPullRequestEvent struct {
+	Provider        GitProvider
+	Action          GitPullRequestAction
+	PullRequestID   string
+	PullRequestName string
+	AuthorLogin     string
+}
@@ -0,0 +1,7 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// GitProvider is a git hosting that sends pull request webhooks.
+// ENUM(Github=GITHUB, Gitlab=GITLAB)
+type GitProvider string
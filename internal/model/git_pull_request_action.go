@@ -0,0 +1,8 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// GitPullRequestAction is a pull request change reported by a git hosting
+// that the service reacts to.
+// ENUM(Opened=OPENED, Merged=MERGED)
+type GitPullRequestAction string
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrIdempotencyKeyDoesNotExist = errors.New("idempotency key does not exist")
+	ErrIdempotencyKeyReused       = errors.New("idempotency key is reused with a different request")
+	ErrIdempotencyKeyInProgress   = errors.New("request with the idempotency key is in progress")
+)
+
+// IdempotencyRecord is the first request a caller sent with an Idempotency-Key.
+// Response is nil while that request is in progress. The record is replaced
+// by the next request with the key once ExpiresAt has passed.
+type IdempotencyRecord struct {
+	CallerID    string
+	Key         string
+	RequestHash string
+	Response    *IdempotentResponse
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+type IdempotentResponse struct {
+	StatusCode int
+	Body       []byte
+}
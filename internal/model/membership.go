@@ -0,0 +1,48 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrUserAlreadyMember = errors.New("user is already a team member")
+	ErrUserNotMember     = errors.New("user is not a team member")
+)
+
+// MembershipChange describes a user moving into, out of or between teams.
+// A nil team name means the user had or has no team.
+type MembershipChange struct {
+	UserID      string
+	OldTeamName *string
+	NewTeamName *string
+	Actor       string
+	ChangedAt   time.Time
+}
+
+type RemovedMember struct {
+	User         User
+	Replacements []ReviewerReplacement
+}
+
+// I use this in collection.Map
+
+func (c MembershipChange) GetUserID() string {
+	return c.UserID
+}
+
+func (c MembershipChange) GetOldTeamName() *string {
+	return c.OldTeamName
+}
+
+func (c MembershipChange) GetNewTeamName() *string {
+	return c.NewTeamName
+}
+
+func (c MembershipChange) GetActor() string {
+	return c.Actor
+}
+
+func (c MembershipChange) GetChangedAt() time.Time {
+	return c.ChangedAt
+}
@@ -0,0 +1,8 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// OpenPullRequestsPolicy decides what happens to OPEN pull requests
+// authored or reviewed by the users of a deleted team.
+// ENUM(Keep=KEEP, Unassign=UNASSIGN, Delete=DELETE)
+type OpenPullRequestsPolicy string
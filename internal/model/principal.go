@@ -0,0 +1,62 @@
+package model
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrForbidden = errors.New("access denied")
+)
+
+// Principal is the authenticated account or API key that made the request.
+// API keys act as service bots limited by their Scopes instead of the role.
+type Principal struct {
+	ID       string
+	Role     Role
+	TeamName *string
+	UserID   *string
+	APIKey   bool
+	Scopes   []APIKeyScope
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// AuthorizeTeam allows team leads to change only their own team.
+// Calls without a principal are internal and are not restricted,
+// the role itself is checked by the router.
+func AuthorizeTeam(ctx context.Context, teamName string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Role != RoleTeamLead {
+		return nil
+	}
+
+	if principal.TeamName == nil || *principal.TeamName != teamName {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
+// AuthorizeUser allows members to act only on their own behalf.
+func AuthorizeUser(ctx context.Context, userID string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok || principal.Role != RoleMember {
+		return nil
+	}
+
+	if principal.UserID == nil || *principal.UserID != userID {
+		return ErrForbidden
+	}
+
+	return nil
+}
@@ -11,14 +11,19 @@ var (
 	ErrPullRequestIsMerged      = errors.New("pull request is merged")
 	ErrReviewerNotAssign        = errors.New("not assigned reviewer")
 	ErrNoCandidate              = errors.New("no candidate to reassign")
+	ErrPullRequestConflict      = errors.New("pull request was modified concurrently")
 )
 
 type PullRequest struct {
-	ID           string
-	Name         string
-	AuthorID     string
-	Status       Status
-	ReviewersIDs []string
+	ID                string
+	Name              string
+	AuthorID          string
+	Status            Status
+	ReviewersIDs      []string
+	NeedMoreReviewers bool
+	// Version grows with every change of the pull request, an update
+	// of a stale version fails with ErrPullRequestConflict.
+	Version int
 
 	CreatedAt *time.Time
 	MergedAt  *time.Time
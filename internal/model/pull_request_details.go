@@ -0,0 +1,7 @@
+package model
+
+type PullRequestDetails struct {
+	PullRequest PullRequest
+	Author      User
+	Reviewers   []User
+}
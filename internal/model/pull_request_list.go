@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+// PullRequestCursor points at the last pull request of a page
+// in (created_at, id) descending order.
+type PullRequestCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+type PullRequestFilter struct {
+	Status      *Status
+	AuthorID    *string
+	ReviewerID  *string
+	TeamName    *string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	After *PullRequestCursor
+	Limit int
+}
+
+type PullRequestPage struct {
+	PullRequests []PullRequest
+	Next         *PullRequestCursor
+}
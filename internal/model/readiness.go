@@ -0,0 +1,36 @@
+package model
+
+// PoolStats is a snapshot of the database connection pool.
+type PoolStats struct {
+	AcquiredConns int32
+	IdleConns     int32
+	TotalConns    int32
+	MaxConns      int32
+}
+
+// Saturation is the share of the pool size taken by acquired connections.
+func (s PoolStats) Saturation() float64 {
+	if s.MaxConns == 0 {
+		return 0
+	}
+
+	return float64(s.AcquiredConns) / float64(s.MaxConns)
+}
+
+// Readiness tells whether the instance may receive traffic.
+type Readiness struct {
+	ShuttingDown             bool
+	DatabaseErr              error
+	MigrationErr             error
+	ExpectedMigrationVersion int
+	AppliedMigrationVersion  int
+	Pool                     PoolStats
+}
+
+func (r Readiness) MigrationsApplied() bool {
+	return r.MigrationErr == nil && r.AppliedMigrationVersion >= r.ExpectedMigrationVersion
+}
+
+func (r Readiness) Ready() bool {
+	return !r.ShuttingDown && r.DatabaseErr == nil && r.MigrationsApplied()
+}
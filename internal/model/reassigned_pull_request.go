@@ -3,12 +3,13 @@ package model
 import "time"
 
 type ReassignedPullRequest struct {
-	ID           string
-	Name         string
-	AuthorID     string
-	Status       Status
-	ReviewersIDs []string
-	ReassignedBy string
+	ID                string
+	Name              string
+	AuthorID          string
+	Status            Status
+	ReviewersIDs      []string
+	NeedMoreReviewers bool
+	ReassignedBy      string
 
 	CreatedAt *time.Time
 	MergedAt  *time.Time
@@ -0,0 +1,24 @@
+package model
+
+type Reassignment struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+	// Version is the version of the pull request the reassignment
+	// was planned on.
+	Version int
+}
+
+// I use this in collection.Map
+
+func (r Reassignment) GetPullRequestID() string {
+	return r.PullRequestID
+}
+
+func (r Reassignment) GetOldReviewerID() string {
+	return r.OldReviewerID
+}
+
+func (r Reassignment) GetNewReviewerID() string {
+	return r.NewReviewerID
+}
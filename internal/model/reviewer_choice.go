@@ -0,0 +1,20 @@
+package model
+
+import "errors"
+
+var (
+	ErrReviewerNotInTeam       = errors.New("reviewer is not a member of the team")
+	ErrReviewerIsAuthor        = errors.New("reviewer is the author of the pull request")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer is already assigned")
+	ErrReviewerInactive        = errors.New("reviewer is inactive")
+	ErrTooManyReviewers        = errors.New("too many preferred reviewers")
+)
+
+// ReviewerPreferences narrow the choice of reviewers on creation:
+// Preferred ones are assigned first, Excluded ones are never picked.
+// A non-nil Count overrides the number of reviewers set for the team.
+type ReviewerPreferences struct {
+	Count     *int
+	Preferred []string
+	Excluded  []string
+}
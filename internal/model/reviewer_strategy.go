@@ -0,0 +1,7 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// ReviewerStrategy is a way of picking reviewers among team candidates.
+// ENUM(Random=RANDOM, LeastLoaded=LEAST_LOADED, RoundRobin=ROUND_ROBIN, Seniority=SENIORITY)
+type ReviewerStrategy string
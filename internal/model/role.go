@@ -0,0 +1,7 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// Role decides which endpoints an account may call.
+// ENUM(Admin=ADMIN, TeamLead=TEAM_LEAD, Member=MEMBER, ServiceBot=SERVICE_BOT)
+type Role string
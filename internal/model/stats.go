@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+type StatsFilter struct {
+	TeamName *string
+	From     *time.Time
+	To       *time.Time
+}
+
+type ReviewerStats struct {
+	UserID         string
+	Username       string
+	TeamName       string
+	OpenCount      int
+	MergedCount    int
+	TotalCount     int
+	ReassignedAway int
+}
+
+type PullRequestStats struct {
+	ID             string
+	Name           string
+	AuthorID       string
+	Status         Status
+	ReviewersCount int
+}
+
+type Stats struct {
+	Reviewers    []ReviewerStats
+	PullRequests []PullRequestStats
+}
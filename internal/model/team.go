@@ -8,6 +8,9 @@ var (
 )
 
 type Team struct {
-	Name    string
-	Members []User
+	Name             string
+	ReviewerStrategy ReviewerStrategy
+	RoundRobinCursor *string
+	Settings         TeamSettings
+	Members          []User
 }
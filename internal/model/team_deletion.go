@@ -0,0 +1,20 @@
+package model
+
+import "errors"
+
+var (
+	ErrUsersHaveHistory = errors.New("users have merged pull requests")
+)
+
+type TeamDeletion struct {
+	TeamName           string
+	UsersPolicy        TeamUsersPolicy
+	TargetTeamName     *string
+	PullRequestsPolicy OpenPullRequestsPolicy
+}
+
+type DeletedTeam struct {
+	Users                 []User
+	DeletedPullRequestIDs []string
+	Unassignments         []ReviewerReplacement
+}
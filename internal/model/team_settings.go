@@ -0,0 +1,31 @@
+package model
+
+import "errors"
+
+var ErrInvalidReviewersCount = errors.New("reviewers count is out of the team range")
+
+const (
+	DefaultMinReviewers = 2
+	DefaultMaxReviewers = 2
+)
+
+// TeamSettings bound the number of reviewers of the team pull requests.
+// A pull request gets MaxReviewers on creation unless a count within
+// the bounds is asked for, and needs more while it has fewer than MinReviewers.
+type TeamSettings struct {
+	MinReviewers int
+	MaxReviewers int
+}
+
+// DefaultTeamSettings are used for teams that were never configured.
+func DefaultTeamSettings() TeamSettings {
+	return TeamSettings{
+		MinReviewers: DefaultMinReviewers,
+		MaxReviewers: DefaultMaxReviewers,
+	}
+}
+
+// Allows reports whether count reviewers fit the bounds.
+func (s TeamSettings) Allows(count int) bool {
+	return count >= s.MinReviewers && count <= s.MaxReviewers
+}
@@ -0,0 +1,7 @@
+//go:generate go-enum --output-suffix=.generated
+
+package model
+
+// TeamUsersPolicy decides what happens to the users of a deleted team.
+// ENUM(Deactivate=DEACTIVATE, Move=MOVE, Delete=DELETE)
+type TeamUsersPolicy string
@@ -7,10 +7,11 @@ var (
 )
 
 type User struct {
-	ID       string
-	Name     string
-	TeamName string
-	IsActive bool
+	ID        string
+	Name      string
+	TeamName  string
+	IsActive  bool
+	Seniority int
 }
 
 // I use this in collection.Map
@@ -30,3 +31,7 @@ func (u User) GetTeamName() string {
 func (u User) GetIsActive() bool {
 	return u.IsActive
 }
+
+func (u User) GetSeniority() int {
+	return u.Seniority
+}
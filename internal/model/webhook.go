@@ -0,0 +1,62 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrWebhookDoesNotExist = errors.New("webhook does not exist")
+)
+
+// Webhook is a subscription to assignment events.
+// A nil TeamName subscribes to events of every team.
+type Webhook struct {
+	ID        int64
+	URL       string
+	TeamName  *string
+	Secret    string
+	CreatedAt time.Time
+}
+
+// WebhookSubscription pairs a pull request with a webhook
+// interested in it. TeamName is the team of the pull request author.
+type WebhookSubscription struct {
+	PullRequestID string
+	TeamName      string
+	Webhook       Webhook
+}
+
+// WebhookDelivery is a message in the webhook outbox.
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	URL           string
+	Secret        string
+	EventType     AssignmentEventType
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	DeliveredAt   *time.Time
+	FailedAt      *time.Time
+	CreatedAt     time.Time
+}
+
+// I use this in collection.Map
+
+func (d WebhookDelivery) GetWebhookID() int64 {
+	return d.WebhookID
+}
+
+func (d WebhookDelivery) GetPayload() string {
+	return string(d.Payload)
+}
+
+func (d WebhookDelivery) GetNextAttemptAt() time.Time {
+	return d.NextAttemptAt
+}
+
+func (d WebhookDelivery) GetCreatedAt() time.Time {
+	return d.CreatedAt
+}
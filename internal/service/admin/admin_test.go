@@ -2,29 +2,48 @@ package admin_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/golang/mock/gomock"
 	mock "github.com/hizu77/avito-autumn-2025/internal/mock/admin"
+	trmanager "github.com/hizu77/avito-autumn-2025/internal/mock/tr_manager"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/hizu77/avito-autumn-2025/internal/service/admin"
+	"github.com/hizu77/avito-autumn-2025/pkg/jwtkeys"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 )
 
 const (
-	testAdminID   = "4d4a8cd8-501b-4bd4-8589-6be8dcca7c09"
-	testPassword  = "secret123"
-	wrongPassword = "wrongpass"
+	testAdminID      = "4d4a8cd8-501b-4bd4-8589-6be8dcca7c09"
+	otherAdminID     = "b6e3f1f4-2b3c-4c3f-9f1e-2f0c1d5a7e11"
+	testPassword     = "secret123"
+	newPassword      = "secret456"
+	wrongPassword    = "wrongpass"
+	testRefreshToken = "refresh-token"
+	testTokenID      = "token-id"
 
 	testJWTSecret = "super-secret-for-tests"
 )
 
+var (
+	testTeamName = "backend"
+	errStorage   = errors.New("storage error")
+)
+
 func newService(t *testing.T) (*admin.Service, *mock.AdminStorage) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	storage := mock.NewAdminStorage(ctrl)
-	service := admin.New(storage, []byte(testJWTSecret))
+	service := admin.New(storage, trmanager.NewMockTrManager(), jwtkeys.SigningKey{
+		Algorithm: jwtkeys.AlgorithmHS256,
+		Key:       []byte(testJWTSecret),
+	})
 	return service, storage
 }
 
@@ -41,7 +60,6 @@ func TestLoginAdmin(t *testing.T) {
 		name    string
 		args    args
 		mock    func(storage *mock.AdminStorage)
-		want    string
 		wantErr error
 	}{
 		{
@@ -55,7 +73,6 @@ func TestLoginAdmin(t *testing.T) {
 				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
 					Return(model.Admin{}, model.ErrAdminDoesNotExist)
 			},
-			want:    "",
 			wantErr: model.ErrAdminDoesNotExist,
 		},
 		{
@@ -70,7 +87,6 @@ func TestLoginAdmin(t *testing.T) {
 				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
 					Return(model.Admin{ID: testAdminID, PasswordHash: string(hash)}, nil)
 			},
-			want:    "",
 			wantErr: model.ErrInvalidAdminPassword,
 		},
 		{
@@ -83,9 +99,15 @@ func TestLoginAdmin(t *testing.T) {
 			mock: func(storage *mock.AdminStorage) {
 				hash, _ := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.DefaultCost)
 				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
-					Return(model.Admin{ID: testAdminID, PasswordHash: string(hash)}, nil)
+					Return(model.Admin{ID: testAdminID, PasswordHash: string(hash), Role: model.RoleAdmin}, nil)
+				storage.EXPECT().InsertRefreshToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+						if token.AdminID != testAdminID || !token.ExpiresAt.After(time.Now()) {
+							return errors.New("unexpected refresh token")
+						}
+						return nil
+					})
 			},
-			want:    "",
 			wantErr: nil,
 		},
 	}
@@ -100,27 +122,54 @@ func TestLoginAdmin(t *testing.T) {
 			got, err := service.LoginAdmin(tt.args.ctx, tt.args.id, tt.args.password)
 
 			if tt.wantErr != nil {
-				require.Equal(t, tt.want, got)
+				require.Equal(t, model.TokenPair{}, got)
 				require.ErrorIs(t, err, tt.wantErr)
 				return
 			}
 
 			require.NoError(t, err)
-			if tt.wantErr == nil && tt.want == "" {
-				require.NotEmpty(t, got)
-			} else {
-				require.Equal(t, tt.want, got)
-			}
+			require.NotEmpty(t, got.AccessToken)
+			require.Len(t, got.RefreshToken, 64)
 		})
 	}
 }
 
+func TestLoginAdminClaims(t *testing.T) {
+	t.Parallel()
+
+	service, storage := newService(t)
+	hash, _ := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.DefaultCost)
+	storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
+		Return(model.Admin{
+			ID:           testAdminID,
+			PasswordHash: string(hash),
+			Role:         model.RoleTeamLead,
+			TeamName:     &testTeamName,
+		}, nil)
+	storage.EXPECT().InsertRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+
+	tokens, err := service.LoginAdmin(context.Background(), testAdminID, testPassword)
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(tokens.AccessToken, claims, func(*jwt.Token) (any, error) {
+		return []byte(testJWTSecret), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, testAdminID, claims["admin_id"])
+	require.Equal(t, model.RoleTeamLead.String(), claims["role"])
+	require.Equal(t, testTeamName, claims["team_name"])
+	require.NotContains(t, claims, "user_id")
+	require.Len(t, claims["jti"], 32)
+	require.Contains(t, claims, "iat")
+}
+
 func TestRegisterAdmin(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
 		ctx      context.Context
-		id       string
+		admin    model.Admin
 		password string
 	}
 
@@ -135,7 +184,7 @@ func TestRegisterAdmin(t *testing.T) {
 			name: "admin already exists",
 			args: args{
 				ctx:      context.Background(),
-				id:       testAdminID,
+				admin:    model.Admin{ID: testAdminID, Role: model.RoleAdmin},
 				password: testPassword,
 			},
 			mock: func(storage *mock.AdminStorage) {
@@ -149,7 +198,7 @@ func TestRegisterAdmin(t *testing.T) {
 			name: "success",
 			args: args{
 				ctx:      context.Background(),
-				id:       testAdminID,
+				admin:    model.Admin{ID: testAdminID, Role: model.RoleAdmin},
 				password: testPassword,
 			},
 			mock: func(storage *mock.AdminStorage) {
@@ -159,10 +208,25 @@ func TestRegisterAdmin(t *testing.T) {
 					})
 			},
 			want: model.Admin{
-				ID: testAdminID,
+				ID:   testAdminID,
+				Role: model.RoleAdmin,
 			},
 			wantErr: nil,
 		},
+		{
+			name: "team lead of unknown team",
+			args: args{
+				ctx:      context.Background(),
+				admin:    model.Admin{ID: testAdminID, Role: model.RoleTeamLead, TeamName: &testTeamName},
+				password: testPassword,
+			},
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().InsertAdmin(gomock.Any(), gomock.Any()).
+					Return(model.Admin{}, model.ErrTeamDoesNotExist)
+			},
+			want:    model.Admin{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,11 +236,12 @@ func TestRegisterAdmin(t *testing.T) {
 			service, storage := newService(t)
 			tt.mock(storage)
 
-			got, err := service.RegisterAdmin(tt.args.ctx, tt.args.id, tt.args.password)
+			got, err := service.RegisterAdmin(tt.args.ctx, tt.args.admin, tt.args.password)
 
 			require.ErrorIs(t, err, tt.wantErr)
 			if tt.wantErr == nil {
 				require.Equal(t, tt.want.ID, got.ID)
+				require.Equal(t, tt.want.Role, got.Role)
 				require.NotEmpty(t, got.PasswordHash)
 			} else {
 				require.Equal(t, tt.want, got)
@@ -184,3 +249,255 @@ func TestRegisterAdmin(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshAdminToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(storage *mock.AdminStorage)
+		wantErr error
+	}{
+		{
+			name: "unknown or already used token",
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeRefreshToken(gomock.Any(), hashToken(testRefreshToken), gomock.Any()).
+					Return(model.RefreshToken{}, model.ErrInvalidRefreshToken)
+			},
+			wantErr: model.ErrInvalidRefreshToken,
+		},
+		{
+			name: "admin deleted meanwhile",
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeRefreshToken(gomock.Any(), hashToken(testRefreshToken), gomock.Any()).
+					Return(model.RefreshToken{AdminID: testAdminID}, nil)
+				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
+					Return(model.Admin{}, model.ErrAdminDoesNotExist)
+			},
+			wantErr: model.ErrAdminDoesNotExist,
+		},
+		{
+			name: "rotates token",
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeRefreshToken(gomock.Any(), hashToken(testRefreshToken), gomock.Any()).
+					Return(model.RefreshToken{AdminID: testAdminID}, nil)
+				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).
+					Return(model.Admin{ID: testAdminID, Role: model.RoleAdmin}, nil)
+				storage.EXPECT().InsertRefreshToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, token model.RefreshToken) error {
+						if token.TokenHash == hashToken(testRefreshToken) {
+							return errors.New("refresh token was not rotated")
+						}
+						return nil
+					})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			got, err := service.RefreshAdminToken(context.Background(), testRefreshToken)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, got.AccessToken)
+			require.NotEqual(t, testRefreshToken, got.RefreshToken)
+		})
+	}
+}
+
+func TestLogoutAdmin(t *testing.T) {
+	t.Parallel()
+
+	accessToken := model.AccessToken{
+		ID:        testTokenID,
+		AdminID:   testAdminID,
+		IssuedAt:  time.Now(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	tests := []struct {
+		name         string
+		accessToken  model.AccessToken
+		refreshToken string
+		mock         func(storage *mock.AdminStorage)
+		wantErr      error
+	}{
+		{
+			name:         "revokes given refresh token",
+			accessToken:  accessToken,
+			refreshToken: testRefreshToken,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeRefreshToken(gomock.Any(), hashToken(testRefreshToken), gomock.Any()).
+					Return(model.RefreshToken{AdminID: testAdminID}, nil)
+				storage.EXPECT().InsertRevokedAccessToken(gomock.Any(), accessToken).Return(nil)
+			},
+		},
+		{
+			name:        "revokes all refresh tokens",
+			accessToken: accessToken,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeAdminRefreshTokens(gomock.Any(), testAdminID, gomock.Any()).Return(nil)
+				storage.EXPECT().InsertRevokedAccessToken(gomock.Any(), accessToken).Return(nil)
+			},
+		},
+		{
+			name:         "refresh token of another admin",
+			accessToken:  accessToken,
+			refreshToken: testRefreshToken,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeRefreshToken(gomock.Any(), hashToken(testRefreshToken), gomock.Any()).
+					Return(model.RefreshToken{AdminID: otherAdminID}, nil)
+			},
+			wantErr: model.ErrInvalidRefreshToken,
+		},
+		{
+			name:        "token without id",
+			accessToken: model.AccessToken{AdminID: testAdminID},
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeAdminRefreshTokens(gomock.Any(), testAdminID, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:        "storage error",
+			accessToken: accessToken,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().RevokeAdminRefreshTokens(gomock.Any(), testAdminID, gomock.Any()).Return(nil)
+				storage.EXPECT().InsertRevokedAccessToken(gomock.Any(), accessToken).Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			err := service.LogoutAdmin(context.Background(), tt.accessToken, tt.refreshToken)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestChangeAdminPassword(t *testing.T) {
+	t.Parallel()
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.DefaultCost)
+	storedAdmin := model.Admin{ID: testAdminID, PasswordHash: string(hash), Role: model.RoleAdmin}
+	accessToken := model.AccessToken{ID: testTokenID, AdminID: testAdminID}
+
+	tests := []struct {
+		name        string
+		oldPassword string
+		mock        func(storage *mock.AdminStorage)
+		wantErr     error
+	}{
+		{
+			name:        "wrong old password",
+			oldPassword: wrongPassword,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).Return(storedAdmin, nil)
+			},
+			wantErr: model.ErrInvalidAdminPassword,
+		},
+		{
+			name:        "success",
+			oldPassword: testPassword,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().GetAdmin(gomock.Any(), testAdminID).Return(storedAdmin, nil)
+				storage.EXPECT().UpdatePassword(gomock.Any(), testAdminID, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, passwordHash string, _ time.Time) error {
+						return bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(newPassword))
+					})
+				storage.EXPECT().RevokeAdminRefreshTokens(gomock.Any(), testAdminID, gomock.Any()).Return(nil)
+				storage.EXPECT().InsertRevokedAccessToken(gomock.Any(), accessToken).Return(nil)
+				storage.EXPECT().InsertRefreshToken(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			got, err := service.ChangeAdminPassword(context.Background(), accessToken, tt.oldPassword, newPassword)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotEmpty(t, got.AccessToken)
+			require.NotEmpty(t, got.RefreshToken)
+		})
+	}
+}
+
+func TestDeleteAdmin(t *testing.T) {
+	t.Parallel()
+
+	callerContext := model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:   testAdminID,
+		Role: model.RoleAdmin,
+	})
+
+	tests := []struct {
+		name    string
+		id      string
+		mock    func(storage *mock.AdminStorage)
+		wantErr error
+	}{
+		{
+			name:    "cannot delete itself",
+			id:      testAdminID,
+			mock:    func(storage *mock.AdminStorage) {},
+			wantErr: model.ErrAdminDeletesSelf,
+		},
+		{
+			name: "admin not found",
+			id:   otherAdminID,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().DeleteAdmin(gomock.Any(), otherAdminID).Return(model.ErrAdminDoesNotExist)
+			},
+			wantErr: model.ErrAdminDoesNotExist,
+		},
+		{
+			name: "success",
+			id:   otherAdminID,
+			mock: func(storage *mock.AdminStorage) {
+				storage.EXPECT().DeleteAdmin(gomock.Any(), otherAdminID).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			err := service.DeleteAdmin(callerContext, tt.id)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ChangeAdminPassword replaces the password of the token's account and closes all its sessions:
+// refresh tokens are revoked and access tokens issued earlier stop being accepted.
+// A new token pair is returned, so the caller stays logged in.
+func (s *Service) ChangeAdminPassword(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	oldPassword string,
+	newPassword string,
+) (model.TokenPair, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "generating hash")
+	}
+
+	// Access tokens carry the issue time in whole seconds,
+	// so the change time is truncated the same way to keep the new tokens valid.
+	// The current token may be issued within the same second and is denylisted explicitly.
+	now := time.Now().UTC().Truncate(time.Second)
+
+	var tokens model.TokenPair
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		admin, err := s.storage.GetAdmin(ctx, accessToken.AdminID)
+		if err != nil {
+			return errors.Wrap(err, "storage getting admin")
+		}
+
+		err = bcrypt.CompareHashAndPassword(
+			[]byte(admin.PasswordHash),
+			[]byte(oldPassword),
+		)
+		if err != nil {
+			return model.ErrInvalidAdminPassword
+		}
+
+		if err = s.storage.UpdatePassword(ctx, admin.ID, string(hash), now); err != nil {
+			return errors.Wrap(err, "storage updating password")
+		}
+
+		if err = s.storage.RevokeAdminRefreshTokens(ctx, admin.ID, now); err != nil {
+			return errors.Wrap(err, "storage revoking refresh tokens")
+		}
+
+		if accessToken.ID != "" {
+			if err = s.storage.InsertRevokedAccessToken(ctx, accessToken); err != nil {
+				return errors.Wrap(err, "storage revoking access token")
+			}
+		}
+
+		tokens, err = s.issueTokenPair(ctx, admin)
+		if err != nil {
+			return errors.Wrap(err, "issuing tokens")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "changing password")
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,22 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// DeleteAdmin removes the account together with its refresh tokens.
+// Access tokens of the account stop being accepted right away.
+func (s *Service) DeleteAdmin(ctx context.Context, id string) error {
+	if principal, ok := model.PrincipalFromContext(ctx); ok && principal.ID == id {
+		return model.ErrAdminDeletesSelf
+	}
+
+	if err := s.storage.DeleteAdmin(ctx, id); err != nil {
+		return errors.Wrap(err, "storage deleting admin")
+	}
+
+	return nil
+}
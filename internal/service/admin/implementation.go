@@ -2,27 +2,42 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/jwtkeys"
 )
 
 //go:generate mockgen -source=implementation.go -destination=../../mock/admin/storage.go -package=mock -mock_names storage=AdminStorage
 type storage interface {
 	GetAdmin(ctx context.Context, id string) (model.Admin, error)
 	InsertAdmin(ctx context.Context, admin model.Admin) (model.Admin, error)
+	UpdatePassword(ctx context.Context, id string, passwordHash string, changedAt time.Time) error
+	DeleteAdmin(ctx context.Context, id string) error
+
+	InsertRefreshToken(ctx context.Context, token model.RefreshToken) error
+	RevokeRefreshToken(ctx context.Context, tokenHash string, revokedAt time.Time) (model.RefreshToken, error)
+	RevokeAdminRefreshTokens(ctx context.Context, adminID string, revokedAt time.Time) error
+
+	InsertRevokedAccessToken(ctx context.Context, token model.AccessToken) error
+	IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error)
 }
 
 type Service struct {
-	storage   storage
-	jwtSecret []byte
+	storage    storage
+	trManager  trm.Manager
+	signingKey jwtkeys.SigningKey
 }
 
 func New(
 	storage storage,
-	jwtSecret []byte,
+	trManager trm.Manager,
+	signingKey jwtkeys.SigningKey,
 ) *Service {
 	return &Service{
-		storage:   storage,
-		jwtSecret: jwtSecret,
+		storage:    storage,
+		trManager:  trManager,
+		signingKey: signingKey,
 	}
 }
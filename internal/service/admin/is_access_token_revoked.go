@@ -0,0 +1,17 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error) {
+	revoked, err := s.storage.IsAccessTokenRevoked(ctx, token)
+	if err != nil {
+		return false, errors.Wrap(err, "storage checking access token")
+	}
+
+	return revoked, nil
+}
@@ -2,29 +2,20 @@ package admin
 
 import (
 	"context"
-	"time"
 
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
 )
 
-const (
-	jwtExpiration = time.Hour
-
-	adminIDPayloadKey         = "admin_id"
-	tokenExpirationPayloadKey = "exp"
-)
-
 func (s *Service) LoginAdmin(
 	ctx context.Context,
 	id string,
 	password string,
-) (string, error) {
+) (model.TokenPair, error) {
 	admin, err := s.storage.GetAdmin(ctx, id)
 	if err != nil {
-		return "", errors.Wrap(err, "getting admin")
+		return model.TokenPair{}, errors.Wrap(err, "getting admin")
 	}
 
 	err = bcrypt.CompareHashAndPassword(
@@ -32,19 +23,13 @@ func (s *Service) LoginAdmin(
 		[]byte(password),
 	)
 	if err != nil {
-		return "", model.ErrInvalidAdminPassword
-	}
-
-	claims := jwt.MapClaims{
-		adminIDPayloadKey:         id,
-		tokenExpirationPayloadKey: time.Now().Add(jwtExpiration).Unix(),
+		return model.TokenPair{}, model.ErrInvalidAdminPassword
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	signedToken, err := token.SignedString(s.jwtSecret)
+	tokens, err := s.issueTokenPair(ctx, admin)
 	if err != nil {
-		return "", errors.Wrap(err, "signing token")
+		return model.TokenPair{}, errors.Wrap(err, "issuing tokens")
 	}
 
-	return signedToken, nil
+	return tokens, nil
 }
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// LogoutAdmin denylists the access token until it expires and revokes
+// the given refresh token of the same account.
+// Without a refresh token all sessions of the account are closed.
+func (s *Service) LogoutAdmin(
+	ctx context.Context,
+	accessToken model.AccessToken,
+	refreshToken string,
+) error {
+	now := time.Now().UTC()
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		if refreshToken == "" {
+			if err := s.storage.RevokeAdminRefreshTokens(ctx, accessToken.AdminID, now); err != nil {
+				return errors.Wrap(err, "storage revoking refresh tokens")
+			}
+		} else {
+			revoked, err := s.storage.RevokeRefreshToken(ctx, hashRefreshToken(refreshToken), now)
+			if err != nil {
+				return errors.Wrap(err, "storage revoking refresh token")
+			}
+
+			if revoked.AdminID != accessToken.AdminID {
+				return model.ErrInvalidRefreshToken
+			}
+		}
+
+		if accessToken.ID == "" {
+			return nil
+		}
+
+		if err := s.storage.InsertRevokedAccessToken(ctx, accessToken); err != nil {
+			return errors.Wrap(err, "storage revoking access token")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "logging out")
+	}
+
+	return nil
+}
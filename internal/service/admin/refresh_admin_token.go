@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// RefreshAdminToken exchanges a refresh token for a new token pair.
+// The used refresh token is revoked, so it cannot be exchanged again.
+func (s *Service) RefreshAdminToken(ctx context.Context, refreshToken string) (model.TokenPair, error) {
+	var tokens model.TokenPair
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		revoked, err := s.storage.RevokeRefreshToken(ctx, hashRefreshToken(refreshToken), time.Now().UTC())
+		if err != nil {
+			return errors.Wrap(err, "storage revoking refresh token")
+		}
+
+		admin, err := s.storage.GetAdmin(ctx, revoked.AdminID)
+		if err != nil {
+			return errors.Wrap(err, "storage getting admin")
+		}
+
+		tokens, err = s.issueTokenPair(ctx, admin)
+		if err != nil {
+			return errors.Wrap(err, "issuing tokens")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "refreshing token")
+	}
+
+	return tokens, nil
+}
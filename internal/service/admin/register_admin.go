@@ -8,9 +8,10 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// RegisterAdmin creates an account with the role, team and user of admin.
 func (s *Service) RegisterAdmin(
 	ctx context.Context,
-	id string,
+	admin model.Admin,
 	password string,
 ) (model.Admin, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -18,10 +19,7 @@ func (s *Service) RegisterAdmin(
 		return model.Admin{}, errors.Wrap(err, "generating hash")
 	}
 
-	admin := model.Admin{
-		ID:           id,
-		PasswordHash: string(hash),
-	}
+	admin.PasswordHash = string(hash)
 
 	return s.storage.InsertAdmin(ctx, admin)
 }
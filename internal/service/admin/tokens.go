@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	jwtExpiration          = time.Hour
+	refreshTokenExpiration = 30 * 24 * time.Hour
+
+	tokenIDBytes      = 16
+	refreshTokenBytes = 32
+
+	adminIDPayloadKey         = "admin_id"
+	rolePayloadKey            = "role"
+	teamNamePayloadKey        = "team_name"
+	userIDPayloadKey          = "user_id"
+	tokenIDPayloadKey         = "jti"
+	tokenIssuedAtPayloadKey   = "iat"
+	tokenExpirationPayloadKey = "exp"
+
+	keyIDHeader = "kid"
+)
+
+// issueTokenPair signs an access token for the admin and stores a new refresh token.
+func (s *Service) issueTokenPair(ctx context.Context, admin model.Admin) (model.TokenPair, error) {
+	now := time.Now().UTC()
+
+	tokenID, err := generateToken(tokenIDBytes)
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "generating token id")
+	}
+
+	claims := jwt.MapClaims{
+		adminIDPayloadKey:         admin.ID,
+		rolePayloadKey:            admin.Role.String(),
+		tokenIDPayloadKey:         tokenID,
+		tokenIssuedAtPayloadKey:   now.Unix(),
+		tokenExpirationPayloadKey: now.Add(jwtExpiration).Unix(),
+	}
+	if admin.TeamName != nil {
+		claims[teamNamePayloadKey] = *admin.TeamName
+	}
+	if admin.UserID != nil {
+		claims[userIDPayloadKey] = *admin.UserID
+	}
+
+	method := jwt.GetSigningMethod(s.signingKey.Algorithm)
+	if method == nil {
+		return model.TokenPair{}, errors.Errorf("unknown signing algorithm %s", s.signingKey.Algorithm)
+	}
+
+	token := jwt.NewWithClaims(method, claims)
+	if s.signingKey.ID != "" {
+		token.Header[keyIDHeader] = s.signingKey.ID
+	}
+
+	signedToken, err := token.SignedString(s.signingKey.Key)
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "signing token")
+	}
+
+	refreshToken, err := generateToken(refreshTokenBytes)
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "generating refresh token")
+	}
+
+	err = s.storage.InsertRefreshToken(ctx, model.RefreshToken{
+		TokenHash: hashRefreshToken(refreshToken),
+		AdminID:   admin.ID,
+		ExpiresAt: now.Add(refreshTokenExpiration),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return model.TokenPair{}, errors.Wrap(err, "storage inserting refresh token")
+	}
+
+	return model.TokenPair{
+		AccessToken:  signedToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+func generateToken(size int) (string, error) {
+	token := make([]byte, size)
+	if _, err := rand.Read(token); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+// hashRefreshToken uses a fast hash instead of bcrypt: refresh tokens are
+// random and long, and a deterministic hash lets them be looked up by value.
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
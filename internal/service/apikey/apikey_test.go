@@ -0,0 +1,180 @@
+package apikey_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/apikey"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/apikey"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	testAdminID  = "admin"
+	testKeyID    = "0123456789abcdef"
+	testSecret   = "secret"
+	testKeyName  = "ci"
+	wrongSecret  = "wrong"
+	malformedKey = "no-separator"
+)
+
+var errStorage = errors.New("storage error")
+
+func newService(t *testing.T) (*apikey.Service, *mock.APIKeyStorage) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewAPIKeyStorage(ctrl)
+	service := apikey.New(storage)
+	return service, storage
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:   testAdminID,
+		Role: model.RoleAdmin,
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		service, storage := newService(t)
+		storage.EXPECT().
+			InsertAPIKey(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, apiKey model.APIKey) (model.APIKey, error) {
+				return apiKey, nil
+			})
+
+		got, key, err := service.CreateAPIKey(ctx, model.APIKey{
+			Name:   testKeyName,
+			Scopes: []model.APIKeyScope{model.APIKeyScopePrWrite, model.APIKeyScopePrWrite, model.APIKeyScopeTeamRead},
+		})
+		require.NoError(t, err)
+
+		id, secret, ok := strings.Cut(key, ".")
+		require.True(t, ok)
+		require.Equal(t, got.ID, id)
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(got.KeyHash), []byte(secret)))
+		require.Equal(t, []model.APIKeyScope{model.APIKeyScopePrWrite, model.APIKeyScopeTeamRead}, got.Scopes)
+		require.Equal(t, testAdminID, *got.CreatedBy)
+		require.False(t, got.CreatedAt.IsZero())
+		require.Nil(t, got.RevokedAt)
+	})
+
+	t.Run("storage error", func(t *testing.T) {
+		t.Parallel()
+
+		service, storage := newService(t)
+		storage.EXPECT().
+			InsertAPIKey(gomock.Any(), gomock.Any()).
+			Return(model.APIKey{}, errStorage)
+
+		_, key, err := service.CreateAPIKey(ctx, model.APIKey{Name: testKeyName})
+		require.ErrorIs(t, err, errStorage)
+		require.Empty(t, key)
+	})
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	hash, _ := bcrypt.GenerateFromPassword([]byte(testSecret), bcrypt.MinCost)
+	revokedAt := time.Now()
+	activeKey := model.APIKey{
+		ID:      testKeyID,
+		KeyHash: string(hash),
+		Scopes:  []model.APIKeyScope{model.APIKeyScopePrRead},
+	}
+	revokedKey := activeKey
+	revokedKey.RevokedAt = &revokedAt
+
+	tests := []struct {
+		name    string
+		key     string
+		mock    func(storage *mock.APIKeyStorage)
+		wantErr error
+	}{
+		{
+			name:    "malformed key",
+			key:     malformedKey,
+			mock:    func(storage *mock.APIKeyStorage) {},
+			wantErr: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "unknown key",
+			key:  testKeyID + "." + testSecret,
+			mock: func(storage *mock.APIKeyStorage) {
+				storage.EXPECT().GetAPIKey(gomock.Any(), testKeyID).
+					Return(model.APIKey{}, model.ErrAPIKeyDoesNotExist)
+			},
+			wantErr: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "revoked key",
+			key:  testKeyID + "." + testSecret,
+			mock: func(storage *mock.APIKeyStorage) {
+				storage.EXPECT().GetAPIKey(gomock.Any(), testKeyID).Return(revokedKey, nil)
+			},
+			wantErr: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "wrong secret",
+			key:  testKeyID + "." + wrongSecret,
+			mock: func(storage *mock.APIKeyStorage) {
+				storage.EXPECT().GetAPIKey(gomock.Any(), testKeyID).Return(activeKey, nil)
+			},
+			wantErr: model.ErrInvalidAPIKey,
+		},
+		{
+			name: "storage error",
+			key:  testKeyID + "." + testSecret,
+			mock: func(storage *mock.APIKeyStorage) {
+				storage.EXPECT().GetAPIKey(gomock.Any(), testKeyID).Return(model.APIKey{}, errStorage)
+			},
+			wantErr: errStorage,
+		},
+		{
+			name: "success",
+			key:  testKeyID + "." + testSecret,
+			mock: func(storage *mock.APIKeyStorage) {
+				storage.EXPECT().GetAPIKey(gomock.Any(), testKeyID).Return(activeKey, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			got, err := service.AuthenticateAPIKey(context.Background(), tt.key)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, activeKey, got)
+		})
+	}
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	t.Parallel()
+
+	service, storage := newService(t)
+	storage.EXPECT().RevokeAPIKey(gomock.Any(), testKeyID, gomock.Any()).
+		Return(model.APIKey{}, model.ErrAPIKeyDoesNotExist)
+
+	_, err := service.RevokeAPIKey(context.Background(), testKeyID)
+	require.ErrorIs(t, err, model.ErrAPIKeyDoesNotExist)
+}
@@ -0,0 +1,37 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AuthenticateAPIKey returns the active key matching the presented one.
+// Unknown, revoked and malformed keys are all reported as invalid.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, key string) (model.APIKey, error) {
+	id, secret, ok := strings.Cut(key, keySeparator)
+	if !ok || id == "" || secret == "" {
+		return model.APIKey{}, model.ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.storage.GetAPIKey(ctx, id)
+	if errors.Is(err, model.ErrAPIKeyDoesNotExist) {
+		return model.APIKey{}, model.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "storage getting api key")
+	}
+
+	if apiKey.RevokedAt != nil {
+		return model.APIKey{}, model.ErrInvalidAPIKey
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(apiKey.KeyHash), []byte(secret)); err != nil {
+		return model.APIKey{}, model.ErrInvalidAPIKey
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,67 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	idBytes     = 8
+	secretBytes = 32
+
+	keySeparator = "."
+)
+
+// CreateAPIKey stores a new key created by the calling admin.
+// The key itself is returned only here: it consists of the key id
+// and a secret, and only the hash of the secret is stored.
+func (s *Service) CreateAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, string, error) {
+	id, err := generateToken(idBytes)
+	if err != nil {
+		return model.APIKey{}, "", errors.Wrap(err, "generating id")
+	}
+
+	secret, err := generateToken(secretBytes)
+	if err != nil {
+		return model.APIKey{}, "", errors.Wrap(err, "generating secret")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return model.APIKey{}, "", errors.Wrap(err, "generating hash")
+	}
+
+	apiKey.ID = id
+	apiKey.KeyHash = string(hash)
+	apiKey.Scopes = collection.Unique(apiKey.Scopes, func(scope model.APIKeyScope) model.APIKeyScope {
+		return scope
+	})
+	apiKey.CreatedAt = time.Now().UTC()
+	apiKey.RevokedAt = nil
+	if principal, ok := model.PrincipalFromContext(ctx); ok {
+		apiKey.CreatedBy = &principal.ID
+	}
+
+	savedAPIKey, err := s.storage.InsertAPIKey(ctx, apiKey)
+	if err != nil {
+		return model.APIKey{}, "", errors.Wrap(err, "storage inserting api key")
+	}
+
+	return savedAPIKey, id + keySeparator + secret, nil
+}
+
+func generateToken(size int) (string, error) {
+	token := make([]byte, size)
+	if _, err := rand.Read(token); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+
+	return hex.EncodeToString(token), nil
+}
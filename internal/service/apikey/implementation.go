@@ -0,0 +1,26 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/apikey/storage.go -package=mock -mock_names storage=APIKeyStorage
+type storage interface {
+	InsertAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, error)
+	GetAPIKey(ctx context.Context, id string) (model.APIKey, error)
+	GetAPIKeys(ctx context.Context) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) (model.APIKey, error)
+}
+
+type Service struct {
+	storage storage
+}
+
+func New(storage storage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
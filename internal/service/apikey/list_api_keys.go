@@ -0,0 +1,17 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) ListAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	apiKeys, err := s.storage.GetAPIKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage getting api keys")
+	}
+
+	return apiKeys, nil
+}
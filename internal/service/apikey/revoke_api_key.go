@@ -0,0 +1,19 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// RevokeAPIKey disables the key. Revoked keys stay listed for audit.
+func (s *Service) RevokeAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	apiKey, err := s.storage.RevokeAPIKey(ctx, id, time.Now().UTC())
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "storage revoking api key")
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,40 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	checkTimeout = 2 * time.Second
+)
+
+// CheckReadiness checks the dependencies needed to serve requests.
+// Failed checks are reported in the result rather than as an error.
+func (s *Service) CheckReadiness(ctx context.Context) model.Readiness {
+	readiness := model.Readiness{
+		ShuttingDown:             s.shutdownState.ShuttingDown(),
+		ExpectedMigrationVersion: s.expectedMigrationVersion,
+		Pool:                     s.storage.GetPoolStats(),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	if err := s.storage.Ping(ctx); err != nil {
+		readiness.DatabaseErr = errors.Wrap(err, "pinging database")
+		return readiness
+	}
+
+	version, err := s.storage.GetMigrationVersion(ctx)
+	if err != nil {
+		readiness.MigrationErr = errors.Wrap(err, "getting migration version")
+		return readiness
+	}
+	readiness.AppliedMigrationVersion = version
+
+	return readiness
+}
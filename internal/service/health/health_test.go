@@ -0,0 +1,120 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/health"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/health"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testExpectedVersion = 18
+)
+
+var (
+	errStorage = errors.New("storage error")
+	testPool   = model.PoolStats{AcquiredConns: 3, IdleConns: 1, TotalConns: 4, MaxConns: 4}
+)
+
+func newService(t *testing.T) (*health.Service, *mock.HealthStorage, *mock.ShutdownState) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewHealthStorage(ctrl)
+	shutdownState := mock.NewShutdownState(ctrl)
+	service := health.New(storage, shutdownState, testExpectedVersion)
+	return service, storage, shutdownState
+}
+
+func TestCheckReadiness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		mock             func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState)
+		wantReady        bool
+		wantDatabaseErr  error
+		wantMigrationErr error
+		wantApplied      int
+		wantShuttingDown bool
+	}{
+		{
+			name: "database unavailable",
+			mock: func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState) {
+				shutdownState.EXPECT().ShuttingDown().Return(false)
+				storage.EXPECT().GetPoolStats().Return(testPool)
+				storage.EXPECT().Ping(gomock.Any()).Return(errStorage)
+			},
+			wantReady:       false,
+			wantDatabaseErr: errStorage,
+		},
+		{
+			name: "migration version error",
+			mock: func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState) {
+				shutdownState.EXPECT().ShuttingDown().Return(false)
+				storage.EXPECT().GetPoolStats().Return(testPool)
+				storage.EXPECT().Ping(gomock.Any()).Return(nil)
+				storage.EXPECT().GetMigrationVersion(gomock.Any()).Return(0, errStorage)
+			},
+			wantReady:        false,
+			wantMigrationErr: errStorage,
+		},
+		{
+			name: "migrations behind",
+			mock: func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState) {
+				shutdownState.EXPECT().ShuttingDown().Return(false)
+				storage.EXPECT().GetPoolStats().Return(testPool)
+				storage.EXPECT().Ping(gomock.Any()).Return(nil)
+				storage.EXPECT().GetMigrationVersion(gomock.Any()).Return(testExpectedVersion-1, nil)
+			},
+			wantReady:   false,
+			wantApplied: testExpectedVersion - 1,
+		},
+		{
+			name: "shutting down",
+			mock: func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState) {
+				shutdownState.EXPECT().ShuttingDown().Return(true)
+				storage.EXPECT().GetPoolStats().Return(testPool)
+				storage.EXPECT().Ping(gomock.Any()).Return(nil)
+				storage.EXPECT().GetMigrationVersion(gomock.Any()).Return(testExpectedVersion, nil)
+			},
+			wantReady:        false,
+			wantApplied:      testExpectedVersion,
+			wantShuttingDown: true,
+		},
+		{
+			name: "ready",
+			mock: func(storage *mock.HealthStorage, shutdownState *mock.ShutdownState) {
+				shutdownState.EXPECT().ShuttingDown().Return(false)
+				storage.EXPECT().GetPoolStats().Return(testPool)
+				storage.EXPECT().Ping(gomock.Any()).Return(nil)
+				storage.EXPECT().GetMigrationVersion(gomock.Any()).Return(testExpectedVersion, nil)
+			},
+			wantReady:   true,
+			wantApplied: testExpectedVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage, shutdownState := newService(t)
+			tt.mock(storage, shutdownState)
+
+			got := service.CheckReadiness(context.Background())
+
+			require.Equal(t, tt.wantReady, got.Ready())
+			require.Equal(t, tt.wantShuttingDown, got.ShuttingDown)
+			require.Equal(t, testExpectedVersion, got.ExpectedMigrationVersion)
+			require.Equal(t, tt.wantApplied, got.AppliedMigrationVersion)
+			require.Equal(t, testPool, got.Pool)
+			require.ErrorIs(t, got.DatabaseErr, tt.wantDatabaseErr)
+			require.ErrorIs(t, got.MigrationErr, tt.wantMigrationErr)
+		})
+	}
+}
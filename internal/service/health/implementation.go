@@ -0,0 +1,39 @@
+package health
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/health/storage.go -package=mock -mock_names storage=HealthStorage,shutdownState=ShutdownState
+type (
+	storage interface {
+		Ping(ctx context.Context) error
+		GetPoolStats() model.PoolStats
+		GetMigrationVersion(ctx context.Context) (int, error)
+	}
+
+	shutdownState interface {
+		ShuttingDown() bool
+	}
+)
+
+type Service struct {
+	storage       storage
+	shutdownState shutdownState
+
+	expectedMigrationVersion int
+}
+
+func New(
+	storage storage,
+	shutdownState shutdownState,
+	expectedMigrationVersion int,
+) *Service {
+	return &Service{
+		storage:                  storage,
+		shutdownState:            shutdownState,
+		expectedMigrationVersion: expectedMigrationVersion,
+	}
+}
@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Abort releases the key of a request that failed without a response
+// worth replaying, so that a retry is served again.
+func (s *Service) Abort(ctx context.Context, callerID string, key string) error {
+	if err := s.storage.DeleteIdempotencyKey(ctx, callerID, key); err != nil {
+		return errors.Wrap(err, "storage deleting idempotency key")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// Begin reserves the key of the caller for the request with requestHash.
+// It returns nil if the request should be served, or the response
+// to replay if the same request was already served with the key.
+func (s *Service) Begin(
+	ctx context.Context,
+	callerID string,
+	key string,
+	requestHash string,
+) (*model.IdempotentResponse, error) {
+	now := time.Now().UTC()
+
+	record, reserved, err := s.storage.ReserveIdempotencyKey(ctx, model.IdempotencyRecord{
+		CallerID:    callerID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.cfg.LockTimeout),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "storage reserving idempotency key")
+	}
+
+	if reserved {
+		return nil, nil
+	}
+	if record.RequestHash != requestHash {
+		return nil, model.ErrIdempotencyKeyReused
+	}
+	if record.Response == nil {
+		return nil, model.ErrIdempotencyKeyInProgress
+	}
+
+	return record.Response, nil
+}
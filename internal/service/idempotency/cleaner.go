@@ -0,0 +1,65 @@
+package idempotency
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Cleaner periodically deletes expired idempotency keys. Expired keys
+// are never replayed, deleting them only keeps the table small.
+type Cleaner struct {
+	service  *Service
+	interval time.Duration
+	logger   *zap.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewCleaner(service *Service, interval time.Duration, logger *zap.Logger) *Cleaner {
+	return &Cleaner{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the cleanup loop in the background until ctx is done or Stop is called.
+func (c *Cleaner) Start(ctx context.Context) {
+	go c.run(ctx)
+}
+
+// Stop signals the cleanup loop to exit and waits for it.
+func (c *Cleaner) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+
+	<-c.done
+}
+
+func (c *Cleaner) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			if _, err := c.service.DeleteExpired(ctx); err != nil {
+				c.logger.Error("deleting expired idempotency keys", zap.Error(err))
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// Complete stores the response of a request begun with the key,
+// so that it is replayed for TTL.
+func (s *Service) Complete(
+	ctx context.Context,
+	callerID string,
+	key string,
+	response model.IdempotentResponse,
+) error {
+	expiresAt := time.Now().UTC().Add(s.cfg.TTL)
+
+	if err := s.storage.SaveIdempotentResponse(ctx, callerID, key, response, expiresAt); err != nil {
+		return errors.Wrap(err, "storage saving idempotent response")
+	}
+
+	return nil
+}
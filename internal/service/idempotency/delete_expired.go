@@ -0,0 +1,18 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DeleteExpired returns how many expired keys were deleted.
+func (s *Service) DeleteExpired(ctx context.Context) (int64, error) {
+	deleted, err := s.storage.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC())
+	if err != nil {
+		return 0, errors.Wrap(err, "storage deleting expired idempotency keys")
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,118 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/idempotency"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/idempotency"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testCallerID    = "admin"
+	testKey         = "key-1"
+	testRequestHash = "hash-1"
+	otherHash       = "hash-2"
+)
+
+var (
+	errStorage   = errors.New("storage error")
+	testResponse = model.IdempotentResponse{StatusCode: 201, Body: []byte(`{"ok":true}`)}
+	testConfig   = idempotency.Config{TTL: 24 * time.Hour, LockTimeout: time.Minute}
+)
+
+func newService(t *testing.T) (*idempotency.Service, *mock.IdempotencyStorage) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewIdempotencyStorage(ctrl)
+	service := idempotency.New(storage, testConfig)
+	return service, storage
+}
+
+func TestBegin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		stored   model.IdempotencyRecord
+		reserved bool
+		err      error
+		want     *model.IdempotentResponse
+		wantErr  error
+	}{
+		{
+			name:     "new key is reserved",
+			reserved: true,
+		},
+		{
+			name:   "completed request is replayed",
+			stored: model.IdempotencyRecord{RequestHash: testRequestHash, Response: &testResponse},
+			want:   &testResponse,
+		},
+		{
+			name:    "key reused with another request",
+			stored:  model.IdempotencyRecord{RequestHash: otherHash, Response: &testResponse},
+			wantErr: model.ErrIdempotencyKeyReused,
+		},
+		{
+			name:    "request in progress",
+			stored:  model.IdempotencyRecord{RequestHash: testRequestHash},
+			wantErr: model.ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:    "storage error",
+			err:     errStorage,
+			wantErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			storage.EXPECT().
+				ReserveIdempotencyKey(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error) {
+					require.Equal(t, testCallerID, record.CallerID)
+					require.Equal(t, testKey, record.Key)
+					require.Equal(t, testRequestHash, record.RequestHash)
+					require.Equal(t, testConfig.LockTimeout, record.ExpiresAt.Sub(record.CreatedAt))
+					return tt.stored, tt.reserved, tt.err
+				})
+
+			got, err := service.Begin(context.Background(), testCallerID, testKey, testRequestHash)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	t.Parallel()
+
+	service, storage := newService(t)
+	before := time.Now().UTC()
+	storage.EXPECT().
+		SaveIdempotentResponse(gomock.Any(), testCallerID, testKey, testResponse, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ model.IdempotentResponse, expiresAt time.Time) error {
+			require.WithinRange(t, expiresAt, before.Add(testConfig.TTL), time.Now().UTC().Add(testConfig.TTL))
+			return nil
+		})
+
+	require.NoError(t, service.Complete(context.Background(), testCallerID, testKey, testResponse))
+}
+
+func TestAbort(t *testing.T) {
+	t.Parallel()
+
+	service, storage := newService(t)
+	storage.EXPECT().DeleteIdempotencyKey(gomock.Any(), testCallerID, testKey).Return(errStorage)
+
+	require.ErrorIs(t, service.Abort(context.Background(), testCallerID, testKey), errStorage)
+}
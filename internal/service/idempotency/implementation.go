@@ -0,0 +1,42 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/idempotency/storage.go -package=mock -mock_names storage=IdempotencyStorage
+type storage interface {
+	ReserveIdempotencyKey(ctx context.Context, record model.IdempotencyRecord) (model.IdempotencyRecord, bool, error)
+	SaveIdempotentResponse(
+		ctx context.Context,
+		callerID string,
+		key string,
+		response model.IdempotentResponse,
+		expiresAt time.Time,
+	) error
+	DeleteIdempotencyKey(ctx context.Context, callerID string, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+}
+
+// Config.TTL is how long a response is replayed. Config.LockTimeout
+// is how long a request in progress holds its key: if the instance
+// serving it dies, the key is released for a retry afterwards.
+type Config struct {
+	TTL         time.Duration
+	LockTimeout time.Duration
+}
+
+type Service struct {
+	storage storage
+	cfg     Config
+}
+
+func New(storage storage, cfg Config) *Service {
+	return &Service{
+		storage: storage,
+		cfg:     cfg,
+	}
+}
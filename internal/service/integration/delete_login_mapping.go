@@ -0,0 +1,21 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error {
+	ctx, span := tracing.Start(ctx, "integration.DeleteLoginMapping")
+	defer span.End()
+
+	err := s.storage.DeleteLoginMapping(ctx, provider, normalizeLogin(login))
+	if err != nil {
+		return errors.Wrap(err, "storage deleting login mapping")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package integration
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// HandlePullRequestEvent creates a pull request when it is opened on the git hosting
+// and merges it when it is merged there. Changes are attributed to the provider.
+// Git hostings redeliver events, so an opened event for an existing pull
+// request is treated as already handled.
+func (s *Service) HandlePullRequestEvent(ctx context.Context, event model.GitPullRequestEvent) (model.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "integration.HandlePullRequestEvent", tracing.PullRequestID.String(event.PullRequestID))
+	defer span.End()
+
+	ctx = model.ContextWithActor(ctx, strings.ToLower(event.Provider.String()))
+
+	switch event.Action {
+	case model.GitPullRequestActionOpened:
+		return s.openPullRequest(ctx, event)
+	case model.GitPullRequestActionMerged:
+		return s.mergePullRequest(ctx, event)
+	default:
+		return model.PullRequest{}, errors.Wrapf(model.ErrInvalidGitPullRequestAction, "action %q", event.Action)
+	}
+}
+
+func (s *Service) openPullRequest(ctx context.Context, event model.GitPullRequestEvent) (model.PullRequest, error) {
+	authorID, err := s.storage.GetUserIDByLogin(ctx, event.Provider, normalizeLogin(event.AuthorLogin))
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "storage getting user ID by login")
+	}
+
+	created, err := s.pullRequestService.CreatePullRequest(ctx, model.PullRequest{
+		ID:       event.PullRequestID,
+		Name:     event.PullRequestName,
+		AuthorID: authorID,
+	}, model.ReviewerPreferences{})
+	if errors.Is(err, model.ErrPullRequestAlreadyExists) {
+		existing, getErr := s.pullRequestService.GetPullRequest(ctx, event.PullRequestID)
+		if getErr != nil {
+			return model.PullRequest{}, errors.Wrap(getErr, "getting existing pull request")
+		}
+
+		return existing.PullRequest, nil
+	}
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "creating pull request")
+	}
+
+	return created, nil
+}
+
+func (s *Service) mergePullRequest(ctx context.Context, event model.GitPullRequestEvent) (model.PullRequest, error) {
+	merged, err := s.pullRequestService.MergePullRequest(ctx, event.PullRequestID)
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "merging pull request")
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,40 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/integration/storage.go -package=mock -mock_names storage=IntegrationStorage,pullRequestService=PullRequestService
+type storage interface {
+	SaveLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error)
+	GetLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error)
+	DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error
+	GetUserIDByLogin(ctx context.Context, provider model.GitProvider, login string) (string, error)
+}
+
+type pullRequestService interface {
+	CreatePullRequest(
+		ctx context.Context,
+		request model.PullRequest,
+		preferences model.ReviewerPreferences,
+	) (model.PullRequest, error)
+	MergePullRequest(ctx context.Context, id string) (model.PullRequest, error)
+	GetPullRequest(ctx context.Context, id string) (model.PullRequestDetails, error)
+}
+
+type Service struct {
+	storage            storage
+	pullRequestService pullRequestService
+}
+
+func New(
+	storage storage,
+	pullRequestService pullRequestService,
+) *Service {
+	return &Service{
+		storage:            storage,
+		pullRequestService: pullRequestService,
+	}
+}
@@ -0,0 +1,267 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/integration"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/integration"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLogin   = "octocat"
+	testUserID  = "user-1"
+	testPRID    = "org/repo#1"
+	testPRTitle = "Add feature"
+)
+
+var errStorage = errors.New("storage error")
+
+func newService(t *testing.T) (*integration.Service, *mock.IntegrationStorage, *mock.PullRequestService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewIntegrationStorage(ctrl)
+	pullRequestService := mock.NewPullRequestService(ctrl)
+	service := integration.New(storage, pullRequestService)
+	return service, storage, pullRequestService
+}
+
+func TestHandlePullRequestEvent(t *testing.T) {
+	t.Parallel()
+
+	openedEvent := model.GitPullRequestEvent{
+		Provider:        model.GitProviderGithub,
+		Action:          model.GitPullRequestActionOpened,
+		PullRequestID:   testPRID,
+		PullRequestName: testPRTitle,
+		AuthorLogin:     "OctoCat",
+	}
+	mergedEvent := model.GitPullRequestEvent{
+		Provider:      model.GitProviderGitlab,
+		Action:        model.GitPullRequestActionMerged,
+		PullRequestID: testPRID,
+	}
+
+	tests := []struct {
+		name    string
+		event   model.GitPullRequestEvent
+		mock    func(storage *mock.IntegrationStorage, prService *mock.PullRequestService)
+		want    model.PullRequest
+		wantErr error
+	}{
+		{
+			name:  "opened creates pull request for mapped author",
+			event: openedEvent,
+			mock: func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {
+				storage.EXPECT().
+					GetUserIDByLogin(gomock.Any(), model.GitProviderGithub, testLogin).
+					Return(testUserID, nil)
+				prService.EXPECT().
+					CreatePullRequest(gomock.Any(), model.PullRequest{
+						ID:       testPRID,
+						Name:     testPRTitle,
+						AuthorID: testUserID,
+					}, model.ReviewerPreferences{}).
+					DoAndReturn(func(
+						ctx context.Context,
+						pr model.PullRequest,
+						_ model.ReviewerPreferences,
+					) (model.PullRequest, error) {
+						if model.ActorFromContext(ctx) != "github" {
+							return model.PullRequest{}, errors.New("unexpected actor")
+						}
+						pr.Status = model.StatusOpen
+						return pr, nil
+					})
+			},
+			want: model.PullRequest{
+				ID:       testPRID,
+				Name:     testPRTitle,
+				AuthorID: testUserID,
+				Status:   model.StatusOpen,
+			},
+		},
+		{
+			name:  "opened by unmapped login",
+			event: openedEvent,
+			mock: func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {
+				storage.EXPECT().
+					GetUserIDByLogin(gomock.Any(), model.GitProviderGithub, testLogin).
+					Return("", model.ErrGitLoginNotMapped)
+			},
+			wantErr: model.ErrGitLoginNotMapped,
+		},
+		{
+			name:  "opened twice",
+			event: openedEvent,
+			mock: func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {
+				storage.EXPECT().
+					GetUserIDByLogin(gomock.Any(), model.GitProviderGithub, testLogin).
+					Return(testUserID, nil)
+				prService.EXPECT().
+					CreatePullRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(model.PullRequest{}, model.ErrPullRequestAlreadyExists)
+				prService.EXPECT().
+					GetPullRequest(gomock.Any(), testPRID).
+					Return(model.PullRequestDetails{
+						PullRequest: model.PullRequest{ID: testPRID, Status: model.StatusOpen},
+					}, nil)
+			},
+			want: model.PullRequest{ID: testPRID, Status: model.StatusOpen},
+		},
+		{
+			name:  "merged merges pull request",
+			event: mergedEvent,
+			mock: func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {
+				prService.EXPECT().
+					MergePullRequest(gomock.Any(), testPRID).
+					Return(model.PullRequest{ID: testPRID, Status: model.StatusMerged}, nil)
+			},
+			want: model.PullRequest{ID: testPRID, Status: model.StatusMerged},
+		},
+		{
+			name:  "merged unknown pull request",
+			event: mergedEvent,
+			mock: func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {
+				prService.EXPECT().
+					MergePullRequest(gomock.Any(), testPRID).
+					Return(model.PullRequest{}, model.ErrPullRequestDoesNotExist)
+			},
+			wantErr: model.ErrPullRequestDoesNotExist,
+		},
+		{
+			name:    "unknown action",
+			event:   model.GitPullRequestEvent{Action: "CLOSED"},
+			mock:    func(storage *mock.IntegrationStorage, prService *mock.PullRequestService) {},
+			wantErr: model.ErrInvalidGitPullRequestAction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage, prService := newService(t)
+			tt.mock(storage, prService)
+
+			got, err := service.HandlePullRequestEvent(context.Background(), tt.event)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetLoginMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mapping model.GitLoginMapping
+		mock    func(storage *mock.IntegrationStorage)
+		want    model.GitLoginMapping
+		wantErr error
+	}{
+		{
+			name:    "normalizes login",
+			mapping: model.GitLoginMapping{Provider: model.GitProviderGithub, Login: " OctoCat ", UserID: testUserID},
+			mock: func(storage *mock.IntegrationStorage) {
+				mapping := model.GitLoginMapping{Provider: model.GitProviderGithub, Login: testLogin, UserID: testUserID}
+				storage.EXPECT().
+					SaveLoginMapping(gomock.Any(), mapping).
+					Return(mapping, nil)
+			},
+			want: model.GitLoginMapping{Provider: model.GitProviderGithub, Login: testLogin, UserID: testUserID},
+		},
+		{
+			name:    "user not found",
+			mapping: model.GitLoginMapping{Provider: model.GitProviderGitlab, Login: testLogin, UserID: "missing"},
+			mock: func(storage *mock.IntegrationStorage) {
+				storage.EXPECT().
+					SaveLoginMapping(gomock.Any(), gomock.Any()).
+					Return(model.GitLoginMapping{}, model.ErrUserDoesNotExist)
+			},
+			wantErr: model.ErrUserDoesNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage, _ := newService(t)
+			tt.mock(storage)
+
+			got, err := service.SetLoginMapping(context.Background(), tt.mapping)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteLoginMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(storage *mock.IntegrationStorage)
+		wantErr error
+	}{
+		{
+			name: "success",
+			mock: func(storage *mock.IntegrationStorage) {
+				storage.EXPECT().
+					DeleteLoginMapping(gomock.Any(), model.GitProviderGithub, testLogin).
+					Return(nil)
+			},
+		},
+		{
+			name: "not mapped",
+			mock: func(storage *mock.IntegrationStorage) {
+				storage.EXPECT().
+					DeleteLoginMapping(gomock.Any(), model.GitProviderGithub, testLogin).
+					Return(model.ErrGitLoginNotMapped)
+			},
+			wantErr: model.ErrGitLoginNotMapped,
+		},
+		{
+			name: "storage error",
+			mock: func(storage *mock.IntegrationStorage) {
+				storage.EXPECT().
+					DeleteLoginMapping(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errStorage)
+			},
+			wantErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage, _ := newService(t)
+			tt.mock(storage)
+
+			err := service.DeleteLoginMapping(context.Background(), model.GitProviderGithub, "OctoCat")
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) ListLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error) {
+	ctx, span := tracing.Start(ctx, "integration.ListLoginMappings")
+	defer span.End()
+
+	mappings, err := s.storage.GetLoginMappings(ctx, provider)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage getting login mappings")
+	}
+
+	return mappings, nil
+}
@@ -0,0 +1,9 @@
+package integration
+
+import "strings"
+
+// Git hostings treat logins case-insensitively,
+// so mappings are stored and looked up in lower case.
+func normalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
@@ -0,0 +1,25 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// SetLoginMapping links a git hosting login to a user,
+// replacing the user previously linked to the login.
+func (s *Service) SetLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error) {
+	ctx, span := tracing.Start(ctx, "integration.SetLoginMapping", tracing.UserID.String(mapping.UserID))
+	defer span.End()
+
+	mapping.Login = normalizeLogin(mapping.Login)
+
+	saved, err := s.storage.SaveLoginMapping(ctx, mapping)
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "storage saving login mapping")
+	}
+
+	return saved, nil
+}
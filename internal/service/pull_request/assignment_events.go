@@ -0,0 +1,88 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func newAssignedEvents(
+	ctx context.Context,
+	pullRequestID string,
+	reviewerIDs []string,
+	reason string,
+	at time.Time,
+) []model.AssignmentEvent {
+	actor := model.ActorFromContext(ctx)
+
+	return collection.Map(reviewerIDs, func(reviewerID string) model.AssignmentEvent {
+		return model.AssignmentEvent{
+			PullRequestID: pullRequestID,
+			Type:          model.AssignmentEventTypeAssigned,
+			Actor:         actor,
+			NewReviewerID: &reviewerID,
+			Reason:        reason,
+			CreatedAt:     at,
+		}
+	})
+}
+
+func newReassignedEvent(
+	ctx context.Context,
+	reassignment model.Reassignment,
+	reason string,
+	at time.Time,
+) model.AssignmentEvent {
+	return model.AssignmentEvent{
+		PullRequestID: reassignment.PullRequestID,
+		Type:          model.AssignmentEventTypeReassigned,
+		Actor:         model.ActorFromContext(ctx),
+		OldReviewerID: &reassignment.OldReviewerID,
+		NewReviewerID: &reassignment.NewReviewerID,
+		Reason:        reason,
+		CreatedAt:     at,
+	}
+}
+
+func newUnassignedEvent(
+	ctx context.Context,
+	removal model.ReviewerReplacement,
+	reason string,
+	at time.Time,
+) model.AssignmentEvent {
+	return model.AssignmentEvent{
+		PullRequestID: removal.PullRequestID,
+		Type:          model.AssignmentEventTypeUnassigned,
+		Actor:         model.ActorFromContext(ctx),
+		OldReviewerID: &removal.OldReviewerID,
+		Reason:        reason,
+		CreatedAt:     at,
+	}
+}
+
+func newMergedEvent(ctx context.Context, pullRequestID string, at time.Time) model.AssignmentEvent {
+	return model.AssignmentEvent{
+		PullRequestID: pullRequestID,
+		Type:          model.AssignmentEventTypeMerged,
+		Actor:         model.ActorFromContext(ctx),
+		Reason:        model.ReasonPullRequestMerged,
+		CreatedAt:     at,
+	}
+}
+
+// recordEvents writes the events to the audit log and queues webhook
+// notifications about them in the caller's transaction.
+func (s *Service) recordEvents(ctx context.Context, events []model.AssignmentEvent) error {
+	if _, err := s.pullRequestStorage.InsertAssignmentEvents(ctx, events); err != nil {
+		return errors.Wrap(err, "inserting assignment events")
+	}
+
+	if err := s.notifier.EnqueueAssignmentEvents(ctx, events); err != nil {
+		return errors.Wrap(err, "enqueueing notifications")
+	}
+
+	return nil
+}
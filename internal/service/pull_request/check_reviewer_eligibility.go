@@ -0,0 +1,34 @@
+package pullrequest
+
+import (
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// checkReviewerEligibility applies to an explicitly chosen reviewer the rules
+// the selectors apply to candidates: a member of the team, not the author
+// of pr, not already assigned to it and active.
+func checkReviewerEligibility(team model.Team, pr model.PullRequest, userID string) error {
+	idx := slices.IndexFunc(team.Members, func(user model.User) bool {
+		return user.ID == userID
+	})
+	if idx < 0 {
+		return errors.Wrapf(model.ErrReviewerNotInTeam, "user %q, team %q", userID, team.Name)
+	}
+
+	if userID == pr.AuthorID {
+		return errors.Wrapf(model.ErrReviewerIsAuthor, "user %q", userID)
+	}
+
+	if slices.Contains(pr.ReviewersIDs, userID) {
+		return errors.Wrapf(model.ErrReviewerAlreadyAssigned, "user %q", userID)
+	}
+
+	if !team.Members[idx].IsActive {
+		return errors.Wrapf(model.ErrReviewerInactive, "user %q", userID)
+	}
+
+	return nil
+}
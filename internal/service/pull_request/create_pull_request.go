@@ -2,53 +2,116 @@ package pullrequest
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
 	"github.com/pkg/errors"
 )
 
-const (
-	maxCreateReviewersCount = 2
-)
+// CreatePullRequest assigns as many reviewers as the team settings allow,
+// or as asked for within their bounds. The preferred reviewers go first,
+// each of them must be eligible, and the remaining slots are filled by
+// the team strategy among active teammates that are not excluded.
+func (s *Service) CreatePullRequest(
+	ctx context.Context,
+	request model.PullRequest,
+	preferences model.ReviewerPreferences,
+) (model.PullRequest, error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"pullrequest.CreatePullRequest",
+		tracing.PullRequestID.String(request.ID),
+		tracing.UserID.String(request.AuthorID),
+	)
+	defer span.End()
 
-func (s *Service) CreatePullRequest(ctx context.Context, request model.PullRequest) (model.PullRequest, error) {
 	team, err := s.teamStorage.GetTeamByUserID(ctx, request.AuthorID)
 	if err != nil {
 		return model.PullRequest{}, errors.Wrap(err, "getting team by user ID")
 	}
+	span.SetAttributes(tracing.TeamName.String(team.Name))
+
+	reviewersCount := team.Settings.MaxReviewers
+	if preferences.Count != nil {
+		if !team.Settings.Allows(*preferences.Count) {
+			return model.PullRequest{}, errors.Wrapf(
+				model.ErrInvalidReviewersCount,
+				"%d reviewers, team allows %d to %d",
+				*preferences.Count,
+				team.Settings.MinReviewers,
+				team.Settings.MaxReviewers,
+			)
+		}
+		reviewersCount = *preferences.Count
+	}
+
+	if len(preferences.Preferred) > reviewersCount {
+		return model.PullRequest{}, errors.Wrapf(
+			model.ErrTooManyReviewers,
+			"%d preferred, at most %d",
+			len(preferences.Preferred),
+			reviewersCount,
+		)
+	}
+
+	preferred := model.PullRequest{AuthorID: request.AuthorID}
+	for _, id := range preferences.Preferred {
+		if err = checkReviewerEligibility(team, preferred, id); err != nil {
+			return model.PullRequest{}, errors.Wrap(err, "checking preferred reviewer")
+		}
+		preferred.ReviewersIDs = append(preferred.ReviewersIDs, id)
+	}
 
 	activeTeammates := collection.Filter(
 		team.Members,
 		func(user model.User) bool {
-			return user.IsActive && user.ID != request.AuthorID
+			return user.IsActive &&
+				user.ID != request.AuthorID &&
+				!slices.Contains(preferred.ReviewersIDs, user.ID) &&
+				!slices.Contains(preferences.Excluded, user.ID)
 		},
 	)
-	activeTeammatesIDs := collection.Map(activeTeammates, model.User.GetID)
-
-	reviewers, err := s.getRandomReviewers(activeTeammatesIDs, maxCreateReviewersCount)
-	if err != nil {
-		return model.PullRequest{}, errors.Wrap(err, "getting random reviewers")
-	}
-
-	createdAt := time.Now().UTC()
-	pullRequest := model.PullRequest{
-		ID:           request.ID,
-		Name:         request.Name,
-		AuthorID:     request.AuthorID,
-		Status:       model.StatusOpen,
-		ReviewersIDs: reviewers,
-		CreatedAt:    &createdAt,
-	}
 
 	var createdPullRequest model.PullRequest
 	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		selected, txErr := s.selectReviewers(
+			ctx,
+			&team,
+			activeTeammates,
+			reviewersCount-len(preferred.ReviewersIDs),
+		)
+		if txErr != nil {
+			return errors.Wrap(txErr, "selecting reviewers")
+		}
+		reviewers := make([]string, 0, len(preferred.ReviewersIDs)+len(selected))
+		reviewers = append(reviewers, preferred.ReviewersIDs...)
+		reviewers = append(reviewers, selected...)
+		span.SetAttributes(tracing.ReviewerIDs.StringSlice(reviewers))
+
+		createdAt := time.Now().UTC()
+		pullRequest := model.PullRequest{
+			ID:                request.ID,
+			Name:              request.Name,
+			AuthorID:          request.AuthorID,
+			Status:            model.StatusOpen,
+			ReviewersIDs:      reviewers,
+			NeedMoreReviewers: len(reviewers) < team.Settings.MinReviewers,
+			CreatedAt:         &createdAt,
+		}
+
 		inserted, txErr := s.pullRequestStorage.InsertPullRequest(ctx, pullRequest)
 		if txErr != nil {
 			return errors.Wrap(txErr, "insert pull request")
 		}
 
+		events := newAssignedEvents(ctx, inserted.ID, inserted.ReviewersIDs, model.ReasonPullRequestCreated, createdAt)
+		if txErr = s.recordEvents(ctx, events); txErr != nil {
+			return errors.Wrap(txErr, "recording assignment events")
+		}
+
 		createdPullRequest = inserted
 
 		return nil
@@ -57,5 +120,7 @@ func (s *Service) CreatePullRequest(ctx context.Context, request model.PullReque
 		return model.PullRequest{}, errors.Wrap(err, "creating pull request in tx")
 	}
 
+	s.recorder.PullRequestCreated()
+
 	return createdPullRequest, nil
 }
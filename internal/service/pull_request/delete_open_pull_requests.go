@@ -0,0 +1,33 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// DeleteOpenPullRequestsByAuthors removes OPEN pull requests of the given
+// authors together with their reviewers and assignment history.
+func (s *Service) DeleteOpenPullRequestsByAuthors(
+	ctx context.Context,
+	authorIDs []string,
+) ([]string, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.DeleteOpenPullRequestsByAuthors")
+	defer span.End()
+
+	if len(authorIDs) == 0 {
+		return []string{}, nil
+	}
+
+	deletedIDs, err := s.pullRequestStorage.DeleteOpenPullRequestsByAuthors(ctx, authorIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "deleting open pull requests")
+	}
+
+	if deletedIDs == nil {
+		deletedIDs = []string{}
+	}
+
+	return deletedIDs, nil
+}
@@ -0,0 +1,48 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// GetPullRequest returns the pull request with its author and reviewers
+// without changing anything.
+func (s *Service) GetPullRequest(ctx context.Context, id string) (model.PullRequestDetails, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.GetPullRequest", tracing.PullRequestID.String(id))
+	defer span.End()
+
+	pr, err := s.pullRequestStorage.GetPullRequestByID(ctx, id)
+	if err != nil {
+		return model.PullRequestDetails{}, errors.Wrap(err, "getting pull request")
+	}
+
+	team, err := s.teamStorage.GetTeamByUserID(ctx, pr.AuthorID)
+	if err != nil {
+		return model.PullRequestDetails{}, errors.Wrap(err, "getting author team")
+	}
+
+	author := model.User{
+		ID:       pr.AuthorID,
+		TeamName: team.Name,
+	}
+	for _, member := range team.Members {
+		if member.ID == pr.AuthorID {
+			author = member
+			break
+		}
+	}
+
+	reviewers, err := s.pullRequestStorage.GetPullRequestReviewers(ctx, id)
+	if err != nil {
+		return model.PullRequestDetails{}, errors.Wrap(err, "getting reviewers")
+	}
+
+	return model.PullRequestDetails{
+		PullRequest: pr,
+		Author:      author,
+		Reviewers:   reviewers,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) GetPullRequestHistory(ctx context.Context, id string) ([]model.AssignmentEvent, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.GetPullRequestHistory", tracing.PullRequestID.String(id))
+	defer span.End()
+
+	if _, err := s.pullRequestStorage.GetPullRequestByID(ctx, id); err != nil {
+		return nil, errors.Wrap(err, "getting pull request")
+	}
+
+	events, err := s.pullRequestStorage.GetAssignmentEvents(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting assignment events")
+	}
+
+	return events, nil
+}
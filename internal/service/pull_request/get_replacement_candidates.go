@@ -0,0 +1,30 @@
+package pullrequest
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+)
+
+// getReplacementCandidates returns active members of the reviewer's team
+// who may replace reviewerID on pr: not the author and not already assigned.
+func getReplacementCandidates(team model.Team, pr model.PullRequest, reviewerID string) []model.User {
+	currentReviewers := make(map[string]struct{}, len(pr.ReviewersIDs))
+	for _, id := range pr.ReviewersIDs {
+		currentReviewers[id] = struct{}{}
+	}
+
+	return collection.Filter(
+		team.Members,
+		func(user model.User) bool {
+			if !user.IsActive || user.ID == reviewerID || user.ID == pr.AuthorID {
+				return false
+			}
+
+			if _, exists := currentReviewers[user.ID]; exists {
+				return false
+			}
+
+			return true
+		},
+	)
+}
@@ -0,0 +1,22 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// HasMergedPullRequests tells whether any of the users authored
+// or reviewed a MERGED pull request.
+func (s *Service) HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.HasMergedPullRequests", tracing.UserIDs.StringSlice(userIDs))
+	defer span.End()
+
+	exists, err := s.pullRequestStorage.HasMergedPullRequests(ctx, userIDs)
+	if err != nil {
+		return false, errors.Wrap(err, "checking merged pull requests")
+	}
+
+	return exists, nil
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 )
 
-//go:generate mockgen -source=implementation.go -destination=../../mock/pull_request/storage.go -package=mock -mock_names teamStorage=TeamStorage,pullRequestStorage=PullRequestStorage
+//go:generate mockgen -source=implementation.go -destination=../../mock/pull_request/storage.go -package=mock -mock_names teamStorage=TeamStorage,pullRequestStorage=PullRequestStorage,notifier=Notifier,recorder=Recorder
 type (
 	teamStorage interface {
 		GetTeamByUserID(ctx context.Context, userID string) (model.Team, error)
+		GetTeamByName(ctx context.Context, name string) (model.Team, error)
+		UpdateRoundRobinCursor(ctx context.Context, name string, userID string) error
 	}
 
 	pullRequestStorage interface {
@@ -18,24 +20,55 @@ type (
 		InsertPullRequest(ctx context.Context, request model.PullRequest) (model.PullRequest, error)
 		UpdatePullRequestInfo(ctx context.Context, req model.PullRequest) (model.PullRequest, error)
 		UpdatePullRequestReviewers(ctx context.Context, req model.PullRequest) (model.PullRequest, error)
+		GetOpenPullRequestsByReviewers(ctx context.Context, ids []string) ([]model.PullRequest, error)
+		GetPullRequestsNeedingReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error)
+		ReplaceReviewers(ctx context.Context, reassignments []model.Reassignment) ([]model.Reassignment, error)
+		InsertAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) ([]model.AssignmentEvent, error)
+		GetAssignmentEvents(ctx context.Context, pullRequestID string) ([]model.AssignmentEvent, error)
+		ListPullRequests(ctx context.Context, filter model.PullRequestFilter) ([]model.PullRequest, error)
+		GetPullRequestReviewers(ctx context.Context, id string) ([]model.User, error)
+		GetOpenReviewCounts(ctx context.Context, reviewerIDs []string) (map[string]int, error)
+		RemoveOpenReviewers(ctx context.Context, reviewerIDs []string) ([]model.ReviewerReplacement, error)
+		RefreshNeedMoreReviewers(ctx context.Context, teamName string, minReviewers int) error
+		DeleteOpenPullRequestsByAuthors(ctx context.Context, authorIDs []string) ([]string, error)
+		HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error)
+	}
+
+	notifier interface {
+		EnqueueAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) error
+	}
+
+	recorder interface {
+		PullRequestCreated()
+		PullRequestMerged()
+		ReviewerReassigned()
+		NoReassignmentCandidate()
 	}
 )
 
 type Service struct {
 	teamStorage        teamStorage
 	pullRequestStorage pullRequestStorage
+	notifier           notifier
+	recorder           recorder
 
+	selectors map[model.ReviewerStrategy]ReviewerSelector
 	trManager trm.Manager
 }
 
 func New(
 	teamStorage teamStorage,
 	pullRequestStorage pullRequestStorage,
+	notifier notifier,
+	recorder recorder,
 	trManager trm.Manager,
 ) *Service {
 	return &Service{
 		teamStorage:        teamStorage,
 		pullRequestStorage: pullRequestStorage,
+		notifier:           notifier,
+		recorder:           recorder,
+		selectors:          newReviewerSelectors(teamStorage, pullRequestStorage),
 		trManager:          trManager,
 	}
 }
@@ -0,0 +1,47 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// leastLoadedSelector picks candidates with the fewest OPEN reviews,
+// breaking ties by user ID.
+type leastLoadedSelector struct {
+	pullRequestStorage pullRequestStorage
+}
+
+func newLeastLoadedSelector(pullRequestStorage pullRequestStorage) *leastLoadedSelector {
+	return &leastLoadedSelector{
+		pullRequestStorage: pullRequestStorage,
+	}
+}
+
+func (l *leastLoadedSelector) SelectReviewers(
+	ctx context.Context,
+	_ *model.Team,
+	candidates []model.User,
+	count int,
+) ([]string, error) {
+	candidatesIDs := collection.Map(candidates, model.User.GetID)
+
+	loads, err := l.pullRequestStorage.GetOpenReviewCounts(ctx, candidatesIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting open review counts")
+	}
+
+	slices.SortFunc(candidatesIDs, func(a, b string) int {
+		if c := cmp.Compare(loads[a], loads[b]); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	return candidatesIDs[:min(count, len(candidatesIDs))], nil
+}
@@ -0,0 +1,50 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultListPageSize = 50
+)
+
+// ListPullRequests returns one page of pull requests, newest first.
+// Next is set only when there are more pull requests after the page.
+func (s *Service) ListPullRequests(
+	ctx context.Context,
+	filter model.PullRequestFilter,
+) (model.PullRequestPage, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.ListPullRequests")
+	defer span.End()
+
+	pageSize := filter.Limit
+	if pageSize <= 0 {
+		pageSize = defaultListPageSize
+	}
+	filter.Limit = pageSize + 1
+
+	pullRequests, err := s.pullRequestStorage.ListPullRequests(ctx, filter)
+	if err != nil {
+		return model.PullRequestPage{}, errors.Wrap(err, "listing pull requests")
+	}
+
+	page := model.PullRequestPage{
+		PullRequests: pullRequests,
+	}
+
+	if len(pullRequests) > pageSize {
+		page.PullRequests = pullRequests[:pageSize]
+
+		last := page.PullRequests[pageSize-1]
+		page.Next = &model.PullRequestCursor{
+			CreatedAt: *last.CreatedAt,
+			ID:        last.ID,
+		}
+	}
+
+	return page, nil
+}
@@ -5,27 +5,59 @@ import (
 	"time"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 	"github.com/pkg/errors"
 )
 
+// MergePullRequest is idempotent. A reassignment committed between
+// the read and the update fails the call with model.ErrPullRequestConflict.
 func (s *Service) MergePullRequest(ctx context.Context, id string) (model.PullRequest, error) {
-	pr, err := s.pullRequestStorage.GetPullRequestByID(ctx, id)
-	if err != nil {
-		return model.PullRequest{}, errors.Wrap(err, "getting pull request")
-	}
+	ctx, span := tracing.Start(ctx, "pullrequest.MergePullRequest", tracing.PullRequestID.String(id))
+	defer span.End()
 
-	if pr.Status == model.StatusMerged {
-		return pr, nil
-	}
+	var (
+		updated model.PullRequest
+		merged  bool
+	)
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		pr, txErr := s.pullRequestStorage.GetPullRequestByID(ctx, id)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting pull request")
+		}
+
+		if pr.Status == model.StatusMerged {
+			updated = pr
+			return nil
+		}
+
+		now := time.Now().UTC()
+		pr.Status = model.StatusMerged
+		pr.MergedAt = &now
 
-	now := time.Now().UTC()
-	pr.Status = model.StatusMerged
-	pr.MergedAt = &now
+		txUpdated, txErr := s.pullRequestStorage.UpdatePullRequestInfo(ctx, pr)
+		if txErr != nil {
+			return errors.Wrap(txErr, "updating pull request info")
+		}
 
-	updated, err := s.pullRequestStorage.UpdatePullRequestInfo(ctx, pr)
+		event := newMergedEvent(ctx, txUpdated.ID, now)
+		if txErr = s.recordEvents(ctx, []model.AssignmentEvent{event}); txErr != nil {
+			return errors.Wrap(txErr, "recording assignment events")
+		}
+
+		updated = txUpdated
+		merged = true
+
+		return nil
+	})
 	if err != nil {
-		return model.PullRequest{}, errors.Wrap(err, "updating pull request info")
+		return model.PullRequest{}, errors.Wrap(err, "merging pull request in tx")
 	}
 
+	if !merged {
+		return updated, nil
+	}
+
+	s.recorder.PullRequestMerged()
+
 	return updated, nil
 }
@@ -33,8 +33,15 @@ func newService(t *testing.T) (*pullrequest.Service, *mock.TeamStorage, *mock.Pu
 	ctrl := gomock.NewController(t)
 	teamStorage := mock.NewTeamStorage(ctrl)
 	pullRequestStorage := mock.NewPullRequestStorage(ctrl)
+	notifier := mock.NewNotifier(ctrl)
+	notifier.EXPECT().EnqueueAssignmentEvents(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	recorder := mock.NewRecorder(ctrl)
+	recorder.EXPECT().PullRequestCreated().AnyTimes()
+	recorder.EXPECT().PullRequestMerged().AnyTimes()
+	recorder.EXPECT().ReviewerReassigned().AnyTimes()
+	recorder.EXPECT().NoReassignmentCandidate().AnyTimes()
 	trManager := trmanager.NewMockTrManager()
-	service := pullrequest.New(teamStorage, pullRequestStorage, trManager)
+	service := pullrequest.New(teamStorage, pullRequestStorage, notifier, recorder, trManager)
 	return service, teamStorage, pullRequestStorage
 }
 
@@ -42,8 +49,9 @@ func TestCreatePullRequest(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx     context.Context
-		request model.PullRequest
+		ctx         context.Context
+		request     model.PullRequest
+		preferences model.ReviewerPreferences
 	}
 
 	tests := []struct {
@@ -83,7 +91,8 @@ func TestCreatePullRequest(t *testing.T) {
 			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testAuthorID,
@@ -103,6 +112,10 @@ func TestCreatePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -126,7 +139,8 @@ func TestCreatePullRequest(t *testing.T) {
 			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testAuthorID,
@@ -146,6 +160,10 @@ func TestCreatePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -169,7 +187,8 @@ func TestCreatePullRequest(t *testing.T) {
 			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testAuthorID,
@@ -195,6 +214,10 @@ func TestCreatePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -218,7 +241,8 @@ func TestCreatePullRequest(t *testing.T) {
 			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testAuthorID,
@@ -250,6 +274,10 @@ func TestCreatePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -273,7 +301,8 @@ func TestCreatePullRequest(t *testing.T) {
 			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testAuthorID,
@@ -299,6 +328,10 @@ func TestCreatePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -309,6 +342,288 @@ func TestCreatePullRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "least loaded strategy - fewest open reviews",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:             testTeamName,
+						Settings:         model.DefaultTeamSettings(),
+						ReviewerStrategy: model.ReviewerStrategyLeastLoaded,
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID3, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().GetOpenReviewCounts(gomock.Any(), []string{testUserID1, testUserID2, testUserID3}).
+					Return(map[string]int{testUserID1: 5, testUserID3: 1}, nil)
+				prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: model.PullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusOpen,
+				ReviewersIDs: []string{testUserID2, testUserID3},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "round robin strategy - continues after cursor",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				cursor := testUserID2
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:             testTeamName,
+						Settings:         model.DefaultTeamSettings(),
+						ReviewerStrategy: model.ReviewerStrategyRoundRobin,
+						RoundRobinCursor: &cursor,
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID3, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				teamStorage.EXPECT().UpdateRoundRobinCursor(gomock.Any(), testTeamName, testUserID1).
+					Return(nil)
+				prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: model.PullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusOpen,
+				ReviewersIDs: []string{testUserID3, testUserID1},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "seniority strategy - picks among active teammates",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:             testTeamName,
+						Settings:         model.DefaultTeamSettings(),
+						ReviewerStrategy: model.ReviewerStrategySeniority,
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true, Seniority: 5},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true, Seniority: 1},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: false, Seniority: 5},
+							{ID: testUserID3, TeamName: testTeamName, IsActive: true, Seniority: 3},
+						},
+					}, nil)
+				prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: model.PullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusOpen,
+				ReviewersIDs: []string{testUserID1, testUserID3},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "preferred reviewer first, excluded never picked",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+				preferences: model.ReviewerPreferences{
+					Preferred: []string{testUserID3},
+					Excluded:  []string{testUserID1},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID3, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: model.PullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusOpen,
+				ReviewersIDs: []string{testUserID3, testUserID2},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "too many preferred reviewers",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+				preferences: model.ReviewerPreferences{
+					Preferred: []string{testUserID1, testUserID2, testUserID3},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID3, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+			},
+			want:    model.PullRequest{},
+			wantErr: model.ErrTooManyReviewers,
+		},
+		{
+			name: "preferred reviewer is inactive",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+				preferences: model.ReviewerPreferences{
+					Preferred: []string{testUserID1},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: false},
+						},
+					}, nil)
+			},
+			want:    model.PullRequest{},
+			wantErr: model.ErrReviewerInactive,
+		},
+		{
+			name: "preferred reviewer is not in team",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+				preferences: model.ReviewerPreferences{
+					Preferred: []string{testUserID4},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+			},
+			want:    model.PullRequest{},
+			wantErr: model.ErrReviewerNotInTeam,
+		},
+		{
+			name: "preferred reviewer is the author",
+			args: args{
+				ctx: context.Background(),
+				request: model.PullRequest{
+					ID:       testPRID,
+					Name:     testPRName,
+					AuthorID: testAuthorID,
+				},
+				preferences: model.ReviewerPreferences{
+					Preferred: []string{testAuthorID},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+			},
+			want:    model.PullRequest{},
+			wantErr: model.ErrReviewerIsAuthor,
+		},
 	}
 
 	for _, tt := range tests {
@@ -318,7 +633,7 @@ func TestCreatePullRequest(t *testing.T) {
 			service, teamStorage, prStorage := newService(t)
 			tt.mock(teamStorage, prStorage)
 
-			got, err := service.CreatePullRequest(tt.args.ctx, tt.args.request)
+			got, err := service.CreatePullRequest(tt.args.ctx, tt.args.request, tt.args.preferences)
 
 			require.ErrorIs(t, err, tt.wantErr)
 
@@ -333,6 +648,7 @@ func TestCreatePullRequest(t *testing.T) {
 					require.LessOrEqual(t, len(got.ReviewersIDs), 2)
 					require.NotContains(t, got.ReviewersIDs, tt.args.request.AuthorID)
 				}
+				require.Equal(t, len(got.ReviewersIDs) < 2, got.NeedMoreReviewers)
 				require.NotNil(t, got.CreatedAt)
 				require.Nil(t, got.MergedAt)
 			} else {
@@ -342,6 +658,127 @@ func TestCreatePullRequest(t *testing.T) {
 	}
 }
 
+func TestCreatePullRequest_ReviewersCount(t *testing.T) {
+	t.Parallel()
+
+	team := model.Team{
+		Name:     testTeamName,
+		Settings: model.TeamSettings{MinReviewers: 1, MaxReviewers: 3},
+		Members: []model.User{
+			{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID3, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID4, TeamName: testTeamName, IsActive: true},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		count         *int
+		wantReviewers int
+		wantErr       error
+	}{
+		{
+			name:          "team maximum by default",
+			wantReviewers: 3,
+		},
+		{
+			name:          "count override within bounds",
+			count:         ptr(1),
+			wantReviewers: 1,
+		},
+		{
+			name:    "count override above maximum",
+			count:   ptr(4),
+			wantErr: model.ErrInvalidReviewersCount,
+		},
+		{
+			name:    "count override below minimum",
+			count:   ptr(0),
+			wantErr: model.ErrInvalidReviewersCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, prStorage := newService(t)
+			teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).Return(team, nil)
+			prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+					return pr, nil
+				}).
+				AnyTimes()
+			prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+					return e, nil
+				}).
+				AnyTimes()
+
+			got, err := service.CreatePullRequest(
+				context.Background(),
+				model.PullRequest{ID: testPRID, Name: testPRName, AuthorID: testAuthorID},
+				model.ReviewerPreferences{Count: tt.count},
+			)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				require.Len(t, got.ReviewersIDs, tt.wantReviewers)
+				require.NotContains(t, got.ReviewersIDs, testAuthorID)
+				require.False(t, got.NeedMoreReviewers)
+			}
+		})
+	}
+}
+
+func TestCreatePullRequest_SeniorityFavoursJuniors(t *testing.T) {
+	t.Parallel()
+
+	const runs = 500
+
+	team := model.Team{
+		Name:             testTeamName,
+		Settings:         model.TeamSettings{MinReviewers: 1, MaxReviewers: 1},
+		ReviewerStrategy: model.ReviewerStrategySeniority,
+		Members: []model.User{
+			{ID: testAuthorID, TeamName: testTeamName, IsActive: true, Seniority: 3},
+			{ID: testUserID1, TeamName: testTeamName, IsActive: true, Seniority: 1},
+			{ID: testUserID2, TeamName: testTeamName, IsActive: true, Seniority: 5},
+		},
+	}
+
+	service, teamStorage, prStorage := newService(t)
+	teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).Return(team, nil).Times(runs)
+	prStorage.EXPECT().InsertPullRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+			return pr, nil
+		}).
+		Times(runs)
+	prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+			return e, nil
+		}).
+		Times(runs)
+
+	picks := make(map[string]int)
+	for range runs {
+		got, err := service.CreatePullRequest(
+			context.Background(),
+			model.PullRequest{ID: testPRID, Name: testPRName, AuthorID: testAuthorID},
+			model.ReviewerPreferences{},
+		)
+		require.NoError(t, err)
+		require.Len(t, got.ReviewersIDs, 1)
+		picks[got.ReviewersIDs[0]]++
+	}
+
+	// Weights are 5 for the junior and 1 for the senior, so the junior
+	// is expected about 5 times as often; 2 leaves a wide margin.
+	require.Greater(t, picks[testUserID1], 2*picks[testUserID2], picks)
+}
+
 func TestMergePullRequest(t *testing.T) {
 	t.Parallel()
 
@@ -391,6 +828,10 @@ func TestMergePullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.PullRequest{
 				ID:           testPRID,
@@ -403,33 +844,56 @@ func TestMergePullRequest(t *testing.T) {
 			wantErr: nil,
 		},
 		{
-			name: "idempotent - already merged",
+			name: "stale write - conflict",
 			args: args{
 				ctx: context.Background(),
 				id:  testPRID,
 			},
 			mock: func(prStorage *mock.PullRequestStorage) {
-				mergedTime := mockTime.Add(-time.Hour)
 				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
 					Return(model.PullRequest{
 						ID:           testPRID,
 						Name:         testPRName,
 						AuthorID:     testAuthorID,
-						Status:       model.StatusMerged,
+						Status:       model.StatusOpen,
 						ReviewersIDs: []string{testReviewerID1},
+						Version:      1,
 						CreatedAt:    &mockTime,
-						MergedAt:     &mergedTime,
 					}, nil)
+				prStorage.EXPECT().UpdatePullRequestInfo(gomock.Any(), gomock.Any()).
+					Return(model.PullRequest{}, model.ErrPullRequestConflict)
+			},
+			want:    model.PullRequest{},
+			wantErr: model.ErrPullRequestConflict,
+		},
+		{
+			name: "idempotent - already merged",
+			args: args{
+				ctx: context.Background(),
+				id:  testPRID,
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				mergedTime := mockTime.Add(-time.Hour)
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{
+						ID:           testPRID,
+						Name:         testPRName,
+						AuthorID:     testAuthorID,
+						Status:       model.StatusMerged,
+						ReviewersIDs: []string{testReviewerID1},
+						CreatedAt:    &mockTime,
+						MergedAt:     &mergedTime,
+					}, nil)
+			},
+			want: model.PullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusMerged,
+				ReviewersIDs: []string{testReviewerID1},
+				CreatedAt:    &mockTime,
+				MergedAt:     func() *time.Time { t := mockTime.Add(-time.Hour); return &t }(),
 			},
-			want: model.PullRequest{
-				ID:           testPRID,
-				Name:         testPRName,
-				AuthorID:     testAuthorID,
-				Status:       model.StatusMerged,
-				ReviewersIDs: []string{testReviewerID1},
-				CreatedAt:    &mockTime,
-				MergedAt:     func() *time.Time { t := mockTime.Add(-time.Hour); return &t }(),
-			},
 			wantErr: nil,
 		},
 	}
@@ -459,9 +923,10 @@ func TestReassignPullRequest(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		ctx        context.Context
-		id         string
-		reviewerID string
+		ctx           context.Context
+		id            string
+		reviewerID    string
+		newReviewerID *string
 	}
 
 	tests := []struct {
@@ -548,7 +1013,8 @@ func TestReassignPullRequest(t *testing.T) {
 					}, nil)
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testReviewerID1,
@@ -582,7 +1048,8 @@ func TestReassignPullRequest(t *testing.T) {
 
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testReviewerID1,
@@ -609,6 +1076,11 @@ func TestReassignPullRequest(t *testing.T) {
 					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
 						return pr, nil
 					})
+
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
 			},
 			want: model.ReassignedPullRequest{
 				ID:           testPRID,
@@ -621,6 +1093,39 @@ func TestReassignPullRequest(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "stale write - conflict",
+			args: args{
+				ctx:        context.Background(),
+				id:         testPRID,
+				reviewerID: testReviewerID1,
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{
+						ID:           testPRID,
+						Name:         testPRName,
+						AuthorID:     testAuthorID,
+						Status:       model.StatusOpen,
+						ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+						Version:      2,
+						CreatedAt:    &mockTime,
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().UpdatePullRequestReviewers(gomock.Any(), gomock.Any()).
+					Return(model.PullRequest{}, model.ErrPullRequestConflict)
+			},
+			want:    model.ReassignedPullRequest{},
+			wantErr: model.ErrPullRequestConflict,
+		},
 		{
 			name: "all team members already reviewers - no candidate",
 			args: args{
@@ -640,7 +1145,8 @@ func TestReassignPullRequest(t *testing.T) {
 					}, nil)
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testReviewerID1,
@@ -679,7 +1185,8 @@ func TestReassignPullRequest(t *testing.T) {
 					}, nil)
 				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
 					Return(model.Team{
-						Name: testTeamName,
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
 						Members: []model.User{
 							{
 								ID:       testReviewerID1,
@@ -699,6 +1206,117 @@ func TestReassignPullRequest(t *testing.T) {
 			want:    model.ReassignedPullRequest{},
 			wantErr: model.ErrNoCandidate,
 		},
+		{
+			name: "chosen reviewer replaces reviewer",
+			args: args{
+				ctx:           context.Background(),
+				id:            testPRID,
+				reviewerID:    testReviewerID1,
+				newReviewerID: ptr(testUserID2),
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{
+						ID:           testPRID,
+						Name:         testPRName,
+						AuthorID:     testAuthorID,
+						Status:       model.StatusOpen,
+						ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+						CreatedAt:    &mockTime,
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+							{ID: testReviewerID2, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().UpdatePullRequestReviewers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: model.ReassignedPullRequest{
+				ID:           testPRID,
+				Name:         testPRName,
+				AuthorID:     testAuthorID,
+				Status:       model.StatusOpen,
+				ReviewersIDs: []string{testUserID2, testReviewerID2},
+				CreatedAt:    &mockTime,
+				ReassignedBy: testUserID2,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "chosen reviewer already assigned",
+			args: args{
+				ctx:           context.Background(),
+				id:            testPRID,
+				reviewerID:    testReviewerID1,
+				newReviewerID: ptr(testReviewerID2),
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{
+						ID:           testPRID,
+						Name:         testPRName,
+						AuthorID:     testAuthorID,
+						Status:       model.StatusOpen,
+						ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+						CreatedAt:    &mockTime,
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+							{ID: testReviewerID2, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+			},
+			want:    model.ReassignedPullRequest{},
+			wantErr: model.ErrReviewerAlreadyAssigned,
+		},
+		{
+			name: "chosen reviewer is inactive",
+			args: args{
+				ctx:           context.Background(),
+				id:            testPRID,
+				reviewerID:    testReviewerID1,
+				newReviewerID: ptr(testUserID1),
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{
+						ID:           testPRID,
+						Name:         testPRName,
+						AuthorID:     testAuthorID,
+						Status:       model.StatusOpen,
+						ReviewersIDs: []string{testReviewerID1},
+						CreatedAt:    &mockTime,
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: false},
+						},
+					}, nil)
+			},
+			want:    model.ReassignedPullRequest{},
+			wantErr: model.ErrReviewerInactive,
+		},
 	}
 
 	for _, tt := range tests {
@@ -708,7 +1326,7 @@ func TestReassignPullRequest(t *testing.T) {
 			service, teamStorage, prStorage := newService(t)
 			tt.mock(teamStorage, prStorage)
 
-			got, err := service.ReassignPullRequest(tt.args.ctx, tt.args.id, tt.args.reviewerID)
+			got, err := service.ReassignPullRequest(tt.args.ctx, tt.args.id, tt.args.reviewerID, tt.args.newReviewerID)
 
 			require.ErrorIs(t, err, tt.wantErr)
 
@@ -725,3 +1343,712 @@ func TestReassignPullRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestReassignReviewers(t *testing.T) {
+	t.Parallel()
+
+	newReviewerID := testUserID1
+
+	type args struct {
+		ctx         context.Context
+		reviewerIDs []string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage)
+		want    []model.ReviewerReplacement
+		wantErr error
+	}{
+		{
+			name: "no reviewers - nothing to do",
+			args: args{
+				ctx:         context.Background(),
+				reviewerIDs: nil,
+			},
+			mock: func(_ *mock.TeamStorage, _ *mock.PullRequestStorage) {},
+			want: []model.ReviewerReplacement{},
+		},
+		{
+			name: "team not found",
+			args: args{
+				ctx:         context.Background(),
+				reviewerIDs: []string{testReviewerID1},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetOpenPullRequestsByReviewers(gomock.Any(), []string{testReviewerID1}).
+					Return([]model.PullRequest{
+						{
+							ID:           testPRID,
+							AuthorID:     testAuthorID,
+							Status:       model.StatusOpen,
+							ReviewersIDs: []string{testReviewerID1},
+						},
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    nil,
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "replaces reviewer and keeps reviewer without candidate",
+			args: args{
+				ctx:         context.Background(),
+				reviewerIDs: []string{testReviewerID1, testReviewerID2},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().
+					GetOpenPullRequestsByReviewers(gomock.Any(), []string{testReviewerID1, testReviewerID2}).
+					Return([]model.PullRequest{
+						{
+							ID:           testPRID,
+							AuthorID:     testAuthorID,
+							Status:       model.StatusOpen,
+							ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+						},
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: false},
+							{ID: testReviewerID2, TeamName: testTeamName, IsActive: false},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().ReplaceReviewers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, r []model.Reassignment) ([]model.Reassignment, error) {
+						return r, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: []model.ReviewerReplacement{
+				{
+					PullRequestID: testPRID,
+					OldReviewerID: testReviewerID1,
+					NewReviewerID: &newReviewerID,
+				},
+				{
+					PullRequestID: testPRID,
+					OldReviewerID: testReviewerID2,
+					NewReviewerID: nil,
+				},
+			},
+		},
+		{
+			name: "concurrent change - conflict without events",
+			args: args{
+				ctx:         context.Background(),
+				reviewerIDs: []string{testReviewerID1},
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetOpenPullRequestsByReviewers(gomock.Any(), []string{testReviewerID1}).
+					Return([]model.PullRequest{
+						{
+							ID:           testPRID,
+							AuthorID:     testAuthorID,
+							Status:       model.StatusOpen,
+							ReviewersIDs: []string{testReviewerID1},
+							Version:      3,
+						},
+					}, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: false},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+						},
+					}, nil)
+				prStorage.EXPECT().ReplaceReviewers(gomock.Any(), []model.Reassignment{
+					{
+						PullRequestID: testPRID,
+						OldReviewerID: testReviewerID1,
+						NewReviewerID: testUserID1,
+						Version:       3,
+					},
+				}).Return(nil, model.ErrPullRequestConflict)
+			},
+			want:    nil,
+			wantErr: model.ErrPullRequestConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, prStorage := newService(t)
+			tt.mock(teamStorage, prStorage)
+
+			got, err := service.ReassignReviewers(tt.args.ctx, tt.args.reviewerIDs, model.ReasonReviewerDeactivated)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTopUpReviewers(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx      context.Context
+		teamName string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage)
+		want    []model.PullRequest
+		wantErr error
+	}{
+		{
+			name: "nothing to top up",
+			args: args{
+				ctx:      context.Background(),
+				teamName: testTeamName,
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{Name: testTeamName, Settings: model.DefaultTeamSettings()}, nil)
+				prStorage.EXPECT().RefreshNeedMoreReviewers(gomock.Any(), testTeamName, 2).
+					Return(nil)
+				prStorage.EXPECT().GetPullRequestsNeedingReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
+			},
+			want: []model.PullRequest{},
+		},
+		{
+			name: "team not found",
+			args: args{
+				ctx:      context.Background(),
+				teamName: testTeamName,
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    nil,
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "adds activated teammate and clears flag",
+			args: args{
+				ctx:      context.Background(),
+				teamName: testTeamName,
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID2, TeamName: testTeamName, IsActive: false},
+						},
+					}, nil)
+				prStorage.EXPECT().RefreshNeedMoreReviewers(gomock.Any(), testTeamName, 2).
+					Return(nil)
+				prStorage.EXPECT().GetPullRequestsNeedingReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{
+						{
+							ID:                testPRID,
+							AuthorID:          testAuthorID,
+							Status:            model.StatusOpen,
+							ReviewersIDs:      []string{testReviewerID1},
+							NeedMoreReviewers: true,
+						},
+					}, nil)
+				prStorage.EXPECT().UpdatePullRequestReviewers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+						return pr, nil
+					})
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						return e, nil
+					})
+			},
+			want: []model.PullRequest{
+				{
+					ID:                testPRID,
+					AuthorID:          testAuthorID,
+					Status:            model.StatusOpen,
+					ReviewersIDs:      []string{testReviewerID1, testUserID1},
+					NeedMoreReviewers: false,
+				},
+			},
+		},
+		{
+			name: "no candidates - pull request stays flagged",
+			args: args{
+				ctx:      context.Background(),
+				teamName: testTeamName,
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members: []model.User{
+							{ID: testAuthorID, TeamName: testTeamName, IsActive: true},
+							{ID: testUserID1, TeamName: testTeamName, IsActive: false},
+						},
+					}, nil)
+				prStorage.EXPECT().RefreshNeedMoreReviewers(gomock.Any(), testTeamName, 2).
+					Return(nil)
+				prStorage.EXPECT().GetPullRequestsNeedingReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{
+						{
+							ID:                testPRID,
+							AuthorID:          testAuthorID,
+							Status:            model.StatusOpen,
+							ReviewersIDs:      []string{},
+							NeedMoreReviewers: true,
+						},
+					}, nil)
+			},
+			want: []model.PullRequest{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, prStorage := newService(t)
+			tt.mock(teamStorage, prStorage)
+
+			got, err := service.TopUpReviewers(tt.args.ctx, tt.args.teamName)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetPullRequestHistory(t *testing.T) {
+	t.Parallel()
+
+	oldReviewerID := testReviewerID1
+	newReviewerID := testUserID1
+
+	events := []model.AssignmentEvent{
+		{
+			PullRequestID: testPRID,
+			Type:          model.AssignmentEventTypeAssigned,
+			Actor:         model.ActorAnonymous,
+			NewReviewerID: &oldReviewerID,
+			Reason:        model.ReasonPullRequestCreated,
+			CreatedAt:     mockTime,
+		},
+		{
+			PullRequestID: testPRID,
+			Type:          model.AssignmentEventTypeReassigned,
+			Actor:         "admin",
+			OldReviewerID: &oldReviewerID,
+			NewReviewerID: &newReviewerID,
+			Reason:        model.ReasonReassignRequested,
+			CreatedAt:     mockTime,
+		},
+	}
+
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(prStorage *mock.PullRequestStorage)
+		want    []model.AssignmentEvent
+		wantErr error
+	}{
+		{
+			name: "pull request not found",
+			args: args{
+				ctx: context.Background(),
+				id:  testPRID,
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{}, model.ErrPullRequestDoesNotExist)
+			},
+			want:    nil,
+			wantErr: model.ErrPullRequestDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx: context.Background(),
+				id:  testPRID,
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{ID: testPRID}, nil)
+				prStorage.EXPECT().GetAssignmentEvents(gomock.Any(), testPRID).
+					Return(events, nil)
+			},
+			want:    events,
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _, prStorage := newService(t)
+			tt.mock(prStorage)
+
+			got, err := service.GetPullRequestHistory(tt.args.ctx, tt.args.id)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReassignPullRequest_RecordsActor(t *testing.T) {
+	t.Parallel()
+
+	service, teamStorage, prStorage := newService(t)
+	ctx := model.ContextWithActor(context.Background(), "admin")
+
+	prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+		Return(model.PullRequest{
+			ID:           testPRID,
+			AuthorID:     testAuthorID,
+			Status:       model.StatusOpen,
+			ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+		}, nil)
+	teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testReviewerID1).
+		Return(model.Team{
+			Name:     testTeamName,
+			Settings: model.DefaultTeamSettings(),
+			Members: []model.User{
+				{ID: testReviewerID1, TeamName: testTeamName, IsActive: true},
+				{ID: testReviewerID2, TeamName: testTeamName, IsActive: true},
+				{ID: testUserID1, TeamName: testTeamName, IsActive: true},
+			},
+		}, nil)
+	prStorage.EXPECT().UpdatePullRequestReviewers(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, pr model.PullRequest) (model.PullRequest, error) {
+			return pr, nil
+		})
+
+	var recorded []model.AssignmentEvent
+	prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, e []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+			recorded = e
+			return e, nil
+		})
+
+	_, err := service.ReassignPullRequest(ctx, testPRID, testReviewerID1, nil)
+	require.NoError(t, err)
+
+	require.Len(t, recorded, 1)
+	require.Equal(t, model.AssignmentEventTypeReassigned, recorded[0].Type)
+	require.Equal(t, "admin", recorded[0].Actor)
+	require.Equal(t, model.ReasonReassignRequested, recorded[0].Reason)
+	require.Equal(t, testReviewerID1, *recorded[0].OldReviewerID)
+	require.Equal(t, testUserID1, *recorded[0].NewReviewerID)
+}
+
+func TestReassignPullRequest_MemberNotReviewer(t *testing.T) {
+	t.Parallel()
+
+	service, _, _ := newService(t)
+	memberID := testUserID1
+	ctx := model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:     "member",
+		Role:   model.RoleMember,
+		UserID: &memberID,
+	})
+
+	_, err := service.ReassignPullRequest(ctx, testPRID, testReviewerID1, nil)
+	require.ErrorIs(t, err, model.ErrForbidden)
+}
+
+func TestListPullRequests(t *testing.T) {
+	t.Parallel()
+
+	newer := mockTime.Add(time.Minute)
+	older := mockTime.Add(-time.Minute)
+	reviewerID := testReviewerID1
+
+	type args struct {
+		ctx    context.Context
+		filter model.PullRequestFilter
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(prStorage *mock.PullRequestStorage)
+		want    model.PullRequestPage
+		wantErr error
+	}{
+		{
+			name: "storage error",
+			args: args{
+				ctx:    context.Background(),
+				filter: model.PullRequestFilter{Limit: 2},
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().ListPullRequests(gomock.Any(), model.PullRequestFilter{Limit: 3}).
+					Return(nil, model.ErrTeamDoesNotExist)
+			},
+			want:    model.PullRequestPage{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "last page - no cursor",
+			args: args{
+				ctx:    context.Background(),
+				filter: model.PullRequestFilter{ReviewerID: &reviewerID, Limit: 2},
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().ListPullRequests(gomock.Any(), model.PullRequestFilter{
+					ReviewerID: &reviewerID,
+					Limit:      3,
+				}).
+					Return([]model.PullRequest{
+						{ID: testPRID, CreatedAt: &mockTime},
+					}, nil)
+			},
+			want: model.PullRequestPage{
+				PullRequests: []model.PullRequest{
+					{ID: testPRID, CreatedAt: &mockTime},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "full page - cursor points at last item",
+			args: args{
+				ctx:    context.Background(),
+				filter: model.PullRequestFilter{Limit: 2},
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().ListPullRequests(gomock.Any(), model.PullRequestFilter{Limit: 3}).
+					Return([]model.PullRequest{
+						{ID: "pr-3", CreatedAt: &newer},
+						{ID: "pr-2", CreatedAt: &mockTime},
+						{ID: "pr-1", CreatedAt: &older},
+					}, nil)
+			},
+			want: model.PullRequestPage{
+				PullRequests: []model.PullRequest{
+					{ID: "pr-3", CreatedAt: &newer},
+					{ID: "pr-2", CreatedAt: &mockTime},
+				},
+				Next: &model.PullRequestCursor{
+					CreatedAt: mockTime,
+					ID:        "pr-2",
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "default page size",
+			args: args{
+				ctx:    context.Background(),
+				filter: model.PullRequestFilter{},
+			},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().ListPullRequests(gomock.Any(), model.PullRequestFilter{Limit: 51}).
+					Return([]model.PullRequest{}, nil)
+			},
+			want: model.PullRequestPage{
+				PullRequests: []model.PullRequest{},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _, prStorage := newService(t)
+			tt.mock(prStorage)
+
+			got, err := service.ListPullRequests(tt.args.ctx, tt.args.filter)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetPullRequest(t *testing.T) {
+	t.Parallel()
+
+	pr := model.PullRequest{
+		ID:           testPRID,
+		Name:         testPRName,
+		AuthorID:     testAuthorID,
+		Status:       model.StatusMerged,
+		ReviewersIDs: []string{testReviewerID1, testReviewerID2},
+		CreatedAt:    &mockTime,
+		MergedAt:     &mockTime,
+	}
+	author := model.User{ID: testAuthorID, Name: "Author", TeamName: testTeamName, IsActive: true}
+	reviewers := []model.User{
+		{ID: testReviewerID1, Name: "Reviewer 1", TeamName: testTeamName, IsActive: true},
+		{ID: testReviewerID2, Name: "Reviewer 2", TeamName: "frontend", IsActive: false},
+	}
+
+	type args struct {
+		ctx context.Context
+		id  string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage)
+		want    model.PullRequestDetails
+		wantErr error
+	}{
+		{
+			name: "pull request not found",
+			args: args{
+				ctx: context.Background(),
+				id:  testPRID,
+			},
+			mock: func(_ *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(model.PullRequest{}, model.ErrPullRequestDoesNotExist)
+			},
+			want:    model.PullRequestDetails{},
+			wantErr: model.ErrPullRequestDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx: context.Background(),
+				id:  testPRID,
+			},
+			mock: func(teamStorage *mock.TeamStorage, prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().GetPullRequestByID(gomock.Any(), testPRID).
+					Return(pr, nil)
+				teamStorage.EXPECT().GetTeamByUserID(gomock.Any(), testAuthorID).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: model.DefaultTeamSettings(),
+						Members:  []model.User{author, reviewers[0]},
+					}, nil)
+				prStorage.EXPECT().GetPullRequestReviewers(gomock.Any(), testPRID).
+					Return(reviewers, nil)
+			},
+			want: model.PullRequestDetails{
+				PullRequest: pr,
+				Author:      author,
+				Reviewers:   reviewers,
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, prStorage := newService(t)
+			tt.mock(teamStorage, prStorage)
+
+			got, err := service.GetPullRequest(tt.args.ctx, tt.args.id)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnassignReviewers(t *testing.T) {
+	t.Parallel()
+
+	removals := []model.ReviewerReplacement{
+		{PullRequestID: testPRID, OldReviewerID: testReviewerID1},
+	}
+
+	tests := []struct {
+		name        string
+		reviewerIDs []string
+		mock        func(prStorage *mock.PullRequestStorage)
+		want        []model.ReviewerReplacement
+		wantErr     error
+	}{
+		{
+			name:        "no reviewers - nothing to do",
+			reviewerIDs: nil,
+			mock:        func(_ *mock.PullRequestStorage) {},
+			want:        []model.ReviewerReplacement{},
+		},
+		{
+			name:        "no open reviews",
+			reviewerIDs: []string{testReviewerID1},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().RemoveOpenReviewers(gomock.Any(), []string{testReviewerID1}).
+					Return(nil, nil)
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Len(0)).
+					Return(nil, nil)
+			},
+			want: []model.ReviewerReplacement{},
+		},
+		{
+			name:        "records unassigned events",
+			reviewerIDs: []string{testReviewerID1},
+			mock: func(prStorage *mock.PullRequestStorage) {
+				prStorage.EXPECT().RemoveOpenReviewers(gomock.Any(), []string{testReviewerID1}).
+					Return(removals, nil)
+				prStorage.EXPECT().InsertAssignmentEvents(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, events []model.AssignmentEvent) ([]model.AssignmentEvent, error) {
+						if len(events) != 1 ||
+							events[0].Type != model.AssignmentEventTypeUnassigned ||
+							*events[0].OldReviewerID != testReviewerID1 ||
+							events[0].NewReviewerID != nil ||
+							events[0].Reason != model.ReasonTeamDeleted {
+							return nil, model.ErrReviewerNotAssign
+						}
+
+						return events, nil
+					})
+			},
+			want: removals,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _, prStorage := newService(t)
+			tt.mock(prStorage)
+
+			got, err := service.UnassignReviewers(context.Background(), tt.reviewerIDs, model.ReasonTeamDeleted)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,46 @@
+package pullrequest
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// randomSelector picks reviewers uniformly at random.
+type randomSelector struct{}
+
+func newRandomSelector() *randomSelector {
+	return &randomSelector{}
+}
+
+func (r *randomSelector) SelectReviewers(
+	_ context.Context,
+	_ *model.Team,
+	candidates []model.User,
+	count int,
+) ([]string, error) {
+	candidatesIDs := collection.Map(candidates, model.User.GetID)
+	if len(candidatesIDs) <= count {
+		return candidatesIDs, nil
+	}
+
+	selected := make(map[string]struct{}, count)
+	for len(selected) < count {
+		nBig, err := rand.Int(rand.Reader, big.NewInt(int64(len(candidatesIDs))))
+		if err != nil {
+			return nil, errors.Wrap(err, "generating random number")
+		}
+
+		idx := int(nBig.Int64())
+		id := candidatesIDs[idx]
+		selected[id] = struct{}{}
+	}
+
+	reviewers := collection.Keys(selected)
+
+	return reviewers, nil
+}
@@ -3,9 +3,10 @@ package pullrequest
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
-	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 	"github.com/pkg/errors"
 )
 
@@ -13,93 +14,121 @@ const (
 	reassignReviewersCount = 1
 )
 
+// ReassignPullRequest reads and updates the pull request in one transaction,
+// a concurrent change of it fails the call with model.ErrPullRequestConflict.
+// A non-nil chosenReviewerID replaces reviewerID if it is eligible, otherwise
+// the replacement is picked by the team strategy.
 func (s *Service) ReassignPullRequest(
 	ctx context.Context,
 	id string,
 	reviewerID string,
+	chosenReviewerID *string,
 ) (model.ReassignedPullRequest, error) {
-	pr, err := s.pullRequestStorage.GetPullRequestByID(ctx, id)
-	if err != nil {
-		return model.ReassignedPullRequest{}, errors.Wrap(err, "getting pull request")
-	}
+	ctx, span := tracing.Start(
+		ctx,
+		"pullrequest.ReassignPullRequest",
+		tracing.PullRequestID.String(id),
+		tracing.UserID.String(reviewerID),
+	)
+	defer span.End()
 
-	if pr.Status == model.StatusMerged {
-		return model.ReassignedPullRequest{}, model.ErrPullRequestIsMerged
+	if err := model.AuthorizeUser(ctx, reviewerID); err != nil {
+		return model.ReassignedPullRequest{}, err
 	}
 
-	if !slices.Contains(pr.ReviewersIDs, reviewerID) {
-		return model.ReassignedPullRequest{}, model.ErrReviewerNotAssign
-	}
+	var (
+		updatedPr     model.PullRequest
+		newReviewerID string
+	)
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		pr, txErr := s.pullRequestStorage.GetPullRequestByID(ctx, id)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting pull request")
+		}
 
-	team, err := s.teamStorage.GetTeamByUserID(ctx, reviewerID)
-	if err != nil {
-		return model.ReassignedPullRequest{}, errors.Wrap(err, "getting team")
-	}
+		if pr.Status == model.StatusMerged {
+			return model.ErrPullRequestIsMerged
+		}
 
-	currentReviewers := make(map[string]struct{}, len(pr.ReviewersIDs))
-	for _, id := range pr.ReviewersIDs {
-		currentReviewers[id] = struct{}{}
-	}
+		if !slices.Contains(pr.ReviewersIDs, reviewerID) {
+			return model.ErrReviewerNotAssign
+		}
 
-	validNewReviewers := collection.Filter(
-		team.Members,
-		func(user model.User) bool {
-			if !user.IsActive || user.ID == reviewerID || user.ID == pr.AuthorID {
-				return false
-			}
+		team, txErr := s.teamStorage.GetTeamByUserID(ctx, reviewerID)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting team")
+		}
+		span.SetAttributes(tracing.TeamName.String(team.Name))
 
-			if _, exists := currentReviewers[user.ID]; exists {
-				return false
+		if chosenReviewerID != nil {
+			if txErr = checkReviewerEligibility(team, pr, *chosenReviewerID); txErr != nil {
+				return errors.Wrap(txErr, "checking chosen reviewer")
+			}
+			newReviewerID = *chosenReviewerID
+		} else {
+			validNewReviewers := getReplacementCandidates(team, pr, reviewerID)
+			if len(validNewReviewers) == 0 {
+				return model.ErrNoCandidate
 			}
 
-			return true
-		},
-	)
-	validNewReviewersIDs := collection.Map(validNewReviewers, model.User.GetID)
-	selectedReviewers, err := s.getRandomReviewers(
-		validNewReviewersIDs,
-		reassignReviewersCount,
-	)
-	if err != nil {
-		return model.ReassignedPullRequest{}, errors.Wrap(err, "selecting new reviewer")
-	}
-	if len(selectedReviewers) == 0 {
-		return model.ReassignedPullRequest{}, model.ErrNoCandidate
-	}
-
-	newReviewerID := selectedReviewers[0]
+			selectedReviewers, selectErr := s.selectReviewers(ctx, &team, validNewReviewers, reassignReviewersCount)
+			if selectErr != nil {
+				return errors.Wrap(selectErr, "selecting new reviewer")
+			}
+			newReviewerID = selectedReviewers[0]
+		}
+		span.SetAttributes(tracing.ReviewerIDs.StringSlice([]string{newReviewerID}))
 
-	for i, id := range pr.ReviewersIDs {
-		if id == reviewerID {
-			pr.ReviewersIDs[i] = newReviewerID
-			break
+		for i, id := range pr.ReviewersIDs {
+			if id == reviewerID {
+				pr.ReviewersIDs[i] = newReviewerID
+				break
+			}
 		}
-	}
+		pr.NeedMoreReviewers = len(pr.ReviewersIDs) < team.Settings.MinReviewers
 
-	var updatedPr model.PullRequest
-	err = s.trManager.Do(ctx, func(ctx context.Context) error {
 		updated, txErr := s.pullRequestStorage.UpdatePullRequestReviewers(ctx, pr)
 		if txErr != nil {
 			return errors.Wrap(txErr, "reassigning pull request")
 		}
 
+		event := newReassignedEvent(
+			ctx,
+			model.Reassignment{
+				PullRequestID: updated.ID,
+				OldReviewerID: reviewerID,
+				NewReviewerID: newReviewerID,
+			},
+			model.ReasonReassignRequested,
+			time.Now().UTC(),
+		)
+		if txErr = s.recordEvents(ctx, []model.AssignmentEvent{event}); txErr != nil {
+			return errors.Wrap(txErr, "recording assignment events")
+		}
+
 		updatedPr = updated
 
 		return nil
 	})
+	if errors.Is(err, model.ErrNoCandidate) {
+		s.recorder.NoReassignmentCandidate()
+	}
 	if err != nil {
 		return model.ReassignedPullRequest{}, errors.Wrap(err, "reassigning pull request in tx")
 	}
 
+	s.recorder.ReviewerReassigned()
+
 	reassignedPullRequest := model.ReassignedPullRequest{
-		ID:           updatedPr.ID,
-		Name:         updatedPr.Name,
-		AuthorID:     updatedPr.AuthorID,
-		Status:       updatedPr.Status,
-		ReviewersIDs: updatedPr.ReviewersIDs,
-		CreatedAt:    updatedPr.CreatedAt,
-		MergedAt:     updatedPr.MergedAt,
-		ReassignedBy: newReviewerID,
+		ID:                updatedPr.ID,
+		Name:              updatedPr.Name,
+		AuthorID:          updatedPr.AuthorID,
+		Status:            updatedPr.Status,
+		ReviewersIDs:      updatedPr.ReviewersIDs,
+		NeedMoreReviewers: updatedPr.NeedMoreReviewers,
+		CreatedAt:         updatedPr.CreatedAt,
+		MergedAt:          updatedPr.MergedAt,
+		ReassignedBy:      newReviewerID,
 	}
 
 	return reassignedPullRequest, nil
@@ -0,0 +1,123 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// ReassignReviewers replaces every given reviewer on all OPEN pull requests
+// they review with teammates from the reviewer's current team. Reviewers
+// without a candidate stay assigned and are reported with a nil NewReviewerID.
+// A pull request changed since it was read fails the call with
+// model.ErrPullRequestConflict.
+func (s *Service) ReassignReviewers(
+	ctx context.Context,
+	reviewerIDs []string,
+	reason string,
+) ([]model.ReviewerReplacement, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.ReassignReviewers", tracing.UserIDs.StringSlice(reviewerIDs))
+	defer span.End()
+
+	if len(reviewerIDs) == 0 {
+		return []model.ReviewerReplacement{}, nil
+	}
+
+	removed := make(map[string]struct{}, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		removed[id] = struct{}{}
+	}
+
+	var replacements []model.ReviewerReplacement
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		pullRequests, txErr := s.pullRequestStorage.GetOpenPullRequestsByReviewers(ctx, reviewerIDs)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting open pull requests")
+		}
+
+		teamsByUserID := make(map[string]*model.Team)
+		reassignments := make([]model.Reassignment, 0, len(pullRequests))
+		replacements = make([]model.ReviewerReplacement, 0, len(pullRequests))
+		now := time.Now().UTC()
+
+		for _, pr := range pullRequests {
+			for i, reviewerID := range pr.ReviewersIDs {
+				if _, ok := removed[reviewerID]; !ok {
+					continue
+				}
+
+				team, ok := teamsByUserID[reviewerID]
+				if !ok {
+					fetched, txErr := s.teamStorage.GetTeamByUserID(ctx, reviewerID)
+					if txErr != nil {
+						return errors.Wrap(txErr, "getting team")
+					}
+
+					team = &fetched
+					for _, member := range team.Members {
+						teamsByUserID[member.ID] = team
+					}
+				}
+
+				candidates := collection.Filter(
+					getReplacementCandidates(*team, pr, reviewerID),
+					func(user model.User) bool {
+						_, isRemoved := removed[user.ID]
+						return !isRemoved
+					},
+				)
+
+				selected, txErr := s.selectReviewers(ctx, team, candidates, reassignReviewersCount)
+				if txErr != nil {
+					return errors.Wrap(txErr, "selecting new reviewer")
+				}
+
+				replacement := model.ReviewerReplacement{
+					PullRequestID: pr.ID,
+					OldReviewerID: reviewerID,
+				}
+
+				if len(selected) > 0 {
+					newReviewerID := selected[0]
+					pr.ReviewersIDs[i] = newReviewerID
+					replacement.NewReviewerID = &newReviewerID
+
+					reassignments = append(reassignments, model.Reassignment{
+						PullRequestID: pr.ID,
+						OldReviewerID: reviewerID,
+						NewReviewerID: newReviewerID,
+						Version:       pr.Version,
+					})
+				}
+
+				replacements = append(replacements, replacement)
+			}
+		}
+
+		if len(reassignments) == 0 {
+			return nil
+		}
+
+		if _, txErr = s.pullRequestStorage.ReplaceReviewers(ctx, reassignments); txErr != nil {
+			return errors.Wrap(txErr, "replacing reviewers")
+		}
+
+		events := collection.Map(reassignments, func(reassignment model.Reassignment) model.AssignmentEvent {
+			return newReassignedEvent(ctx, reassignment, reason, now)
+		})
+		if txErr = s.recordEvents(ctx, events); txErr != nil {
+			return errors.Wrap(txErr, "recording assignment events")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reassigning reviewers in tx")
+	}
+
+	return replacements, nil
+}
@@ -0,0 +1,56 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// ReviewerSelector picks up to count reviewers among candidates of the team.
+// Implementations may advance per-team state, so team is passed by pointer.
+type ReviewerSelector interface {
+	SelectReviewers(
+		ctx context.Context,
+		team *model.Team,
+		candidates []model.User,
+		count int,
+	) ([]string, error)
+}
+
+func newReviewerSelectors(
+	teamStorage teamStorage,
+	pullRequestStorage pullRequestStorage,
+) map[model.ReviewerStrategy]ReviewerSelector {
+	return map[model.ReviewerStrategy]ReviewerSelector{
+		model.ReviewerStrategyRandom:      newRandomSelector(),
+		model.ReviewerStrategyLeastLoaded: newLeastLoadedSelector(pullRequestStorage),
+		model.ReviewerStrategyRoundRobin:  newRoundRobinSelector(teamStorage),
+		model.ReviewerStrategySeniority:   newSenioritySelector(),
+	}
+}
+
+// selectReviewers delegates to the selector of the team strategy,
+// falling back to random choice for teams without one.
+func (s *Service) selectReviewers(
+	ctx context.Context,
+	team *model.Team,
+	candidates []model.User,
+	count int,
+) ([]string, error) {
+	if count <= 0 || len(candidates) == 0 {
+		return []string{}, nil
+	}
+
+	selector, ok := s.selectors[team.ReviewerStrategy]
+	if !ok {
+		selector = s.selectors[model.ReviewerStrategyRandom]
+	}
+
+	reviewers, err := selector.SelectReviewers(ctx, team, candidates, count)
+	if err != nil {
+		return nil, errors.Wrapf(err, "selecting reviewers with %s strategy", team.ReviewerStrategy)
+	}
+
+	return reviewers, nil
+}
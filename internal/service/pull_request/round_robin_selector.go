@@ -0,0 +1,55 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// roundRobinSelector walks team members in user ID order, starting right
+// after the last assigned one, and persists the position per team.
+type roundRobinSelector struct {
+	teamStorage teamStorage
+}
+
+func newRoundRobinSelector(teamStorage teamStorage) *roundRobinSelector {
+	return &roundRobinSelector{
+		teamStorage: teamStorage,
+	}
+}
+
+func (r *roundRobinSelector) SelectReviewers(
+	ctx context.Context,
+	team *model.Team,
+	candidates []model.User,
+	count int,
+) ([]string, error) {
+	candidatesIDs := collection.Map(candidates, model.User.GetID)
+	slices.Sort(candidatesIDs)
+
+	start := 0
+	if team.RoundRobinCursor != nil {
+		cursor := *team.RoundRobinCursor
+		start = max(slices.IndexFunc(candidatesIDs, func(id string) bool {
+			return id > cursor
+		}), 0)
+	}
+
+	count = min(count, len(candidatesIDs))
+	reviewers := make([]string, 0, count)
+	for i := range count {
+		reviewers = append(reviewers, candidatesIDs[(start+i)%len(candidatesIDs)])
+	}
+
+	cursor := reviewers[len(reviewers)-1]
+	if err := r.teamStorage.UpdateRoundRobinCursor(ctx, team.Name, cursor); err != nil {
+		return nil, errors.Wrap(err, "updating round robin cursor")
+	}
+
+	team.RoundRobinCursor = &cursor
+
+	return reviewers, nil
+}
@@ -0,0 +1,67 @@
+package pullrequest
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// senioritySelector picks reviewers at random, favouring juniors to take
+// load off the seniors: a candidate weighs maxSeniority - seniority + 1,
+// so the most senior candidates weigh 1 and equal seniority means equal odds.
+type senioritySelector struct{}
+
+func newSenioritySelector() *senioritySelector {
+	return &senioritySelector{}
+}
+
+func (s *senioritySelector) SelectReviewers(
+	_ context.Context,
+	_ *model.Team,
+	candidates []model.User,
+	count int,
+) ([]string, error) {
+	remaining := make([]model.User, len(candidates))
+	copy(remaining, candidates)
+
+	maxSeniority := 1
+	for _, candidate := range remaining {
+		maxSeniority = max(maxSeniority, candidate.Seniority)
+	}
+
+	totalWeight := int64(0)
+	for _, candidate := range remaining {
+		totalWeight += seniorityWeight(candidate, maxSeniority)
+	}
+
+	reviewers := make([]string, 0, min(count, len(remaining)))
+	for len(reviewers) < count && len(remaining) > 0 {
+		nBig, err := rand.Int(rand.Reader, big.NewInt(totalWeight))
+		if err != nil {
+			return nil, errors.Wrap(err, "generating random number")
+		}
+
+		point := nBig.Int64()
+		idx := 0
+		for ; idx < len(remaining)-1; idx++ {
+			point -= seniorityWeight(remaining[idx], maxSeniority)
+			if point < 0 {
+				break
+			}
+		}
+
+		picked := remaining[idx]
+		reviewers = append(reviewers, picked.ID)
+		totalWeight -= seniorityWeight(picked, maxSeniority)
+		remaining = append(remaining[:idx], remaining[idx+1:]...)
+	}
+
+	return reviewers, nil
+}
+
+func seniorityWeight(user model.User, maxSeniority int) int64 {
+	return int64(maxSeniority - max(user.Seniority, 1) + 1)
+}
@@ -0,0 +1,79 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// TopUpReviewers assigns active members of the team to its OPEN pull requests
+// that were created or left with fewer reviewers than the team minimum.
+// The need_more_reviewers flags are first brought in line with the current
+// minimum, which may have changed since they were set.
+func (s *Service) TopUpReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.TopUpReviewers", tracing.TeamName.String(teamName))
+	defer span.End()
+
+	var updatedPullRequests []model.PullRequest
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, txErr := s.teamStorage.GetTeamByName(ctx, teamName)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting team")
+		}
+
+		txErr = s.pullRequestStorage.RefreshNeedMoreReviewers(ctx, teamName, team.Settings.MinReviewers)
+		if txErr != nil {
+			return errors.Wrap(txErr, "refreshing need more reviewers")
+		}
+
+		pullRequests, txErr := s.pullRequestStorage.GetPullRequestsNeedingReviewers(ctx, teamName)
+		if txErr != nil {
+			return errors.Wrap(txErr, "getting pull requests needing reviewers")
+		}
+
+		updatedPullRequests = make([]model.PullRequest, 0, len(pullRequests))
+		for _, pr := range pullRequests {
+			candidates := getReplacementCandidates(team, pr, "")
+
+			selected, txErr := s.selectReviewers(
+				ctx,
+				&team,
+				candidates,
+				team.Settings.MinReviewers-len(pr.ReviewersIDs),
+			)
+			if txErr != nil {
+				return errors.Wrap(txErr, "selecting reviewers")
+			}
+
+			// The flag is already set, so there is nothing to store.
+			if len(selected) == 0 {
+				continue
+			}
+
+			pr.ReviewersIDs = append(pr.ReviewersIDs, selected...)
+			pr.NeedMoreReviewers = len(pr.ReviewersIDs) < team.Settings.MinReviewers
+
+			updated, txErr := s.pullRequestStorage.UpdatePullRequestReviewers(ctx, pr)
+			if txErr != nil {
+				return errors.Wrap(txErr, "updating pull request reviewers")
+			}
+
+			events := newAssignedEvents(ctx, pr.ID, selected, model.ReasonTeammateAvailable, time.Now().UTC())
+			if txErr = s.recordEvents(ctx, events); txErr != nil {
+				return errors.Wrap(txErr, "recording assignment events")
+			}
+
+			updatedPullRequests = append(updatedPullRequests, updated)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "topping up reviewers in tx")
+	}
+
+	return updatedPullRequests, nil
+}
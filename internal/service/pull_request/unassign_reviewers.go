@@ -0,0 +1,56 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// UnassignReviewers removes the given reviewers from all OPEN pull requests
+// they review without picking replacements. The affected pull requests are
+// flagged as needing more reviewers so that a later top-up can fill them.
+func (s *Service) UnassignReviewers(
+	ctx context.Context,
+	reviewerIDs []string,
+	reason string,
+) ([]model.ReviewerReplacement, error) {
+	ctx, span := tracing.Start(ctx, "pullrequest.UnassignReviewers", tracing.UserIDs.StringSlice(reviewerIDs))
+	defer span.End()
+
+	if len(reviewerIDs) == 0 {
+		return []model.ReviewerReplacement{}, nil
+	}
+
+	var removals []model.ReviewerReplacement
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		txRemovals, err := s.pullRequestStorage.RemoveOpenReviewers(ctx, reviewerIDs)
+		if err != nil {
+			return errors.Wrap(err, "removing open reviewers")
+		}
+
+		now := time.Now().UTC()
+		events := collection.Map(txRemovals, func(removal model.ReviewerReplacement) model.AssignmentEvent {
+			return newUnassignedEvent(ctx, removal, reason, now)
+		})
+		if err = s.recordEvents(ctx, events); err != nil {
+			return errors.Wrap(err, "recording assignment events")
+		}
+
+		removals = txRemovals
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unassigning reviewers in tx")
+	}
+
+	if removals == nil {
+		removals = []model.ReviewerReplacement{}
+	}
+
+	return removals, nil
+}
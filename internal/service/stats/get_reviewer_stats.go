@@ -0,0 +1,29 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) GetReviewerStats(ctx context.Context, filter model.StatsFilter) (model.Stats, error) {
+	ctx, span := tracing.Start(ctx, "stats.GetReviewerStats")
+	defer span.End()
+
+	reviewers, err := s.storage.GetReviewerStats(ctx, filter)
+	if err != nil {
+		return model.Stats{}, errors.Wrap(err, "getting reviewer stats")
+	}
+
+	pullRequests, err := s.storage.GetPullRequestStats(ctx, filter)
+	if err != nil {
+		return model.Stats{}, errors.Wrap(err, "getting pull request stats")
+	}
+
+	return model.Stats{
+		Reviewers:    reviewers,
+		PullRequests: pullRequests,
+	}, nil
+}
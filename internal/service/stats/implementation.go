@@ -0,0 +1,23 @@
+package stats
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/stats/storage.go -package=mock -mock_names storage=StatsStorage
+type storage interface {
+	GetReviewerStats(ctx context.Context, filter model.StatsFilter) ([]model.ReviewerStats, error)
+	GetPullRequestStats(ctx context.Context, filter model.StatsFilter) ([]model.PullRequestStats, error)
+}
+
+type Service struct {
+	storage storage
+}
+
+func New(storage storage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
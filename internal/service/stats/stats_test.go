@@ -0,0 +1,152 @@
+package stats_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/stats"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/stats"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTeamName = "backend"
+	testUserID1  = "user-1"
+	testUserID2  = "user-2"
+	testPRID     = "pr-1"
+)
+
+var errStorage = errors.New("storage error")
+
+func newService(t *testing.T) (*stats.Service, *mock.StatsStorage) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewStatsStorage(ctrl)
+	service := stats.New(storage)
+	return service, storage
+}
+
+func TestGetReviewerStats(t *testing.T) {
+	t.Parallel()
+
+	teamName := testTeamName
+	from := time.Now().Add(-time.Hour)
+	filter := model.StatsFilter{
+		TeamName: &teamName,
+		From:     &from,
+	}
+
+	type args struct {
+		ctx    context.Context
+		filter model.StatsFilter
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(storage *mock.StatsStorage)
+		want    model.Stats
+		wantErr error
+	}{
+		{
+			name: "reviewer stats error",
+			args: args{
+				ctx:    context.Background(),
+				filter: filter,
+			},
+			mock: func(storage *mock.StatsStorage) {
+				storage.EXPECT().GetReviewerStats(gomock.Any(), filter).
+					Return(nil, errStorage)
+			},
+			want:    model.Stats{},
+			wantErr: errStorage,
+		},
+		{
+			name: "pull request stats error",
+			args: args{
+				ctx:    context.Background(),
+				filter: filter,
+			},
+			mock: func(storage *mock.StatsStorage) {
+				storage.EXPECT().GetReviewerStats(gomock.Any(), filter).
+					Return([]model.ReviewerStats{}, nil)
+				storage.EXPECT().GetPullRequestStats(gomock.Any(), filter).
+					Return(nil, errStorage)
+			},
+			want:    model.Stats{},
+			wantErr: errStorage,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx:    context.Background(),
+				filter: filter,
+			},
+			mock: func(storage *mock.StatsStorage) {
+				storage.EXPECT().GetReviewerStats(gomock.Any(), filter).
+					Return([]model.ReviewerStats{
+						{
+							UserID:         testUserID1,
+							TeamName:       testTeamName,
+							OpenCount:      1,
+							TotalCount:     1,
+							ReassignedAway: 1,
+						},
+						{
+							UserID:   testUserID2,
+							TeamName: testTeamName,
+						},
+					}, nil)
+				storage.EXPECT().GetPullRequestStats(gomock.Any(), filter).
+					Return([]model.PullRequestStats{
+						{
+							ID:             testPRID,
+							Status:         model.StatusOpen,
+							ReviewersCount: 1,
+						},
+					}, nil)
+			},
+			want: model.Stats{
+				Reviewers: []model.ReviewerStats{
+					{
+						UserID:         testUserID1,
+						TeamName:       testTeamName,
+						OpenCount:      1,
+						TotalCount:     1,
+						ReassignedAway: 1,
+					},
+					{
+						UserID:   testUserID2,
+						TeamName: testTeamName,
+					},
+				},
+				PullRequests: []model.PullRequestStats{
+					{
+						ID:             testPRID,
+						Status:         model.StatusOpen,
+						ReviewersCount: 1,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			got, err := service.GetReviewerStats(tt.args.ctx, tt.args.filter)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// AddMember puts the user into an existing team. A user coming from another
+// team hands their OPEN reviews over to the old teammates first.
+func (s *Service) AddMember(ctx context.Context, teamName string, user model.User) (model.Team, error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"team.AddMember",
+		tracing.TeamName.String(teamName),
+		tracing.UserID.String(user.ID),
+	)
+	defer span.End()
+
+	if err := model.AuthorizeTeam(ctx, teamName); err != nil {
+		return model.Team{}, err
+	}
+
+	member := withTeam(user, teamName)
+
+	var updatedTeam model.Team
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamStorage.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return errors.Wrap(err, "team storage getting team")
+		}
+
+		if hasMember(team, member.ID) {
+			return model.ErrUserAlreadyMember
+		}
+
+		changes, err := s.prepareJoin(ctx, teamName, []model.User{member})
+		if err != nil {
+			return errors.Wrap(err, "preparing member to join")
+		}
+
+		_, err = s.userStorage.SaveUsers(ctx, []model.User{member})
+		if err != nil {
+			return errors.Wrap(err, "user storage saving user")
+		}
+
+		err = s.teamStorage.InsertMembershipChanges(ctx, changes)
+		if err != nil {
+			return errors.Wrap(err, "team storage inserting membership changes")
+		}
+
+		_, err = s.reviewerAssigner.TopUpReviewers(ctx, teamName)
+		if err != nil {
+			return errors.Wrap(err, "topping up reviewers")
+		}
+
+		updatedTeam, err = s.teamStorage.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return errors.Wrap(err, "team storage getting updated team")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "adding team member")
+	}
+
+	return updatedTeam, nil
+}
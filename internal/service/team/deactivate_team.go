@@ -0,0 +1,52 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) DeactivateTeam(
+	ctx context.Context,
+	deactivation model.TeamDeactivation,
+) (model.DeactivatedTeam, error) {
+	ctx, span := tracing.Start(ctx, "team.DeactivateTeam")
+	defer span.End()
+
+	var deactivatedTeam model.DeactivatedTeam
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		users, err := s.userStorage.DeactivateUsers(ctx, deactivation.TeamName, deactivation.UserIDs)
+		if err != nil {
+			return errors.Wrap(err, "user storage deactivating users")
+		}
+
+		if len(users) == 0 {
+			if deactivation.TeamName != nil {
+				return model.ErrTeamDoesNotExist
+			}
+
+			return model.ErrUserDoesNotExist
+		}
+
+		userIDs := collection.Map(users, model.User.GetID)
+		replacements, err := s.reviewerAssigner.ReassignReviewers(ctx, userIDs, model.ReasonReviewerDeactivated)
+		if err != nil {
+			return errors.Wrap(err, "reassigning reviewers")
+		}
+
+		deactivatedTeam = model.DeactivatedTeam{
+			Users:        users,
+			Replacements: replacements,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.DeactivatedTeam{}, errors.Wrap(err, "deactivating team")
+	}
+
+	return deactivatedTeam, nil
+}
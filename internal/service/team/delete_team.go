@@ -0,0 +1,150 @@
+package team
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// DeleteTeam removes the team after handling its OPEN pull requests and
+// its users according to the requested policies.
+func (s *Service) DeleteTeam(ctx context.Context, deletion model.TeamDeletion) (model.DeletedTeam, error) {
+	ctx, span := tracing.Start(ctx, "team.DeleteTeam", tracing.TeamName.String(deletion.TeamName))
+	defer span.End()
+
+	deletedTeam := model.DeletedTeam{
+		DeletedPullRequestIDs: []string{},
+		Unassignments:         []model.ReviewerReplacement{},
+	}
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamStorage.GetTeamByName(ctx, deletion.TeamName)
+		if err != nil {
+			return errors.Wrap(err, "team storage getting team")
+		}
+
+		if deletion.UsersPolicy == model.TeamUsersPolicyMove {
+			_, err = s.teamStorage.GetTeamByName(ctx, *deletion.TargetTeamName)
+			if err != nil {
+				return errors.Wrap(err, "team storage getting target team")
+			}
+		}
+
+		memberIDs := collection.Map(team.Members, model.User.GetID)
+
+		// Merged pull requests and their history outlive the users,
+		// so users who took part in them are never deleted.
+		if deletion.UsersPolicy == model.TeamUsersPolicyDelete {
+			hasHistory, err := s.pullRequestRemover.HasMergedPullRequests(ctx, memberIDs)
+			if err != nil {
+				return errors.Wrap(err, "checking merged pull requests")
+			}
+			if hasHistory {
+				return model.ErrUsersHaveHistory
+			}
+		}
+
+		if deletion.PullRequestsPolicy == model.OpenPullRequestsPolicyDelete {
+			deletedIDs, err := s.pullRequestRemover.DeleteOpenPullRequestsByAuthors(ctx, memberIDs)
+			if err != nil {
+				return errors.Wrap(err, "deleting open pull requests")
+			}
+
+			deletedTeam.DeletedPullRequestIDs = deletedIDs
+		}
+
+		if deletion.PullRequestsPolicy != model.OpenPullRequestsPolicyKeep {
+			unassignments, err := s.reviewerAssigner.UnassignReviewers(ctx, memberIDs, model.ReasonTeamDeleted)
+			if err != nil {
+				return errors.Wrap(err, "unassigning reviewers")
+			}
+
+			deletedTeam.Unassignments = unassignments
+		}
+
+		users, err := s.releaseUsers(ctx, team, deletion)
+		if err != nil {
+			return errors.Wrap(err, "releasing users")
+		}
+
+		err = s.teamStorage.DeleteTeam(ctx, deletion.TeamName)
+		if err != nil {
+			return errors.Wrap(err, "team storage deleting team")
+		}
+
+		deletedTeam.Users = users
+
+		return nil
+	})
+	if err != nil {
+		return model.DeletedTeam{}, errors.Wrap(err, "deleting team")
+	}
+
+	return deletedTeam, nil
+}
+
+// releaseUsers detaches the members from the team being deleted
+// and returns them in their final state.
+func (s *Service) releaseUsers(
+	ctx context.Context,
+	team model.Team,
+	deletion model.TeamDeletion,
+) ([]model.User, error) {
+	var (
+		users       []model.User
+		newTeamName string
+		err         error
+	)
+
+	switch deletion.UsersPolicy {
+	case model.TeamUsersPolicyDeactivate:
+		_, err = s.userStorage.DeactivateUsers(ctx, &team.Name, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "user storage deactivating users")
+		}
+
+		users, err = s.userStorage.MoveUsers(ctx, team.Name, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "user storage detaching users")
+		}
+	case model.TeamUsersPolicyMove:
+		newTeamName = *deletion.TargetTeamName
+
+		users, err = s.userStorage.MoveUsers(ctx, team.Name, &newTeamName)
+		if err != nil {
+			return nil, errors.Wrap(err, "user storage moving users")
+		}
+	case model.TeamUsersPolicyDelete:
+		err = s.userStorage.DeleteUsers(ctx, collection.Map(team.Members, model.User.GetID))
+		if err != nil {
+			return nil, errors.Wrap(err, "user storage deleting users")
+		}
+
+		users = team.Members
+	default:
+		return nil, errors.Wrapf(model.ErrInvalidTeamUsersPolicy, "policy %q", deletion.UsersPolicy)
+	}
+
+	now := time.Now().UTC()
+	changes := collection.Map(users, func(user model.User) model.MembershipChange {
+		return newMembershipChange(ctx, user.ID, team.Name, newTeamName, now)
+	})
+
+	err = s.teamStorage.InsertMembershipChanges(ctx, changes)
+	if err != nil {
+		return nil, errors.Wrap(err, "team storage inserting membership changes")
+	}
+
+	if newTeamName != "" {
+		_, err = s.reviewerAssigner.TopUpReviewers(ctx, newTeamName)
+		if err != nil {
+			return nil, errors.Wrap(err, "topping up reviewers")
+		}
+	}
+
+	return users, nil
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 )
 
 func (s *Service) GetTeamByName(ctx context.Context, name string) (model.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.GetTeamByName", tracing.TeamName.String(name))
+	defer span.End()
+
 	return s.teamStorage.GetTeamByName(ctx, name)
 }
@@ -7,21 +7,52 @@ import (
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 )
 
-//go:generate mockgen -source=implementation.go -destination=../../mock/team/storage.go -package=mock -mock_names teamStorage=TeamStorage,userStorage=UserStorage
+//go:generate mockgen -source=implementation.go -destination=../../mock/team/storage.go -package=mock -mock_names teamStorage=TeamStorage,userStorage=UserStorage,reviewerAssigner=ReviewerAssigner,pullRequestRemover=PullRequestRemover
 type (
 	userStorage interface {
 		SaveUsers(ctx context.Context, users []model.User) ([]model.User, error)
+		GetUsersByIDs(ctx context.Context, ids []string) ([]model.User, error)
+		UpdateTeam(ctx context.Context, id string, teamName *string) (model.User, error)
+		MoveUsers(ctx context.Context, fromTeam string, toTeam *string) ([]model.User, error)
+		DeleteUsers(ctx context.Context, ids []string) error
+		DeactivateUsers(ctx context.Context, teamName *string, ids []string) ([]model.User, error)
 	}
 
 	teamStorage interface {
 		SaveTeam(ctx context.Context, team model.Team) (model.Team, error)
 		GetTeamByName(ctx context.Context, name string) (model.Team, error)
+		UpdateReviewerStrategy(ctx context.Context, name string, strategy model.ReviewerStrategy) error
+		SaveTeamSettings(ctx context.Context, name string, settings model.TeamSettings) error
+		RenameTeam(ctx context.Context, oldName string, newName string) error
+		InsertMembershipChanges(ctx context.Context, changes []model.MembershipChange) error
+		DeleteTeam(ctx context.Context, name string) error
+	}
+
+	reviewerAssigner interface {
+		ReassignReviewers(
+			ctx context.Context,
+			reviewerIDs []string,
+			reason string,
+		) ([]model.ReviewerReplacement, error)
+		UnassignReviewers(
+			ctx context.Context,
+			reviewerIDs []string,
+			reason string,
+		) ([]model.ReviewerReplacement, error)
+		TopUpReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error)
+	}
+
+	pullRequestRemover interface {
+		DeleteOpenPullRequestsByAuthors(ctx context.Context, authorIDs []string) ([]string, error)
+		HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error)
 	}
 )
 
 type Service struct {
-	userStorage userStorage
-	teamStorage teamStorage
+	userStorage        userStorage
+	teamStorage        teamStorage
+	reviewerAssigner   reviewerAssigner
+	pullRequestRemover pullRequestRemover
 
 	trManager trm.Manager
 }
@@ -29,11 +60,15 @@ type Service struct {
 func New(
 	userStorage userStorage,
 	teamStorage teamStorage,
+	reviewerAssigner reviewerAssigner,
+	pullRequestRemover pullRequestRemover,
 	trManager trm.Manager,
 ) *Service {
 	return &Service{
-		userStorage: userStorage,
-		teamStorage: teamStorage,
-		trManager:   trManager,
+		userStorage:        userStorage,
+		teamStorage:        teamStorage,
+		reviewerAssigner:   reviewerAssigner,
+		pullRequestRemover: pullRequestRemover,
+		trManager:          trManager,
 	}
 }
@@ -0,0 +1,84 @@
+package team
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// prepareJoin must run before the members are saved to the new team:
+// reviews of users leaving another team are handed over to their old
+// teammates while the users still belong to it. Taking a user out of
+// another team requires access to that team as well.
+func (s *Service) prepareJoin(
+	ctx context.Context,
+	teamName string,
+	members []model.User,
+) ([]model.MembershipChange, error) {
+	ids := collection.Map(members, model.User.GetID)
+	existing, err := s.userStorage.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "user storage getting users")
+	}
+
+	oldTeamNames := make(map[string]string, len(existing))
+	for _, user := range existing {
+		oldTeamNames[user.ID] = user.TeamName
+	}
+
+	now := time.Now().UTC()
+	changes := make([]model.MembershipChange, 0, len(members))
+	leaving := make([]string, 0, len(existing))
+	for _, member := range members {
+		oldTeamName := oldTeamNames[member.ID]
+		if oldTeamName == teamName {
+			continue
+		}
+
+		if oldTeamName != "" {
+			if err = model.AuthorizeTeam(ctx, oldTeamName); err != nil {
+				return nil, err
+			}
+
+			leaving = append(leaving, member.ID)
+		}
+
+		changes = append(changes, newMembershipChange(ctx, member.ID, oldTeamName, teamName, now))
+	}
+
+	if len(leaving) > 0 {
+		_, err = s.reviewerAssigner.ReassignReviewers(ctx, leaving, model.ReasonReviewerLeftTeam)
+		if err != nil {
+			return nil, errors.Wrap(err, "reassigning reviewers")
+		}
+	}
+
+	return changes, nil
+}
+
+func newMembershipChange(
+	ctx context.Context,
+	userID string,
+	oldTeamName string,
+	newTeamName string,
+	at time.Time,
+) model.MembershipChange {
+	return model.MembershipChange{
+		UserID:      userID,
+		OldTeamName: optionalTeamName(oldTeamName),
+		NewTeamName: optionalTeamName(newTeamName),
+		Actor:       model.ActorFromContext(ctx),
+		ChangedAt:   at,
+	}
+}
+
+func optionalTeamName(name string) *string {
+	if name == "" {
+		return nil
+	}
+
+	return &name
+}
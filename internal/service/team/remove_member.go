@@ -0,0 +1,99 @@
+package team
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// RemoveMember detaches the user from the team, leaving them without one.
+// Their OPEN reviews are reassigned to the remaining teammates, and those
+// without a candidate are unassigned and flagged as needing more reviewers.
+func (s *Service) RemoveMember(
+	ctx context.Context,
+	teamName string,
+	userID string,
+) (model.RemovedMember, error) {
+	ctx, span := tracing.Start(
+		ctx,
+		"team.RemoveMember",
+		tracing.TeamName.String(teamName),
+		tracing.UserID.String(userID),
+	)
+	defer span.End()
+
+	if err := model.AuthorizeTeam(ctx, teamName); err != nil {
+		return model.RemovedMember{}, err
+	}
+
+	var removedMember model.RemovedMember
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamStorage.GetTeamByName(ctx, teamName)
+		if err != nil {
+			return errors.Wrap(err, "team storage getting team")
+		}
+
+		if !hasMember(team, userID) {
+			return model.ErrUserNotMember
+		}
+
+		replacements, err := s.reviewerAssigner.ReassignReviewers(
+			ctx,
+			[]string{userID},
+			model.ReasonReviewerLeftTeam,
+		)
+		if err != nil {
+			return errors.Wrap(err, "reassigning reviewers")
+		}
+
+		// Nobody could replace a reviewer without a team later,
+		// so the reviews left without a candidate are unassigned.
+		if slices.ContainsFunc(replacements, hasNoReplacement) {
+			_, err = s.reviewerAssigner.UnassignReviewers(ctx, []string{userID}, model.ReasonReviewerLeftTeam)
+			if err != nil {
+				return errors.Wrap(err, "unassigning reviewers")
+			}
+		}
+
+		user, err := s.userStorage.UpdateTeam(ctx, userID, nil)
+		if err != nil {
+			return errors.Wrap(err, "user storage updating team")
+		}
+
+		change := newMembershipChange(ctx, userID, teamName, "", time.Now().UTC())
+		err = s.teamStorage.InsertMembershipChanges(ctx, []model.MembershipChange{change})
+		if err != nil {
+			return errors.Wrap(err, "team storage inserting membership changes")
+		}
+
+		removedMember = model.RemovedMember{
+			User:         user,
+			Replacements: replacements,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.RemovedMember{}, errors.Wrap(err, "removing team member")
+	}
+
+	return removedMember, nil
+}
+
+func hasMember(team model.Team, userID string) bool {
+	for _, member := range team.Members {
+		if member.ID == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasNoReplacement(replacement model.ReviewerReplacement) bool {
+	return replacement.NewReviewerID == nil
+}
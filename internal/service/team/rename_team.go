@@ -0,0 +1,36 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) RenameTeam(ctx context.Context, oldName string, newName string) (model.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.RenameTeam", tracing.TeamName.String(oldName))
+	defer span.End()
+
+	var renamedTeam model.Team
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		err := s.teamStorage.RenameTeam(ctx, oldName, newName)
+		if err != nil {
+			return errors.Wrap(err, "team storage renaming team")
+		}
+
+		team, err := s.teamStorage.GetTeamByName(ctx, newName)
+		if err != nil {
+			return errors.Wrap(err, "team storage getting team")
+		}
+
+		renamedTeam = team
+
+		return nil
+	})
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "renaming team")
+	}
+
+	return renamedTeam, nil
+}
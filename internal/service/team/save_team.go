@@ -4,11 +4,27 @@ import (
 	"context"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
 	"github.com/pkg/errors"
 )
 
+const (
+	defaultSeniority = 1
+)
+
 func (s *Service) SaveTeam(ctx context.Context, team model.Team) (model.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.SaveTeam", tracing.TeamName.String(team.Name))
+	defer span.End()
+
+	if err := model.AuthorizeTeam(ctx, team.Name); err != nil {
+		return model.Team{}, err
+	}
+
+	if team.ReviewerStrategy == "" {
+		team.ReviewerStrategy = model.ReviewerStrategyRandom
+	}
+
 	uniqueMembers := collection.Unique(team.Members, func(member model.User) string {
 		return member.ID
 	})
@@ -16,12 +32,7 @@ func (s *Service) SaveTeam(ctx context.Context, team model.Team) (model.Team, er
 	usersWithTeam := collection.Map(
 		uniqueMembers,
 		func(user model.User) model.User {
-			return model.User{
-				ID:       user.ID,
-				Name:     user.Name,
-				TeamName: team.Name,
-				IsActive: user.IsActive,
-			}
+			return withTeam(user, team.Name)
 		},
 	)
 
@@ -33,11 +44,26 @@ func (s *Service) SaveTeam(ctx context.Context, team model.Team) (model.Team, er
 			return errors.Wrap(err, "team storage saving team")
 		}
 
+		changes, err := s.prepareJoin(ctx, team.Name, usersWithTeam)
+		if err != nil {
+			return errors.Wrap(err, "preparing members to join")
+		}
+
 		txUsers, err := s.userStorage.SaveUsers(ctx, usersWithTeam)
 		if err != nil {
 			return errors.Wrap(err, "user storage saving users")
 		}
 
+		err = s.teamStorage.InsertMembershipChanges(ctx, changes)
+		if err != nil {
+			return errors.Wrap(err, "team storage inserting membership changes")
+		}
+
+		_, err = s.reviewerAssigner.TopUpReviewers(ctx, team.Name)
+		if err != nil {
+			return errors.Wrap(err, "topping up reviewers")
+		}
+
 		savedTeam = txTeam
 		savedUsers = txUsers
 
@@ -51,3 +77,18 @@ func (s *Service) SaveTeam(ctx context.Context, team model.Team) (model.Team, er
 
 	return savedTeam, nil
 }
+
+func withTeam(user model.User, teamName string) model.User {
+	seniority := user.Seniority
+	if seniority == 0 {
+		seniority = defaultSeniority
+	}
+
+	return model.User{
+		ID:        user.ID,
+		Name:      user.Name,
+		TeamName:  teamName,
+		IsActive:  user.IsActive,
+		Seniority: seniority,
+	}
+}
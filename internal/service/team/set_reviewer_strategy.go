@@ -0,0 +1,44 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) SetReviewerStrategy(
+	ctx context.Context,
+	name string,
+	strategy model.ReviewerStrategy,
+) (model.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.SetReviewerStrategy", tracing.TeamName.String(name))
+	defer span.End()
+
+	if err := model.AuthorizeTeam(ctx, name); err != nil {
+		return model.Team{}, err
+	}
+
+	var updatedTeam model.Team
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		err := s.teamStorage.UpdateReviewerStrategy(ctx, name, strategy)
+		if err != nil {
+			return errors.Wrap(err, "updating reviewer strategy")
+		}
+
+		team, err := s.teamStorage.GetTeamByName(ctx, name)
+		if err != nil {
+			return errors.Wrap(err, "getting team")
+		}
+
+		updatedTeam = team
+
+		return nil
+	})
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "setting reviewer strategy")
+	}
+
+	return updatedTeam, nil
+}
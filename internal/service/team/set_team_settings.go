@@ -0,0 +1,52 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+// SetTeamSettings applies to reviewer assignments made from now on,
+// reviewers already assigned to open pull requests are kept. Open pull
+// requests are re-flagged against the new minimum and topped up.
+func (s *Service) SetTeamSettings(
+	ctx context.Context,
+	name string,
+	settings model.TeamSettings,
+) (model.Team, error) {
+	ctx, span := tracing.Start(ctx, "team.SetTeamSettings", tracing.TeamName.String(name))
+	defer span.End()
+
+	if err := model.AuthorizeTeam(ctx, name); err != nil {
+		return model.Team{}, err
+	}
+
+	var updatedTeam model.Team
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		err := s.teamStorage.SaveTeamSettings(ctx, name, settings)
+		if err != nil {
+			return errors.Wrap(err, "saving team settings")
+		}
+
+		_, err = s.reviewerAssigner.TopUpReviewers(ctx, name)
+		if err != nil {
+			return errors.Wrap(err, "topping up reviewers")
+		}
+
+		team, err := s.teamStorage.GetTeamByName(ctx, name)
+		if err != nil {
+			return errors.Wrap(err, "getting team")
+		}
+
+		updatedTeam = team
+
+		return nil
+	})
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "setting team settings")
+	}
+
+	return updatedTeam, nil
+}
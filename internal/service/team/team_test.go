@@ -13,21 +13,31 @@ import (
 )
 
 const (
-	testTeamName  = "backend"
-	testUserID1   = "user-1"
-	testUserID2   = "user-2"
-	testUserName1 = "Alice"
-	testUserName2 = "Bob"
+	testTeamName      = "backend"
+	testOtherTeamName = "frontend"
+	testUserID1       = "user-1"
+	testUserID2       = "user-2"
+	testUserName1     = "Alice"
+	testUserName2     = "Bob"
+	testPRID          = "pr-1"
 )
 
-func newService(t *testing.T) (*team.Service, *mock.TeamStorage, *mock.UserStorage) {
+func newService(t *testing.T) (
+	*team.Service,
+	*mock.TeamStorage,
+	*mock.UserStorage,
+	*mock.ReviewerAssigner,
+	*mock.PullRequestRemover,
+) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	teamStorage := mock.NewTeamStorage(ctrl)
 	userStorage := mock.NewUserStorage(ctrl)
+	reviewerAssigner := mock.NewReviewerAssigner(ctrl)
+	pullRequestRemover := mock.NewPullRequestRemover(ctrl)
 	trManager := trmanager.NewMockTrManager()
-	service := team.New(userStorage, teamStorage, trManager)
-	return service, teamStorage, userStorage
+	service := team.New(userStorage, teamStorage, reviewerAssigner, pullRequestRemover, trManager)
+	return service, teamStorage, userStorage, reviewerAssigner, pullRequestRemover
 }
 
 func TestGetTeamByName(t *testing.T) {
@@ -109,7 +119,7 @@ func TestGetTeamByName(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			service, teamStorage, _ := newService(t)
+			service, teamStorage, _, _, _ := newService(t)
 			tt.mock(teamStorage)
 
 			got, err := service.GetTeamByName(tt.args.ctx, tt.args.name)
@@ -131,7 +141,7 @@ func TestSaveTeam(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		mock    func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage)
+		mock    func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner)
 		want    model.Team
 		wantErr error
 	}{
@@ -151,7 +161,7 @@ func TestSaveTeam(t *testing.T) {
 					},
 				},
 			},
-			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage) {
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, _ *mock.ReviewerAssigner) {
 				teamStorage.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).
 					Return(model.Team{}, model.ErrTeamAlreadyExists)
 			},
@@ -174,11 +184,13 @@ func TestSaveTeam(t *testing.T) {
 					},
 				},
 			},
-			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage) {
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, _ *mock.ReviewerAssigner) {
 				teamStorage.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).
 					Return(model.Team{
 						Name: testTeamName,
 					}, nil)
+				userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID1}).
+					Return(nil, nil)
 				userStorage.EXPECT().SaveUsers(gomock.Any(), gomock.Any()).
 					Return(nil, model.ErrUserDoesNotExist)
 			},
@@ -198,41 +210,246 @@ func TestSaveTeam(t *testing.T) {
 							TeamName: "",
 							IsActive: true,
 						},
+						{
+							ID:        testUserID2,
+							Name:      testUserName2,
+							TeamName:  "",
+							IsActive:  false,
+							Seniority: 3,
+						},
+					},
+				},
+			},
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, team model.Team) (model.Team, error) {
+						return model.Team{
+							Name:             team.Name,
+							ReviewerStrategy: team.ReviewerStrategy,
+						}, nil
+					})
+				userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID1, testUserID2}).
+					Return(nil, nil)
+				userStorage.EXPECT().SaveUsers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, users []model.User) ([]model.User, error) {
+						return users, nil
+					})
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
+			},
+			want: model.Team{
+				Name:             testTeamName,
+				ReviewerStrategy: model.ReviewerStrategyRandom,
+				Members: []model.User{
+					{
+						ID:        testUserID1,
+						Name:      testUserName1,
+						TeamName:  testTeamName,
+						IsActive:  true,
+						Seniority: 1,
+					},
+					{
+						ID:        testUserID2,
+						Name:      testUserName2,
+						TeamName:  testTeamName,
+						IsActive:  false,
+						Seniority: 3,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "member moved from another team",
+			args: args{
+				ctx: context.Background(),
+				team: model.Team{
+					Name: testTeamName,
+					Members: []model.User{
+						{
+							ID:       testUserID1,
+							Name:     testUserName1,
+							IsActive: true,
+						},
 						{
 							ID:       testUserID2,
 							Name:     testUserName2,
-							TeamName: "",
-							IsActive: false,
+							IsActive: true,
 						},
 					},
 				},
 			},
-			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage) {
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
 				teamStorage.EXPECT().SaveTeam(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, team model.Team) (model.Team, error) {
 						return model.Team{
-							Name: team.Name,
+							Name:             team.Name,
+							ReviewerStrategy: team.ReviewerStrategy,
 						}, nil
 					})
+				userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID1, testUserID2}).
+					Return([]model.User{
+						{
+							ID:       testUserID1,
+							Name:     testUserName1,
+							TeamName: testOtherTeamName,
+							IsActive: true,
+						},
+					}, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{}, nil)
 				userStorage.EXPECT().SaveUsers(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(_ context.Context, users []model.User) ([]model.User, error) {
 						return users, nil
 					})
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
 			},
 			want: model.Team{
-				Name: testTeamName,
+				Name:             testTeamName,
+				ReviewerStrategy: model.ReviewerStrategyRandom,
 				Members: []model.User{
+					{
+						ID:        testUserID1,
+						Name:      testUserName1,
+						TeamName:  testTeamName,
+						IsActive:  true,
+						Seniority: 1,
+					},
+					{
+						ID:        testUserID2,
+						Name:      testUserName2,
+						TeamName:  testTeamName,
+						IsActive:  true,
+						Seniority: 1,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, userStorage, assigner, _ := newService(t)
+			tt.mock(teamStorage, userStorage, assigner)
+
+			got, err := service.SaveTeam(tt.args.ctx, tt.args.team)
+
+			require.Equal(t, tt.want, got)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestDeactivateTeam(t *testing.T) {
+	t.Parallel()
+
+	teamName := testTeamName
+	newReviewerID := testUserID2
+
+	type args struct {
+		ctx          context.Context
+		deactivation model.TeamDeactivation
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner)
+		want    model.DeactivatedTeam
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			args: args{
+				ctx:          context.Background(),
+				deactivation: model.TeamDeactivation{TeamName: &teamName},
+			},
+			mock: func(userStorage *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				userStorage.EXPECT().DeactivateUsers(gomock.Any(), &teamName, nil).
+					Return([]model.User{}, nil)
+			},
+			want:    model.DeactivatedTeam{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "users not found",
+			args: args{
+				ctx:          context.Background(),
+				deactivation: model.TeamDeactivation{UserIDs: []string{testUserID1}},
+			},
+			mock: func(userStorage *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				userStorage.EXPECT().DeactivateUsers(gomock.Any(), nil, []string{testUserID1}).
+					Return([]model.User{}, nil)
+			},
+			want:    model.DeactivatedTeam{},
+			wantErr: model.ErrUserDoesNotExist,
+		},
+		{
+			name: "reassign error",
+			args: args{
+				ctx:          context.Background(),
+				deactivation: model.TeamDeactivation{UserIDs: []string{testUserID1}},
+			},
+			mock: func(userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				userStorage.EXPECT().DeactivateUsers(gomock.Any(), nil, []string{testUserID1}).
+					Return([]model.User{
+						{
+							ID:       testUserID1,
+							Name:     testUserName1,
+							TeamName: testTeamName,
+						},
+					}, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerDeactivated).
+					Return(nil, model.ErrUserDoesNotExist)
+			},
+			want:    model.DeactivatedTeam{},
+			wantErr: model.ErrUserDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx:          context.Background(),
+				deactivation: model.TeamDeactivation{UserIDs: []string{testUserID1}},
+			},
+			mock: func(userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				userStorage.EXPECT().DeactivateUsers(gomock.Any(), nil, []string{testUserID1}).
+					Return([]model.User{
+						{
+							ID:       testUserID1,
+							Name:     testUserName1,
+							TeamName: testTeamName,
+						},
+					}, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerDeactivated).
+					Return([]model.ReviewerReplacement{
+						{
+							PullRequestID: testPRID,
+							OldReviewerID: testUserID1,
+							NewReviewerID: &newReviewerID,
+						},
+					}, nil)
+			},
+			want: model.DeactivatedTeam{
+				Users: []model.User{
 					{
 						ID:       testUserID1,
 						Name:     testUserName1,
 						TeamName: testTeamName,
-						IsActive: true,
 					},
+				},
+				Replacements: []model.ReviewerReplacement{
 					{
-						ID:       testUserID2,
-						Name:     testUserName2,
-						TeamName: testTeamName,
-						IsActive: false,
+						PullRequestID: testPRID,
+						OldReviewerID: testUserID1,
+						NewReviewerID: &newReviewerID,
 					},
 				},
 			},
@@ -244,13 +461,738 @@ func TestSaveTeam(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			service, teamStorage, userStorage := newService(t)
-			tt.mock(teamStorage, userStorage)
+			service, _, userStorage, assigner, _ := newService(t)
+			tt.mock(userStorage, assigner)
 
-			got, err := service.SaveTeam(tt.args.ctx, tt.args.team)
+			got, err := service.DeactivateTeam(tt.args.ctx, tt.args.deactivation)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetReviewerStrategy(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx      context.Context
+		name     string
+		strategy model.ReviewerStrategy
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(teamStorage *mock.TeamStorage)
+		want    model.Team
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			args: args{
+				ctx:      context.Background(),
+				name:     testTeamName,
+				strategy: model.ReviewerStrategyLeastLoaded,
+			},
+			mock: func(teamStorage *mock.TeamStorage) {
+				teamStorage.EXPECT().UpdateReviewerStrategy(gomock.Any(), testTeamName, model.ReviewerStrategyLeastLoaded).
+					Return(model.ErrTeamDoesNotExist)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx:      context.Background(),
+				name:     testTeamName,
+				strategy: model.ReviewerStrategyLeastLoaded,
+			},
+			mock: func(teamStorage *mock.TeamStorage) {
+				teamStorage.EXPECT().UpdateReviewerStrategy(gomock.Any(), testTeamName, model.ReviewerStrategyLeastLoaded).
+					Return(nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{
+						Name:             testTeamName,
+						ReviewerStrategy: model.ReviewerStrategyLeastLoaded,
+					}, nil)
+			},
+			want: model.Team{
+				Name:             testTeamName,
+				ReviewerStrategy: model.ReviewerStrategyLeastLoaded,
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, _, _, _ := newService(t)
+			tt.mock(teamStorage)
+
+			got, err := service.SetReviewerStrategy(tt.args.ctx, tt.args.name, tt.args.strategy)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetTeamSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := model.TeamSettings{MinReviewers: 1, MaxReviewers: 3}
+
+	type args struct {
+		ctx      context.Context
+		name     string
+		settings model.TeamSettings
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(teamStorage *mock.TeamStorage, assigner *mock.ReviewerAssigner)
+		want    model.Team
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			args: args{
+				ctx:      context.Background(),
+				name:     testTeamName,
+				settings: settings,
+			},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().SaveTeamSettings(gomock.Any(), testTeamName, settings).
+					Return(model.ErrTeamDoesNotExist)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx:      context.Background(),
+				name:     testTeamName,
+				settings: settings,
+			},
+			mock: func(teamStorage *mock.TeamStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().SaveTeamSettings(gomock.Any(), testTeamName, settings).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{
+						Name:     testTeamName,
+						Settings: settings,
+					}, nil)
+			},
+			want: model.Team{
+				Name:     testTeamName,
+				Settings: settings,
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, _, assigner, _ := newService(t)
+			tt.mock(teamStorage, assigner)
+
+			got, err := service.SetTeamSettings(tt.args.ctx, tt.args.name, tt.args.settings)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	t.Parallel()
+
+	newMember := model.User{
+		ID:       testUserID2,
+		Name:     testUserName2,
+		IsActive: true,
+	}
+
+	teamBefore := model.Team{
+		Name: testTeamName,
+		Members: []model.User{
+			{ID: testUserID1, Name: testUserName1, TeamName: testTeamName, IsActive: true, Seniority: 1},
+		},
+	}
+	teamAfter := model.Team{
+		Name: testTeamName,
+		Members: []model.User{
+			{ID: testUserID1, Name: testUserName1, TeamName: testTeamName, IsActive: true, Seniority: 1},
+			{ID: testUserID2, Name: testUserName2, TeamName: testTeamName, IsActive: true, Seniority: 1},
+		},
+	}
 
+	tests := []struct {
+		name    string
+		user    model.User
+		mock    func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner)
+		want    model.Team
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			user: newMember,
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "already member",
+			user: model.User{ID: testUserID1, Name: testUserName1, IsActive: true},
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(teamBefore, nil)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrUserAlreadyMember,
+		},
+		{
+			name: "new user",
+			user: newMember,
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(teamBefore, nil)
+				userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID2}).
+					Return(nil, nil)
+				userStorage.EXPECT().SaveUsers(gomock.Any(), []model.User{
+					{ID: testUserID2, Name: testUserName2, TeamName: testTeamName, IsActive: true, Seniority: 1},
+				}).
+					DoAndReturn(func(_ context.Context, users []model.User) ([]model.User, error) {
+						return users, nil
+					})
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(teamAfter, nil)
+			},
+			want:    teamAfter,
+			wantErr: nil,
+		},
+		{
+			name: "user moved from another team",
+			user: newMember,
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(teamBefore, nil)
+				userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID2}).
+					Return([]model.User{
+						{ID: testUserID2, Name: testUserName2, TeamName: testOtherTeamName, IsActive: true},
+					}, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID2}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{}, nil)
+				userStorage.EXPECT().SaveUsers(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, users []model.User) ([]model.User, error) {
+						return users, nil
+					})
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(teamAfter, nil)
+			},
+			want:    teamAfter,
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, userStorage, assigner, _ := newService(t)
+			tt.mock(teamStorage, userStorage, assigner)
+
+			got, err := service.AddMember(context.Background(), testTeamName, tt.user)
+
+			require.ErrorIs(t, err, tt.wantErr)
 			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAddMember_TeamLeadOfAnotherTeam(t *testing.T) {
+	t.Parallel()
+
+	service, _, _, _, _ := newService(t)
+	leadTeam := testOtherTeamName
+	ctx := model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:       "lead",
+		Role:     model.RoleTeamLead,
+		TeamName: &leadTeam,
+	})
+
+	_, err := service.AddMember(ctx, testTeamName, model.User{ID: testUserID2, Name: testUserName2})
+	require.ErrorIs(t, err, model.ErrForbidden)
+}
+
+func TestAddMember_TeamLeadTakesMemberOfAnotherTeam(t *testing.T) {
+	t.Parallel()
+
+	service, teamStorage, userStorage, _, _ := newService(t)
+	leadTeam := testTeamName
+	ctx := model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:       "lead",
+		Role:     model.RoleTeamLead,
+		TeamName: &leadTeam,
+	})
+
+	teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+		Return(model.Team{Name: testTeamName}, nil)
+	userStorage.EXPECT().GetUsersByIDs(gomock.Any(), []string{testUserID2}).
+		Return([]model.User{
+			{ID: testUserID2, Name: testUserName2, TeamName: testOtherTeamName, IsActive: true},
+		}, nil)
+
+	_, err := service.AddMember(ctx, testTeamName, model.User{ID: testUserID2, Name: testUserName2, IsActive: true})
+	require.ErrorIs(t, err, model.ErrForbidden)
+}
+
+func TestRemoveMember(t *testing.T) {
+	t.Parallel()
+
+	newReviewerID := testUserID2
+	team := model.Team{
+		Name: testTeamName,
+		Members: []model.User{
+			{ID: testUserID1, Name: testUserName1, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID2, Name: testUserName2, TeamName: testTeamName, IsActive: true},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		userID  string
+		mock    func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner)
+		want    model.RemovedMember
+		wantErr error
+	}{
+		{
+			name:   "team not found",
+			userID: testUserID1,
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    model.RemovedMember{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name:   "not a member",
+			userID: "stranger",
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+			},
+			want:    model.RemovedMember{},
+			wantErr: model.ErrUserNotMember,
+		},
+		{
+			name:   "success",
+			userID: testUserID1,
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{
+						{
+							PullRequestID: testPRID,
+							OldReviewerID: testUserID1,
+							NewReviewerID: &newReviewerID,
+						},
+					}, nil)
+				userStorage.EXPECT().UpdateTeam(gomock.Any(), testUserID1, nil).
+					Return(model.User{ID: testUserID1, Name: testUserName1, IsActive: true}, nil)
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+			},
+			want: model.RemovedMember{
+				User: model.User{ID: testUserID1, Name: testUserName1, IsActive: true},
+				Replacements: []model.ReviewerReplacement{
+					{
+						PullRequestID: testPRID,
+						OldReviewerID: testUserID1,
+						NewReviewerID: &newReviewerID,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "no candidate - unassigned",
+			userID: testUserID1,
+			mock: func(teamStorage *mock.TeamStorage, userStorage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{
+						{
+							PullRequestID: testPRID,
+							OldReviewerID: testUserID1,
+						},
+					}, nil)
+				assigner.EXPECT().UnassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{
+						{
+							PullRequestID: testPRID,
+							OldReviewerID: testUserID1,
+						},
+					}, nil)
+				userStorage.EXPECT().UpdateTeam(gomock.Any(), testUserID1, nil).
+					Return(model.User{ID: testUserID1, Name: testUserName1, IsActive: true}, nil)
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(1)).
+					Return(nil)
+			},
+			want: model.RemovedMember{
+				User: model.User{ID: testUserID1, Name: testUserName1, IsActive: true},
+				Replacements: []model.ReviewerReplacement{
+					{
+						PullRequestID: testPRID,
+						OldReviewerID: testUserID1,
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name:   "unassign error",
+			userID: testUserID1,
+			mock: func(teamStorage *mock.TeamStorage, _ *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				assigner.EXPECT().ReassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return([]model.ReviewerReplacement{
+						{
+							PullRequestID: testPRID,
+							OldReviewerID: testUserID1,
+						},
+					}, nil)
+				assigner.EXPECT().UnassignReviewers(gomock.Any(), []string{testUserID1}, model.ReasonReviewerLeftTeam).
+					Return(nil, model.ErrPullRequestConflict)
+			},
+			want:    model.RemovedMember{},
+			wantErr: model.ErrPullRequestConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, userStorage, assigner, _ := newService(t)
+			tt.mock(teamStorage, userStorage, assigner)
+
+			got, err := service.RemoveMember(context.Background(), testTeamName, tt.userID)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRenameTeam(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		mock    func(teamStorage *mock.TeamStorage)
+		want    model.Team
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			mock: func(teamStorage *mock.TeamStorage) {
+				teamStorage.EXPECT().RenameTeam(gomock.Any(), testTeamName, testOtherTeamName).
+					Return(model.ErrTeamDoesNotExist)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "new name taken",
+			mock: func(teamStorage *mock.TeamStorage) {
+				teamStorage.EXPECT().RenameTeam(gomock.Any(), testTeamName, testOtherTeamName).
+					Return(model.ErrTeamAlreadyExists)
+			},
+			want:    model.Team{},
+			wantErr: model.ErrTeamAlreadyExists,
+		},
+		{
+			name: "success",
+			mock: func(teamStorage *mock.TeamStorage) {
+				teamStorage.EXPECT().RenameTeam(gomock.Any(), testTeamName, testOtherTeamName).
+					Return(nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testOtherTeamName).
+					Return(model.Team{
+						Name: testOtherTeamName,
+						Members: []model.User{
+							{ID: testUserID1, Name: testUserName1, TeamName: testOtherTeamName, IsActive: true},
+						},
+					}, nil)
+			},
+			want: model.Team{
+				Name: testOtherTeamName,
+				Members: []model.User{
+					{ID: testUserID1, Name: testUserName1, TeamName: testOtherTeamName, IsActive: true},
+				},
+			},
+			wantErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, _, _, _ := newService(t)
+			tt.mock(teamStorage)
+
+			got, err := service.RenameTeam(context.Background(), testTeamName, testOtherTeamName)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteTeam(t *testing.T) {
+	t.Parallel()
+
+	targetTeamName := testOtherTeamName
+	team := model.Team{
+		Name: testTeamName,
+		Members: []model.User{
+			{ID: testUserID1, Name: testUserName1, TeamName: testTeamName, IsActive: true},
+			{ID: testUserID2, Name: testUserName2, TeamName: testTeamName, IsActive: true},
+		},
+	}
+	memberIDs := []string{testUserID1, testUserID2}
+	unassignments := []model.ReviewerReplacement{
+		{PullRequestID: testPRID, OldReviewerID: testUserID1},
+	}
+
+	tests := []struct {
+		name     string
+		deletion model.TeamDeletion
+		mock     func(
+			teamStorage *mock.TeamStorage,
+			userStorage *mock.UserStorage,
+			assigner *mock.ReviewerAssigner,
+			remover *mock.PullRequestRemover,
+		)
+		want    model.DeletedTeam
+		wantErr error
+	}{
+		{
+			name: "team not found",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyDeactivate,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyKeep,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				_ *mock.UserStorage,
+				_ *mock.ReviewerAssigner,
+				_ *mock.PullRequestRemover,
+			) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    model.DeletedTeam{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "target team not found",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyMove,
+				TargetTeamName:     &targetTeamName,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyKeep,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				_ *mock.UserStorage,
+				_ *mock.ReviewerAssigner,
+				_ *mock.PullRequestRemover,
+			) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testOtherTeamName).
+					Return(model.Team{}, model.ErrTeamDoesNotExist)
+			},
+			want:    model.DeletedTeam{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "deactivate users and keep pull requests",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyDeactivate,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyKeep,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				userStorage *mock.UserStorage,
+				_ *mock.ReviewerAssigner,
+				_ *mock.PullRequestRemover,
+			) {
+				teamName := testTeamName
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				userStorage.EXPECT().DeactivateUsers(gomock.Any(), &teamName, nil).
+					Return(team.Members, nil)
+				userStorage.EXPECT().MoveUsers(gomock.Any(), testTeamName, nil).
+					Return([]model.User{
+						{ID: testUserID1, Name: testUserName1},
+						{ID: testUserID2, Name: testUserName2},
+					}, nil)
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				teamStorage.EXPECT().DeleteTeam(gomock.Any(), testTeamName).
+					Return(nil)
+			},
+			want: model.DeletedTeam{
+				Users: []model.User{
+					{ID: testUserID1, Name: testUserName1},
+					{ID: testUserID2, Name: testUserName2},
+				},
+				DeletedPullRequestIDs: []string{},
+				Unassignments:         []model.ReviewerReplacement{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "move users and unassign reviews",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyMove,
+				TargetTeamName:     &targetTeamName,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyUnassign,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				userStorage *mock.UserStorage,
+				assigner *mock.ReviewerAssigner,
+				_ *mock.PullRequestRemover,
+			) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testOtherTeamName).
+					Return(model.Team{Name: testOtherTeamName}, nil)
+				assigner.EXPECT().UnassignReviewers(gomock.Any(), memberIDs, model.ReasonTeamDeleted).
+					Return(unassignments, nil)
+				userStorage.EXPECT().MoveUsers(gomock.Any(), testTeamName, &targetTeamName).
+					Return([]model.User{
+						{ID: testUserID1, Name: testUserName1, TeamName: testOtherTeamName, IsActive: true},
+						{ID: testUserID2, Name: testUserName2, TeamName: testOtherTeamName, IsActive: true},
+					}, nil)
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testOtherTeamName).
+					Return([]model.PullRequest{}, nil)
+				teamStorage.EXPECT().DeleteTeam(gomock.Any(), testTeamName).
+					Return(nil)
+			},
+			want: model.DeletedTeam{
+				Users: []model.User{
+					{ID: testUserID1, Name: testUserName1, TeamName: testOtherTeamName, IsActive: true},
+					{ID: testUserID2, Name: testUserName2, TeamName: testOtherTeamName, IsActive: true},
+				},
+				DeletedPullRequestIDs: []string{},
+				Unassignments:         unassignments,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "delete users and pull requests",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyDelete,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyDelete,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				userStorage *mock.UserStorage,
+				assigner *mock.ReviewerAssigner,
+				remover *mock.PullRequestRemover,
+			) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				remover.EXPECT().HasMergedPullRequests(gomock.Any(), memberIDs).
+					Return(false, nil)
+				remover.EXPECT().DeleteOpenPullRequestsByAuthors(gomock.Any(), memberIDs).
+					Return([]string{testPRID}, nil)
+				assigner.EXPECT().UnassignReviewers(gomock.Any(), memberIDs, model.ReasonTeamDeleted).
+					Return([]model.ReviewerReplacement{}, nil)
+				userStorage.EXPECT().DeleteUsers(gomock.Any(), memberIDs).
+					Return(nil)
+				teamStorage.EXPECT().InsertMembershipChanges(gomock.Any(), gomock.Len(2)).
+					Return(nil)
+				teamStorage.EXPECT().DeleteTeam(gomock.Any(), testTeamName).
+					Return(nil)
+			},
+			want: model.DeletedTeam{
+				Users:                 team.Members,
+				DeletedPullRequestIDs: []string{testPRID},
+				Unassignments:         []model.ReviewerReplacement{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "delete users with merged history",
+			deletion: model.TeamDeletion{
+				TeamName:           testTeamName,
+				UsersPolicy:        model.TeamUsersPolicyDelete,
+				PullRequestsPolicy: model.OpenPullRequestsPolicyDelete,
+			},
+			mock: func(
+				teamStorage *mock.TeamStorage,
+				_ *mock.UserStorage,
+				_ *mock.ReviewerAssigner,
+				remover *mock.PullRequestRemover,
+			) {
+				teamStorage.EXPECT().GetTeamByName(gomock.Any(), testTeamName).
+					Return(team, nil)
+				remover.EXPECT().HasMergedPullRequests(gomock.Any(), memberIDs).
+					Return(true, nil)
+			},
+			want:    model.DeletedTeam{},
+			wantErr: model.ErrUsersHaveHistory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, teamStorage, userStorage, assigner, remover := newService(t)
+			tt.mock(teamStorage, userStorage, assigner, remover)
+
+			got, err := service.DeleteTeam(context.Background(), tt.deletion)
+
 			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
 		})
 	}
 }
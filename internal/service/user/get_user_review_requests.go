@@ -4,8 +4,16 @@ import (
 	"context"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
 )
 
 func (s *Service) GetUserReviewRequests(ctx context.Context, id string) ([]model.PullRequest, error) {
+	ctx, span := tracing.Start(ctx, "user.GetUserReviewRequests", tracing.UserID.String(id))
+	defer span.End()
+
+	if err := model.AuthorizeUser(ctx, id); err != nil {
+		return nil, err
+	}
+
 	return s.pullRequestStorage.GetPullRequestsByReviewer(ctx, id)
 }
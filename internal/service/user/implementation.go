@@ -3,31 +3,44 @@ package user
 import (
 	"context"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 )
 
-//go:generate mockgen -source=implementation.go -destination=../../mock/user/storage.go -package=mock -mock_names userStorage=UserStorage,pullRequestStorage=PullRequestStorage
+//go:generate mockgen -source=implementation.go -destination=../../mock/user/storage.go -package=mock -mock_names userStorage=UserStorage,pullRequestStorage=PullRequestStorage,reviewerAssigner=ReviewerAssigner
 type (
 	userStorage interface {
 		UpdateActivity(ctx context.Context, id string, activity bool) (model.User, error)
+		UpdateSeniority(ctx context.Context, id string, seniority int) (model.User, error)
 	}
 
 	pullRequestStorage interface {
 		GetPullRequestsByReviewer(ctx context.Context, id string) ([]model.PullRequest, error)
 	}
+
+	reviewerAssigner interface {
+		TopUpReviewers(ctx context.Context, teamName string) ([]model.PullRequest, error)
+	}
 )
 
 type Service struct {
 	userStorage        userStorage
 	pullRequestStorage pullRequestStorage
+	reviewerAssigner   reviewerAssigner
+
+	trManager trm.Manager
 }
 
 func New(
 	userStorage userStorage,
 	pullRequestStorage pullRequestStorage,
+	reviewerAssigner reviewerAssigner,
+	trManager trm.Manager,
 ) *Service {
 	return &Service{
 		userStorage:        userStorage,
 		pullRequestStorage: pullRequestStorage,
+		reviewerAssigner:   reviewerAssigner,
+		trManager:          trManager,
 	}
 }
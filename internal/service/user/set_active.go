@@ -4,8 +4,44 @@ import (
 	"context"
 
 	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
 )
 
 func (s *Service) SetActive(ctx context.Context, id string, active bool) (model.User, error) {
-	return s.userStorage.UpdateActivity(ctx, id, active)
+	ctx, span := tracing.Start(ctx, "user.SetActive", tracing.UserID.String(id))
+	defer span.End()
+
+	var updatedUser model.User
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		user, err := s.userStorage.UpdateActivity(ctx, id, active)
+		if err != nil {
+			return errors.Wrap(err, "updating activity")
+		}
+
+		updatedUser = user
+
+		// A user without a team has no teammates' pull requests to top up.
+		if user.TeamName == "" {
+			return nil
+		}
+
+		if err = model.AuthorizeTeam(ctx, user.TeamName); err != nil {
+			return err
+		}
+
+		if active {
+			_, err = s.reviewerAssigner.TopUpReviewers(ctx, user.TeamName)
+			if err != nil {
+				return errors.Wrap(err, "topping up reviewers")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "setting activity")
+	}
+
+	return updatedUser, nil
 }
@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) SetSeniority(ctx context.Context, id string, seniority int) (model.User, error) {
+	ctx, span := tracing.Start(ctx, "user.SetSeniority", tracing.UserID.String(id))
+	defer span.End()
+
+	var updatedUser model.User
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		user, err := s.userStorage.UpdateSeniority(ctx, id, seniority)
+		if err != nil {
+			return errors.Wrap(err, "updating seniority")
+		}
+
+		if err = model.AuthorizeTeam(ctx, user.TeamName); err != nil {
+			return err
+		}
+
+		updatedUser = user
+
+		return nil
+	})
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "setting seniority")
+	}
+
+	return updatedUser, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	trmanager "github.com/hizu77/avito-autumn-2025/internal/mock/tr_manager"
 	mock "github.com/hizu77/avito-autumn-2025/internal/mock/user"
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/hizu77/avito-autumn-2025/internal/service/user"
@@ -23,13 +24,15 @@ const (
 
 var mockTime = time.Now()
 
-func newService(t *testing.T) (*user.Service, *mock.UserStorage, *mock.PullRequestStorage) {
+func newService(t *testing.T) (*user.Service, *mock.UserStorage, *mock.PullRequestStorage, *mock.ReviewerAssigner) {
 	t.Helper()
 	ctrl := gomock.NewController(t)
 	userStorage := mock.NewUserStorage(ctrl)
 	pullRequestStorage := mock.NewPullRequestStorage(ctrl)
-	service := user.New(userStorage, pullRequestStorage)
-	return service, userStorage, pullRequestStorage
+	reviewerAssigner := mock.NewReviewerAssigner(ctrl)
+	trManager := trmanager.NewMockTrManager()
+	service := user.New(userStorage, pullRequestStorage, reviewerAssigner, trManager)
+	return service, userStorage, pullRequestStorage, reviewerAssigner
 }
 
 func TestSetActive(t *testing.T) {
@@ -44,7 +47,7 @@ func TestSetActive(t *testing.T) {
 	tests := []struct {
 		name    string
 		args    args
-		mock    func(storage *mock.UserStorage)
+		mock    func(storage *mock.UserStorage, assigner *mock.ReviewerAssigner)
 		want    model.User
 		wantErr error
 	}{
@@ -55,7 +58,7 @@ func TestSetActive(t *testing.T) {
 				id:     testUserID,
 				active: true,
 			},
-			mock: func(storage *mock.UserStorage) {
+			mock: func(storage *mock.UserStorage, _ *mock.ReviewerAssigner) {
 				storage.EXPECT().UpdateActivity(gomock.Any(), testUserID, true).
 					Return(model.User{}, model.ErrUserDoesNotExist)
 			},
@@ -69,7 +72,7 @@ func TestSetActive(t *testing.T) {
 				id:     testUserID,
 				active: true,
 			},
-			mock: func(storage *mock.UserStorage) {
+			mock: func(storage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
 				storage.EXPECT().UpdateActivity(gomock.Any(), testUserID, true).
 					Return(model.User{
 						ID:       testUserID,
@@ -77,6 +80,8 @@ func TestSetActive(t *testing.T) {
 						TeamName: testTeamName,
 						IsActive: true,
 					}, nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return([]model.PullRequest{}, nil)
 			},
 			want: model.User{
 				ID:       testUserID,
@@ -86,6 +91,49 @@ func TestSetActive(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "top up error",
+			args: args{
+				ctx:    context.Background(),
+				id:     testUserID,
+				active: true,
+			},
+			mock: func(storage *mock.UserStorage, assigner *mock.ReviewerAssigner) {
+				storage.EXPECT().UpdateActivity(gomock.Any(), testUserID, true).
+					Return(model.User{
+						ID:       testUserID,
+						Name:     testUserName,
+						TeamName: testTeamName,
+						IsActive: true,
+					}, nil)
+				assigner.EXPECT().TopUpReviewers(gomock.Any(), testTeamName).
+					Return(nil, model.ErrTeamDoesNotExist)
+			},
+			want:    model.User{},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+		{
+			name: "success - reactivate user without team",
+			args: args{
+				ctx:    context.Background(),
+				id:     testUserID,
+				active: true,
+			},
+			mock: func(storage *mock.UserStorage, _ *mock.ReviewerAssigner) {
+				storage.EXPECT().UpdateActivity(gomock.Any(), testUserID, true).
+					Return(model.User{
+						ID:       testUserID,
+						Name:     testUserName,
+						IsActive: true,
+					}, nil)
+			},
+			want: model.User{
+				ID:       testUserID,
+				Name:     testUserName,
+				IsActive: true,
+			},
+			wantErr: nil,
+		},
 		{
 			name: "success - set inactive",
 			args: args{
@@ -93,7 +141,7 @@ func TestSetActive(t *testing.T) {
 				id:     testUserID,
 				active: false,
 			},
-			mock: func(storage *mock.UserStorage) {
+			mock: func(storage *mock.UserStorage, _ *mock.ReviewerAssigner) {
 				storage.EXPECT().UpdateActivity(gomock.Any(), testUserID, false).
 					Return(model.User{
 						ID:       testUserID,
@@ -116,8 +164,8 @@ func TestSetActive(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			service, userStorage, _ := newService(t)
-			tt.mock(userStorage)
+			service, userStorage, _, assigner := newService(t)
+			tt.mock(userStorage, assigner)
 
 			got, err := service.SetActive(tt.args.ctx, tt.args.id, tt.args.active)
 
@@ -206,13 +254,36 @@ func TestGetUserReviewRequests(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "member reads own reviews",
+			args: args{
+				ctx: memberContext(testUserID),
+				id:  testUserID,
+			},
+			mock: func(storage *mock.PullRequestStorage) {
+				storage.EXPECT().GetPullRequestsByReviewer(gomock.Any(), testUserID).
+					Return([]model.PullRequest{}, nil)
+			},
+			want:    []model.PullRequest{},
+			wantErr: nil,
+		},
+		{
+			name: "member reads reviews of another user",
+			args: args{
+				ctx: memberContext("user-2"),
+				id:  testUserID,
+			},
+			mock:    func(storage *mock.PullRequestStorage) {},
+			want:    nil,
+			wantErr: model.ErrForbidden,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			service, _, pullRequestStorage := newService(t)
+			service, _, pullRequestStorage, _ := newService(t)
 			tt.mock(pullRequestStorage)
 
 			got, err := service.GetUserReviewRequests(tt.args.ctx, tt.args.id)
@@ -222,3 +293,106 @@ func TestGetUserReviewRequests(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSeniority(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		ctx       context.Context
+		id        string
+		seniority int
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		mock    func(storage *mock.UserStorage)
+		want    model.User
+		wantErr error
+	}{
+		{
+			name: "user not found",
+			args: args{
+				ctx:       context.Background(),
+				id:        testUserID,
+				seniority: 3,
+			},
+			mock: func(storage *mock.UserStorage) {
+				storage.EXPECT().UpdateSeniority(gomock.Any(), testUserID, 3).
+					Return(model.User{}, model.ErrUserDoesNotExist)
+			},
+			want:    model.User{},
+			wantErr: model.ErrUserDoesNotExist,
+		},
+		{
+			name: "success",
+			args: args{
+				ctx:       context.Background(),
+				id:        testUserID,
+				seniority: 3,
+			},
+			mock: func(storage *mock.UserStorage) {
+				storage.EXPECT().UpdateSeniority(gomock.Any(), testUserID, 3).
+					Return(model.User{
+						ID:        testUserID,
+						Name:      testUserName,
+						TeamName:  testTeamName,
+						IsActive:  true,
+						Seniority: 3,
+					}, nil)
+			},
+			want: model.User{
+				ID:        testUserID,
+				Name:      testUserName,
+				TeamName:  testTeamName,
+				IsActive:  true,
+				Seniority: 3,
+			},
+			wantErr: nil,
+		},
+		{
+			name: "team lead of another team",
+			args: args{
+				ctx:       teamLeadContext("frontend"),
+				id:        testUserID,
+				seniority: 3,
+			},
+			mock: func(storage *mock.UserStorage) {
+				storage.EXPECT().UpdateSeniority(gomock.Any(), testUserID, 3).
+					Return(model.User{ID: testUserID, TeamName: testTeamName}, nil)
+			},
+			want:    model.User{},
+			wantErr: model.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, userStorage, _, _ := newService(t)
+			tt.mock(userStorage)
+
+			got, err := service.SetSeniority(tt.args.ctx, tt.args.id, tt.args.seniority)
+
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func teamLeadContext(teamName string) context.Context {
+	return model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:       "lead",
+		Role:     model.RoleTeamLead,
+		TeamName: &teamName,
+	})
+}
+
+func memberContext(userID string) context.Context {
+	return model.ContextWithPrincipal(context.Background(), model.Principal{
+		ID:     "member",
+		Role:   model.RoleMember,
+		UserID: &userID,
+	})
+}
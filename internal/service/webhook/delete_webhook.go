@@ -0,0 +1,16 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+func (s *Service) DeleteWebhook(ctx context.Context, id int64) error {
+	err := s.storage.DeleteWebhook(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "storage deleting webhook")
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	HeaderSignature = "X-Webhook-Signature-256"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+
+	signaturePrefix = "sha256="
+	maxErrorLength  = 512
+)
+
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	LeaseTimeout time.Duration
+}
+
+// Dispatcher delivers outbox messages to webhook URLs.
+// Messages are claimed for LeaseTimeout in a short transaction and sent
+// outside of it, so a slow receiver does not hold database locks.
+// Failed deliveries are retried with exponential backoff
+// until MaxAttempts is reached.
+type Dispatcher struct {
+	storage   storage
+	client    *http.Client
+	trManager trm.Manager
+	cfg       DispatcherConfig
+	logger    *zap.Logger
+
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewDispatcher(
+	storage storage,
+	client *http.Client,
+	trManager trm.Manager,
+	cfg DispatcherConfig,
+	logger *zap.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		storage:   storage,
+		client:    client,
+		trManager: trManager,
+		cfg:       cfg,
+		logger:    logger,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the polling loop in the background until ctx is done or Stop is called.
+func (d *Dispatcher) Start(ctx context.Context) {
+	go d.run(ctx)
+}
+
+// Stop signals the polling loop to exit and waits for the current batch to finish.
+func (d *Dispatcher) Stop() {
+	d.stopOnce.Do(func() {
+		close(d.stop)
+	})
+
+	<-d.done
+}
+
+func (d *Dispatcher) run(ctx context.Context) {
+	defer close(d.done)
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			if _, err := d.DispatchPending(ctx); err != nil {
+				d.logger.Error("dispatching webhooks", zap.Error(err))
+			}
+		}
+	}
+}
+
+// DispatchPending delivers one batch of due messages
+// and returns how many of them were delivered.
+func (d *Dispatcher) DispatchPending(ctx context.Context) (int, error) {
+	var deliveries []model.WebhookDelivery
+	err := d.trManager.Do(ctx, func(ctx context.Context) error {
+		now := time.Now().UTC()
+
+		var err error
+		deliveries, err = d.storage.ClaimPendingDeliveries(ctx, now, now.Add(d.cfg.LeaseTimeout), d.cfg.BatchSize)
+		if err != nil {
+			return errors.Wrap(err, "storage claiming pending deliveries")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "claiming pending deliveries")
+	}
+
+	var delivered int
+	for _, delivery := range deliveries {
+		sendErr := d.send(ctx, delivery)
+		delivery = d.nextState(delivery, sendErr, time.Now().UTC())
+
+		err = d.trManager.Do(ctx, func(ctx context.Context) error {
+			return d.storage.UpdateDelivery(ctx, delivery)
+		})
+		if err != nil {
+			d.logger.Error("updating webhook delivery",
+				zap.Int64("delivery_id", delivery.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if sendErr == nil {
+			delivered++
+			continue
+		}
+
+		d.logger.Warn("webhook delivery failed",
+			zap.Int64("delivery_id", delivery.ID),
+			zap.Int64("webhook_id", delivery.WebhookID),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Bool("gave_up", delivery.FailedAt != nil),
+			zap.Error(sendErr),
+		)
+	}
+
+	return delivered, nil
+}
+
+func (d *Dispatcher) nextState(delivery model.WebhookDelivery, sendErr error, now time.Time) model.WebhookDelivery {
+	delivery.Attempts++
+
+	if sendErr == nil {
+		delivery.DeliveredAt = &now
+		delivery.LastError = nil
+
+		return delivery
+	}
+
+	lastError := sendErr.Error()
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+	delivery.LastError = &lastError
+
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.FailedAt = &now
+
+		return delivery
+	}
+
+	delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+
+	return delivery
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.cfg.RetryBackoff
+	for i := 1; i < attempts && backoff < d.cfg.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, d.cfg.MaxBackoff)
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery model.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return errors.Wrap(err, "building request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, delivery.EventType.String())
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, delivery.Payload))
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "sending request")
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("unexpected status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the value of the signature header for the body:
+// "sha256=" followed by the hex-encoded HMAC-SHA256 with the webhook secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,70 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// EnqueueAssignmentEvents writes a delivery for every subscribed webhook
+// into the outbox. It must run in the transaction that records the events,
+// so that deliveries exist if and only if the change is committed.
+func (s *Service) EnqueueAssignmentEvents(ctx context.Context, events []model.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	pullRequestIDs := collection.Unique(
+		collection.Map(events, model.AssignmentEvent.GetPullRequestID),
+		func(id string) string {
+			return id
+		},
+	)
+
+	subscriptions, err := s.storage.GetWebhookSubscriptions(ctx, pullRequestIDs)
+	if err != nil {
+		return errors.Wrap(err, "storage getting webhook subscriptions")
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	subscriptionsByPullRequest := make(map[string][]model.WebhookSubscription, len(pullRequestIDs))
+	for _, subscription := range subscriptions {
+		subscriptionsByPullRequest[subscription.PullRequestID] = append(
+			subscriptionsByPullRequest[subscription.PullRequestID],
+			subscription,
+		)
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]model.WebhookDelivery, 0, len(subscriptions))
+	for _, event := range events {
+		for _, subscription := range subscriptionsByPullRequest[event.PullRequestID] {
+			body, err := json.Marshal(newPayload(event, subscription.TeamName))
+			if err != nil {
+				return errors.Wrap(err, "marshalling payload")
+			}
+
+			deliveries = append(deliveries, model.WebhookDelivery{
+				WebhookID:     subscription.Webhook.ID,
+				EventType:     event.Type,
+				Payload:       body,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	err = s.storage.InsertDeliveries(ctx, deliveries)
+	if err != nil {
+		return errors.Wrap(err, "storage inserting deliveries")
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+//go:generate mockgen -source=implementation.go -destination=../../mock/webhook/storage.go -package=mock -mock_names storage=WebhookStorage
+type storage interface {
+	InsertWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]model.Webhook, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	GetWebhookSubscriptions(ctx context.Context, pullRequestIDs []string) ([]model.WebhookSubscription, error)
+	InsertDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	ClaimPendingDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error
+}
+
+type Service struct {
+	storage storage
+}
+
+func New(storage storage) *Service {
+	return &Service{
+		storage: storage,
+	}
+}
@@ -0,0 +1,17 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Service) ListWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	webhooks, err := s.storage.GetWebhooks(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "storage getting webhooks")
+	}
+
+	return webhooks, nil
+}
@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+type payload struct {
+	EventType     string    `json:"event_type"`
+	PullRequestID string    `json:"pull_request_id"`
+	TeamName      string    `json:"team_name"`
+	Actor         string    `json:"actor"`
+	OldReviewerID *string   `json:"old_reviewer_id"`
+	NewReviewerID *string   `json:"new_reviewer_id"`
+	Reason        string    `json:"reason"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+func newPayload(event model.AssignmentEvent, teamName string) payload {
+	return payload{
+		EventType:     event.Type.String(),
+		PullRequestID: event.PullRequestID,
+		TeamName:      teamName,
+		Actor:         event.Actor,
+		OldReviewerID: event.OldReviewerID,
+		NewReviewerID: event.NewReviewerID,
+		Reason:        event.Reason,
+		OccurredAt:    event.CreatedAt,
+	}
+}
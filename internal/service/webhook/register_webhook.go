@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	secretBytes = 32
+)
+
+// RegisterWebhook stores a new subscription.
+// A secret is generated when the caller does not provide one.
+func (s *Service) RegisterWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	if webhook.Secret == "" {
+		secret, err := generateSecret()
+		if err != nil {
+			return model.Webhook{}, errors.Wrap(err, "generating secret")
+		}
+
+		webhook.Secret = secret
+	}
+
+	webhook.CreatedAt = time.Now().UTC()
+
+	savedWebhook, err := s.storage.InsertWebhook(ctx, webhook)
+	if err != nil {
+		return model.Webhook{}, errors.Wrap(err, "storage inserting webhook")
+	}
+
+	return savedWebhook, nil
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, "reading random bytes")
+	}
+
+	return hex.EncodeToString(secret), nil
+}
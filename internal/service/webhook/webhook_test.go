@@ -0,0 +1,371 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	trmanager "github.com/hizu77/avito-autumn-2025/internal/mock/tr_manager"
+	mock "github.com/hizu77/avito-autumn-2025/internal/mock/webhook"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/service/webhook"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const (
+	testPRID1     = "pr-1"
+	testPRID2     = "pr-2"
+	testTeamName  = "backend"
+	testSecret    = "secret"
+	testReviewer1 = "reviewer-1"
+)
+
+var errStorage = errors.New("storage error")
+
+func newService(t *testing.T) (*webhook.Service, *mock.WebhookStorage) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewWebhookStorage(ctrl)
+	service := webhook.New(storage)
+	return service, storage
+}
+
+func newDispatcher(t *testing.T, maxAttempts int) (*webhook.Dispatcher, *mock.WebhookStorage) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	storage := mock.NewWebhookStorage(ctrl)
+	dispatcher := webhook.NewDispatcher(
+		storage,
+		http.DefaultClient,
+		trmanager.NewMockTrManager(),
+		webhook.DispatcherConfig{
+			PollInterval: time.Second,
+			BatchSize:    10,
+			MaxAttempts:  maxAttempts,
+			RetryBackoff: time.Second,
+			MaxBackoff:   time.Minute,
+			LeaseTimeout: time.Minute,
+		},
+		zap.NewNop(),
+	)
+	return dispatcher, storage
+}
+
+func TestRegisterWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		webhook    model.Webhook
+		mock       func(storage *mock.WebhookStorage)
+		wantSecret func(t *testing.T, secret string)
+		wantErr    error
+	}{
+		{
+			name:    "keeps provided secret",
+			webhook: model.Webhook{URL: "http://example.com", Secret: testSecret},
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					InsertWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w model.Webhook) (model.Webhook, error) {
+						w.ID = 1
+						return w, nil
+					})
+			},
+			wantSecret: func(t *testing.T, secret string) {
+				require.Equal(t, testSecret, secret)
+			},
+		},
+		{
+			name:    "generates secret",
+			webhook: model.Webhook{URL: "http://example.com"},
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					InsertWebhook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, w model.Webhook) (model.Webhook, error) {
+						w.ID = 1
+						return w, nil
+					})
+			},
+			wantSecret: func(t *testing.T, secret string) {
+				require.Len(t, secret, 64)
+			},
+		},
+		{
+			name:    "team not found",
+			webhook: model.Webhook{URL: "http://example.com", TeamName: ptr(testTeamName)},
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					InsertWebhook(gomock.Any(), gomock.Any()).
+					Return(model.Webhook{}, model.ErrTeamDoesNotExist)
+			},
+			wantErr: model.ErrTeamDoesNotExist,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			got, err := service.RegisterWebhook(context.Background(), tt.webhook)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, int64(1), got.ID)
+			require.False(t, got.CreatedAt.IsZero())
+			tt.wantSecret(t, got.Secret)
+		})
+	}
+}
+
+func TestEnqueueAssignmentEvents(t *testing.T) {
+	t.Parallel()
+
+	events := []model.AssignmentEvent{
+		{
+			PullRequestID: testPRID1,
+			Type:          model.AssignmentEventTypeAssigned,
+			Actor:         "admin",
+			NewReviewerID: ptr(testReviewer1),
+		},
+		{
+			PullRequestID: testPRID2,
+			Type:          model.AssignmentEventTypeMerged,
+			Actor:         "admin",
+		},
+	}
+
+	tests := []struct {
+		name    string
+		events  []model.AssignmentEvent
+		mock    func(storage *mock.WebhookStorage)
+		wantErr error
+	}{
+		{
+			name:   "no events",
+			events: nil,
+			mock:   func(storage *mock.WebhookStorage) {},
+		},
+		{
+			name:   "no subscriptions",
+			events: events,
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					GetWebhookSubscriptions(gomock.Any(), []string{testPRID1, testPRID2}).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:   "fan out to subscribed webhooks",
+			events: events,
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					GetWebhookSubscriptions(gomock.Any(), []string{testPRID1, testPRID2}).
+					Return([]model.WebhookSubscription{
+						{PullRequestID: testPRID1, TeamName: testTeamName, Webhook: model.Webhook{ID: 1}},
+						{PullRequestID: testPRID1, TeamName: testTeamName, Webhook: model.Webhook{ID: 2}},
+						{PullRequestID: testPRID2, TeamName: testTeamName, Webhook: model.Webhook{ID: 1}},
+					}, nil)
+				storage.EXPECT().
+					InsertDeliveries(gomock.Any(), gomock.Len(3)).
+					DoAndReturn(func(_ context.Context, deliveries []model.WebhookDelivery) error {
+						var body map[string]any
+						if err := json.Unmarshal(deliveries[0].Payload, &body); err != nil {
+							return err
+						}
+						if body["pull_request_id"] != testPRID1 || body["new_reviewer_id"] != testReviewer1 {
+							return errors.New("unexpected payload")
+						}
+						if deliveries[2].EventType != model.AssignmentEventTypeMerged {
+							return errors.New("unexpected event type")
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:   "storage error",
+			events: events,
+			mock: func(storage *mock.WebhookStorage) {
+				storage.EXPECT().
+					GetWebhookSubscriptions(gomock.Any(), gomock.Any()).
+					Return(nil, errStorage)
+			},
+			wantErr: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newService(t)
+			tt.mock(storage)
+
+			err := service.EnqueueAssignmentEvents(context.Background(), tt.events)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDispatchPending(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"event_type":"ASSIGNED"}`)
+
+	tests := []struct {
+		name          string
+		status        int
+		attempts      int
+		maxAttempts   int
+		wantDelivered int
+		check         func(t *testing.T, delivery model.WebhookDelivery)
+	}{
+		{
+			name:          "delivered",
+			status:        http.StatusOK,
+			maxAttempts:   3,
+			wantDelivered: 1,
+			check: func(t *testing.T, delivery model.WebhookDelivery) {
+				require.Equal(t, 1, delivery.Attempts)
+				require.NotNil(t, delivery.DeliveredAt)
+				require.Nil(t, delivery.FailedAt)
+				require.Nil(t, delivery.LastError)
+			},
+		},
+		{
+			name:        "scheduled for retry",
+			status:      http.StatusInternalServerError,
+			maxAttempts: 3,
+			check: func(t *testing.T, delivery model.WebhookDelivery) {
+				require.Equal(t, 1, delivery.Attempts)
+				require.Nil(t, delivery.DeliveredAt)
+				require.Nil(t, delivery.FailedAt)
+				require.NotNil(t, delivery.LastError)
+				require.True(t, delivery.NextAttemptAt.After(time.Now()))
+			},
+		},
+		{
+			name:        "gives up after max attempts",
+			status:      http.StatusInternalServerError,
+			attempts:    2,
+			maxAttempts: 3,
+			check: func(t *testing.T, delivery model.WebhookDelivery) {
+				require.Equal(t, 3, delivery.Attempts)
+				require.Nil(t, delivery.DeliveredAt)
+				require.NotNil(t, delivery.FailedAt)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Header.Get(webhook.HeaderSignature) != webhook.Sign(testSecret, body) ||
+					r.Header.Get(webhook.HeaderEvent) != model.AssignmentEventTypeAssigned.String() {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(tt.status)
+			}))
+			t.Cleanup(server.Close)
+
+			dispatcher, storage := newDispatcher(t, tt.maxAttempts)
+
+			var updated model.WebhookDelivery
+			storage.EXPECT().
+				ClaimPendingDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+				DoAndReturn(func(_ context.Context, now, leaseUntil time.Time, _ int) ([]model.WebhookDelivery, error) {
+					require.Equal(t, now.Add(time.Minute), leaseUntil)
+					return []model.WebhookDelivery{
+						{
+							ID:        1,
+							WebhookID: 1,
+							URL:       server.URL,
+							Secret:    testSecret,
+							EventType: model.AssignmentEventTypeAssigned,
+							Payload:   payload,
+							Attempts:  tt.attempts,
+						},
+					}, nil
+				})
+			storage.EXPECT().
+				UpdateDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, delivery model.WebhookDelivery) error {
+					updated = delivery
+					return nil
+				})
+
+			delivered, err := dispatcher.DispatchPending(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.wantDelivered, delivered)
+			tt.check(t, updated)
+		})
+	}
+}
+
+func TestDispatchPendingUpdateFailed(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	dispatcher, storage := newDispatcher(t, 3)
+
+	storage.EXPECT().
+		ClaimPendingDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return([]model.WebhookDelivery{
+			{ID: 1, WebhookID: 1, URL: server.URL, Secret: testSecret, EventType: model.AssignmentEventTypeAssigned},
+			{ID: 2, WebhookID: 1, URL: server.URL, Secret: testSecret, EventType: model.AssignmentEventTypeAssigned},
+		}, nil)
+	storage.EXPECT().
+		UpdateDelivery(gomock.Any(), gomock.Any()).
+		Return(errStorage)
+	storage.EXPECT().
+		UpdateDelivery(gomock.Any(), gomock.Any()).
+		Return(nil)
+
+	delivered, err := dispatcher.DispatchPending(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+}
+
+func TestDispatchPendingClaimFailed(t *testing.T) {
+	t.Parallel()
+
+	dispatcher, storage := newDispatcher(t, 3)
+
+	storage.EXPECT().
+		ClaimPendingDeliveries(gomock.Any(), gomock.Any(), gomock.Any(), 10).
+		Return(nil, errStorage)
+
+	_, err := dispatcher.DispatchPending(context.Background())
+	require.ErrorIs(t, err, errStorage)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -1,6 +1,9 @@
 package dbmodel
 
 type Admin struct {
-	ID           string `db:"id"`
-	PasswordHash string `db:"password"`
+	ID           string  `db:"id"`
+	PasswordHash string  `db:"password"`
+	Role         string  `db:"role"`
+	TeamName     *string `db:"team_name"`
+	UserID       *string `db:"user_id"`
 }
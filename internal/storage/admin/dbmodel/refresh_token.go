@@ -0,0 +1,11 @@
+package dbmodel
+
+import "time"
+
+type RefreshToken struct {
+	TokenHash string     `db:"token_hash"`
+	AdminID   string     `db:"admin_id"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
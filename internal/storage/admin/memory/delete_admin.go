@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// DeleteAdmin takes the refresh tokens of the admin with it
+// and keeps the api keys it created.
+func (s *Storage) DeleteAdmin(ctx context.Context, id string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Admins[id]; !ok {
+			return model.ErrAdminDoesNotExist
+		}
+
+		delete(state.Admins, id)
+
+		for hash, token := range state.RefreshTokens {
+			if token.AdminID == id {
+				delete(state.RefreshTokens, hash)
+			}
+		}
+
+		for keyID, apiKey := range state.APIKeys {
+			if apiKey.CreatedBy != nil && *apiKey.CreatedBy == id {
+				apiKey.CreatedBy = nil
+				state.APIKeys[keyID] = apiKey
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminDoesNotExist) {
+		return model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "deleting admin")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAdmin(ctx context.Context, id string) (model.Admin, error) {
+	var fetched model.Admin
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		admin, ok := state.Admins[id]
+		if !ok {
+			return model.ErrAdminDoesNotExist
+		}
+
+		fetched = admin.Admin
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminDoesNotExist) {
+		return model.Admin{}, model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return model.Admin{}, errors.Wrap(err, "reading admin")
+	}
+
+	return fetched, nil
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertAdmin(ctx context.Context, admin model.Admin) (model.Admin, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Admins[admin.ID]; ok {
+			return model.ErrAdminAlreadyExists
+		}
+		if admin.TeamName != nil {
+			if _, ok := state.Teams[*admin.TeamName]; !ok {
+				return model.ErrTeamDoesNotExist
+			}
+		}
+		if admin.UserID != nil {
+			if _, ok := state.Users[*admin.UserID]; !ok {
+				return model.ErrUserDoesNotExist
+			}
+		}
+
+		state.Admins[admin.ID] = memory.Admin{Admin: admin}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminAlreadyExists) ||
+		errors.Is(err, model.ErrTeamDoesNotExist) ||
+		errors.Is(err, model.ErrUserDoesNotExist) {
+		return model.Admin{}, err
+	}
+	if err != nil {
+		return model.Admin{}, errors.Wrap(err, "inserting admin")
+	}
+
+	return admin, nil
+}
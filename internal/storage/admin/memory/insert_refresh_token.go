@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Admins[token.AdminID]; !ok {
+			return model.ErrAdminDoesNotExist
+		}
+		if _, ok := state.RefreshTokens[token.TokenHash]; ok {
+			return errors.New("refresh token already exists")
+		}
+
+		state.RefreshTokens[token.TokenHash] = token
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminDoesNotExist) {
+		return model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "inserting refresh token")
+	}
+
+	return nil
+}
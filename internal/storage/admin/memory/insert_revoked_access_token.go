@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// InsertRevokedAccessToken adds the token to the denylist until it expires.
+// Entries of already expired tokens are useless and are cleaned up on the way.
+func (s *Storage) InsertRevokedAccessToken(ctx context.Context, token model.AccessToken) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		now := time.Now()
+		for jti, expiresAt := range state.RevokedAccessTokens {
+			if expiresAt.Before(now) {
+				delete(state.RevokedAccessTokens, jti)
+			}
+		}
+
+		if _, ok := state.RevokedAccessTokens[token.ID]; !ok {
+			state.RevokedAccessTokens[token.ID] = token.ExpiresAt
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "inserting revoked token")
+	}
+
+	return nil
+}
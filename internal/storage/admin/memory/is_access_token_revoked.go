@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// IsAccessTokenRevoked reports whether the token was denylisted on logout,
+// or was issued before its account changed the password or was deleted.
+func (s *Storage) IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error) {
+	var revoked bool
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		if _, ok := state.RevokedAccessTokens[token.ID]; ok {
+			revoked = true
+			return nil
+		}
+
+		admin, ok := state.Admins[token.AdminID]
+		revoked = !ok || admin.PasswordChangedAt != nil && admin.PasswordChangedAt.After(token.IssuedAt)
+
+		return nil
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "reading revoked tokens")
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) RevokeAdminRefreshTokens(ctx context.Context, adminID string, revokedAt time.Time) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for hash, token := range state.RefreshTokens {
+			if token.AdminID == adminID && token.RevokedAt == nil {
+				token.RevokedAt = &revokedAt
+				state.RefreshTokens[hash] = token
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "revoking refresh tokens")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// RevokeRefreshToken marks an active token as used, so every refresh token
+// can be exchanged only once, even by concurrent requests.
+func (s *Storage) RevokeRefreshToken(
+	ctx context.Context,
+	tokenHash string,
+	revokedAt time.Time,
+) (model.RefreshToken, error) {
+	var revoked model.RefreshToken
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		token, ok := state.RefreshTokens[tokenHash]
+		if !ok || token.RevokedAt != nil || !token.ExpiresAt.After(revokedAt) {
+			return model.ErrInvalidRefreshToken
+		}
+
+		token.RevokedAt = &revokedAt
+		state.RefreshTokens[tokenHash] = token
+		revoked = token
+
+		return nil
+	})
+	if errors.Is(err, model.ErrInvalidRefreshToken) {
+		return model.RefreshToken{}, model.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return model.RefreshToken{}, errors.Wrap(err, "revoking refresh token")
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdatePassword(
+	ctx context.Context,
+	id string,
+	passwordHash string,
+	changedAt time.Time,
+) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		admin, ok := state.Admins[id]
+		if !ok {
+			return model.ErrAdminDoesNotExist
+		}
+
+		admin.PasswordHash = passwordHash
+		admin.PasswordChangedAt = &changedAt
+		state.Admins[id] = admin
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminDoesNotExist) {
+		return model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "updating password")
+	}
+
+	return nil
+}
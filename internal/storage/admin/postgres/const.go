@@ -3,11 +3,42 @@ package admin
 const (
 	adminTableName = "admins"
 
-	columnID       = "id"
-	columnPassword = "password"
+	columnID                = "id"
+	columnPassword          = "password"
+	columnRole              = "role"
+	columnTeamName          = "team_name"
+	columnUserID            = "user_id"
+	columnPasswordChangedAt = "password_changed_at"
+
+	teamNameForeignKey = "admins_team_name_fkey"
+	userIDForeignKey   = "admins_user_id_fkey"
+
+	refreshTokenTableName = "admin_refresh_tokens"
+
+	refreshTokenColumnTokenHash = "token_hash"
+	refreshTokenColumnAdminID   = "admin_id"
+	refreshTokenColumnExpiresAt = "expires_at"
+	refreshTokenColumnRevokedAt = "revoked_at"
+	refreshTokenColumnCreatedAt = "created_at"
+
+	revokedAccessTokenTableName = "revoked_access_tokens"
+
+	revokedAccessTokenColumnJTI       = "jti"
+	revokedAccessTokenColumnExpiresAt = "expires_at"
 )
 
 var allColumns = []string{
 	columnID,
 	columnPassword,
+	columnRole,
+	columnTeamName,
+	columnUserID,
+}
+
+var refreshTokenColumns = []string{
+	refreshTokenColumnTokenHash,
+	refreshTokenColumnAdminID,
+	refreshTokenColumnExpiresAt,
+	refreshTokenColumnRevokedAt,
+	refreshTokenColumnCreatedAt,
 }
@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteAdmin(ctx context.Context, id string) error {
+	sql, args, err := squirrel.
+		Delete(adminTableName).
+		Where(squirrel.Eq{columnID: id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrAdminDoesNotExist
+	}
+
+	return nil
+}
@@ -35,7 +35,10 @@ func (s *Storage) GetAdmin(ctx context.Context, id string) (model.Admin, error)
 		return model.Admin{}, errors.Wrap(err, "collecting rows")
 	}
 
-	mappedAdmin := mapDBAdminToDomainAdmin(dbAdmin)
+	mappedAdmin, err := mapDBAdminToDomainAdmin(dbAdmin)
+	if err != nil {
+		return model.Admin{}, errors.Wrap(err, "mapping admin")
+	}
 
 	return mappedAdmin, nil
 }
@@ -12,8 +12,8 @@ import (
 func (s *Storage) InsertAdmin(ctx context.Context, admin model.Admin) (model.Admin, error) {
 	sql, args, err := squirrel.
 		Insert(adminTableName).
-		Columns(columnID, columnPassword).
-		Values(admin.ID, admin.PasswordHash).
+		Columns(allColumns...).
+		Values(admin.ID, admin.PasswordHash, admin.Role.String(), admin.TeamName, admin.UserID).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -24,6 +24,12 @@ func (s *Storage) InsertAdmin(ctx context.Context, admin model.Admin) (model.Adm
 	if constraint.IsUniqueViolation(err) {
 		return model.Admin{}, model.ErrAdminAlreadyExists
 	}
+	if constraint.IsForeignKeyViolationOf(err, teamNameForeignKey) {
+		return model.Admin{}, model.ErrTeamDoesNotExist
+	}
+	if constraint.IsForeignKeyViolationOf(err, userIDForeignKey) {
+		return model.Admin{}, model.ErrUserDoesNotExist
+	}
 	if err != nil {
 		return model.Admin{}, errors.Wrap(err, "collecting rows")
 	}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertRefreshToken(ctx context.Context, token model.RefreshToken) error {
+	sql, args, err := squirrel.
+		Insert(refreshTokenTableName).
+		Columns(refreshTokenColumns...).
+		Values(token.TokenHash, token.AdminID, token.ExpiresAt, token.RevokedAt, token.CreatedAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if constraint.IsForeignKeyViolation(err) {
+		return model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// InsertRevokedAccessToken adds the token to the denylist until it expires.
+// Entries of already expired tokens are useless and are cleaned up on the way.
+func (s *Storage) InsertRevokedAccessToken(ctx context.Context, token model.AccessToken) error {
+	deleteSQL, deleteArgs, err := squirrel.
+		Delete(revokedAccessTokenTableName).
+		Where(revokedAccessTokenColumnExpiresAt + " < now()").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building delete sql")
+	}
+
+	insertSQL, insertArgs, err := squirrel.
+		Insert(revokedAccessTokenTableName).
+		Columns(revokedAccessTokenColumnJTI, revokedAccessTokenColumnExpiresAt).
+		Values(token.ID, token.ExpiresAt).
+		Suffix("ON CONFLICT (" + revokedAccessTokenColumnJTI + ") DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building insert sql")
+	}
+
+	conn := s.getter.DefaultTrOrDB(ctx, s.pool)
+	if _, err = conn.Exec(ctx, deleteSQL, deleteArgs...); err != nil {
+		return errors.Wrap(err, "deleting expired tokens")
+	}
+
+	if _, err = conn.Exec(ctx, insertSQL, insertArgs...); err != nil {
+		return errors.Wrap(err, "inserting revoked token")
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// IsAccessTokenRevoked reports whether the token was denylisted on logout,
+// or was issued before its account changed the password or was deleted.
+func (s *Storage) IsAccessTokenRevoked(ctx context.Context, token model.AccessToken) (bool, error) {
+	denylisted := squirrel.
+		Select("1").
+		From(revokedAccessTokenTableName).
+		Where(squirrel.Eq{revokedAccessTokenColumnJTI: token.ID})
+
+	valid := squirrel.
+		Select("1").
+		From(adminTableName).
+		Where(squirrel.Eq{columnID: token.AdminID}).
+		Where(squirrel.Or{
+			squirrel.Eq{columnPasswordChangedAt: nil},
+			squirrel.LtOrEq{columnPasswordChangedAt: token.IssuedAt},
+		})
+
+	sql, args, err := squirrel.
+		Select().
+		Column(squirrel.Expr("EXISTS (?) OR NOT EXISTS (?)", denylisted, valid)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "building sql")
+	}
+
+	var revoked bool
+	err = s.getter.DefaultTrOrDB(ctx, s.pool).QueryRow(ctx, sql, args...).Scan(&revoked)
+	if err != nil {
+		return false, errors.Wrap(err, "scanning row")
+	}
+
+	return revoked, nil
+}
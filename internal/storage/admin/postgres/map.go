@@ -3,11 +3,30 @@ package admin
 import (
 	"github.com/hizu77/avito-autumn-2025/internal/model"
 	"github.com/hizu77/avito-autumn-2025/internal/storage/admin/dbmodel"
+	"github.com/pkg/errors"
 )
 
-func mapDBAdminToDomainAdmin(admin dbmodel.Admin) model.Admin {
+func mapDBAdminToDomainAdmin(admin dbmodel.Admin) (model.Admin, error) {
+	role, err := model.ParseRole(admin.Role)
+	if err != nil {
+		return model.Admin{}, errors.Wrap(err, "parsing role")
+	}
+
 	return model.Admin{
 		ID:           admin.ID,
 		PasswordHash: admin.PasswordHash,
+		Role:         role,
+		TeamName:     admin.TeamName,
+		UserID:       admin.UserID,
+	}, nil
+}
+
+func mapDBRefreshTokenToDomainRefreshToken(token dbmodel.RefreshToken) model.RefreshToken {
+	return model.RefreshToken{
+		TokenHash: token.TokenHash,
+		AdminID:   token.AdminID,
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: token.RevokedAt,
+		CreatedAt: token.CreatedAt,
 	}
 }
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) RevokeAdminRefreshTokens(ctx context.Context, adminID string, revokedAt time.Time) error {
+	sql, args, err := squirrel.
+		Update(refreshTokenTableName).
+		Set(refreshTokenColumnRevokedAt, revokedAt).
+		Where(squirrel.Eq{
+			refreshTokenColumnAdminID:   adminID,
+			refreshTokenColumnRevokedAt: nil,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	if _, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...); err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	db "database/sql"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/admin/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// RevokeRefreshToken marks an active token as used, so every refresh token
+// can be exchanged only once, even by concurrent requests.
+func (s *Storage) RevokeRefreshToken(
+	ctx context.Context,
+	tokenHash string,
+	revokedAt time.Time,
+) (model.RefreshToken, error) {
+	sql, args, err := squirrel.
+		Update(refreshTokenTableName).
+		Set(refreshTokenColumnRevokedAt, revokedAt).
+		Where(squirrel.Eq{
+			refreshTokenColumnTokenHash: tokenHash,
+			refreshTokenColumnRevokedAt: nil,
+		}).
+		Where(squirrel.Gt{refreshTokenColumnExpiresAt: revokedAt}).
+		Suffix("RETURNING " + strings.Join(refreshTokenColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.RefreshToken{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.RefreshToken{}, errors.Wrap(err, "querying sql")
+	}
+
+	token, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.RefreshToken])
+	if errors.Is(err, db.ErrNoRows) {
+		return model.RefreshToken{}, model.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return model.RefreshToken{}, errors.Wrap(err, "collecting row")
+	}
+
+	return mapDBRefreshTokenToDomainRefreshToken(token), nil
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdatePassword(
+	ctx context.Context,
+	id string,
+	passwordHash string,
+	changedAt time.Time,
+) error {
+	sql, args, err := squirrel.
+		Update(adminTableName).
+		Set(columnPassword, passwordHash).
+		Set(columnPasswordChangedAt, changedAt).
+		Where(squirrel.Eq{columnID: id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrAdminDoesNotExist
+	}
+
+	return nil
+}
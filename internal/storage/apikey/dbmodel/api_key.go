@@ -0,0 +1,13 @@
+package dbmodel
+
+import "time"
+
+type APIKey struct {
+	ID        string     `db:"id"`
+	Name      string     `db:"name"`
+	KeyHash   string     `db:"key_hash"`
+	Scopes    []string   `db:"scopes"`
+	CreatedBy *string    `db:"created_by"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
@@ -0,0 +1,32 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	var fetched model.APIKey
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		apiKey, ok := state.APIKeys[id]
+		if !ok {
+			return model.ErrAPIKeyDoesNotExist
+		}
+
+		fetched = copyAPIKey(apiKey)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAPIKeyDoesNotExist) {
+		return model.APIKey{}, model.ErrAPIKeyDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "reading api key")
+	}
+
+	return fetched, nil
+}
@@ -0,0 +1,32 @@
+package apikey
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	fetched := make([]model.APIKey, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, apiKey := range state.APIKeys {
+			fetched = append(fetched, copyAPIKey(apiKey))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading api keys")
+	}
+
+	slices.SortFunc(fetched, func(a, b model.APIKey) int {
+		return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	return fetched, nil
+}
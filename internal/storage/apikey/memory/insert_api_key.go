@@ -0,0 +1,34 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if apiKey.CreatedBy != nil {
+			if _, ok := state.Admins[*apiKey.CreatedBy]; !ok {
+				return model.ErrAdminDoesNotExist
+			}
+		}
+		if _, ok := state.APIKeys[apiKey.ID]; ok {
+			return errors.Errorf("api key %q already exists", apiKey.ID)
+		}
+
+		state.APIKeys[apiKey.ID] = copyAPIKey(apiKey)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAdminDoesNotExist) {
+		return model.APIKey{}, model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "inserting api key")
+	}
+
+	return apiKey, nil
+}
@@ -0,0 +1,12 @@
+package apikey
+
+import (
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+func copyAPIKey(apiKey model.APIKey) model.APIKey {
+	apiKey.Scopes = slices.Clone(apiKey.Scopes)
+	return apiKey
+}
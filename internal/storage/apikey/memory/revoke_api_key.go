@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// RevokeAPIKey keeps the revocation time of an already revoked key.
+func (s *Storage) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) (model.APIKey, error) {
+	var revoked model.APIKey
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		apiKey, ok := state.APIKeys[id]
+		if !ok {
+			return model.ErrAPIKeyDoesNotExist
+		}
+
+		if apiKey.RevokedAt == nil {
+			apiKey.RevokedAt = &revokedAt
+			state.APIKeys[id] = apiKey
+		}
+		revoked = copyAPIKey(apiKey)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrAPIKeyDoesNotExist) {
+		return model.APIKey{}, model.ErrAPIKeyDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "revoking api key")
+	}
+
+	return revoked, nil
+}
@@ -0,0 +1,23 @@
+package apikey
+
+const (
+	apiKeyTableName = "api_keys"
+
+	apiKeyColumnID        = "id"
+	apiKeyColumnName      = "name"
+	apiKeyColumnKeyHash   = "key_hash"
+	apiKeyColumnScopes    = "scopes"
+	apiKeyColumnCreatedBy = "created_by"
+	apiKeyColumnCreatedAt = "created_at"
+	apiKeyColumnRevokedAt = "revoked_at"
+)
+
+var apiKeyColumns = []string{
+	apiKeyColumnID,
+	apiKeyColumnName,
+	apiKeyColumnKeyHash,
+	apiKeyColumnScopes,
+	apiKeyColumnCreatedBy,
+	apiKeyColumnCreatedAt,
+	apiKeyColumnRevokedAt,
+}
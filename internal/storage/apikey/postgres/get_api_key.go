@@ -0,0 +1,44 @@
+package apikey
+
+import (
+	"context"
+	db "database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/apikey/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAPIKey(ctx context.Context, id string) (model.APIKey, error) {
+	sql, args, err := squirrel.
+		Select(apiKeyColumns...).
+		From(apiKeyTableName).
+		Where(squirrel.Eq{apiKeyColumnID: id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.APIKey])
+	if errors.Is(err, db.ErrNoRows) {
+		return model.APIKey{}, model.ErrAPIKeyDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "collecting row")
+	}
+
+	mappedAPIKey, err := mapDBAPIKeyToDomainAPIKey(fetched)
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "mapping api key")
+	}
+
+	return mappedAPIKey, nil
+}
@@ -0,0 +1,41 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/apikey/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAPIKeys(ctx context.Context) ([]model.APIKey, error) {
+	sql, args, err := squirrel.
+		Select(apiKeyColumns...).
+		From(apiKeyTableName).
+		OrderBy(apiKeyColumnCreatedAt, apiKeyColumnID).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.APIKey])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedAPIKeys, err := collection.MapWithError(fetched, mapDBAPIKeyToDomainAPIKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping api keys")
+	}
+
+	return mappedAPIKeys, nil
+}
@@ -0,0 +1,40 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertAPIKey(ctx context.Context, apiKey model.APIKey) (model.APIKey, error) {
+	sql, args, err := squirrel.
+		Insert(apiKeyTableName).
+		Columns(apiKeyColumns...).
+		Values(
+			apiKey.ID,
+			apiKey.Name,
+			apiKey.KeyHash,
+			mapDomainScopesToDBScopes(apiKey.Scopes),
+			apiKey.CreatedBy,
+			apiKey.CreatedAt,
+			apiKey.RevokedAt,
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if constraint.IsForeignKeyViolation(err) {
+		return model.APIKey{}, model.ErrAdminDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "executing sql")
+	}
+
+	return apiKey, nil
+}
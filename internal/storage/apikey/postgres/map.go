@@ -0,0 +1,29 @@
+package apikey
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/apikey/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func mapDBAPIKeyToDomainAPIKey(apiKey dbmodel.APIKey) (model.APIKey, error) {
+	scopes, err := collection.MapWithError(apiKey.Scopes, model.ParseAPIKeyScope)
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "parsing scopes")
+	}
+
+	return model.APIKey{
+		ID:        apiKey.ID,
+		Name:      apiKey.Name,
+		KeyHash:   apiKey.KeyHash,
+		Scopes:    scopes,
+		CreatedBy: apiKey.CreatedBy,
+		CreatedAt: apiKey.CreatedAt,
+		RevokedAt: apiKey.RevokedAt,
+	}, nil
+}
+
+func mapDomainScopesToDBScopes(scopes []model.APIKeyScope) []string {
+	return collection.Map(scopes, model.APIKeyScope.String)
+}
@@ -0,0 +1,48 @@
+package apikey
+
+import (
+	"context"
+	db "database/sql"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/apikey/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// RevokeAPIKey keeps the revocation time of an already revoked key.
+func (s *Storage) RevokeAPIKey(ctx context.Context, id string, revokedAt time.Time) (model.APIKey, error) {
+	sql, args, err := squirrel.
+		Update(apiKeyTableName).
+		Set(apiKeyColumnRevokedAt, squirrel.Expr("COALESCE("+apiKeyColumnRevokedAt+", ?)", revokedAt)).
+		Where(squirrel.Eq{apiKeyColumnID: id}).
+		Suffix("RETURNING " + strings.Join(apiKeyColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.APIKey])
+	if errors.Is(err, db.ErrNoRows) {
+		return model.APIKey{}, model.ErrAPIKeyDoesNotExist
+	}
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "collecting row")
+	}
+
+	mappedAPIKey, err := mapDBAPIKeyToDomainAPIKey(fetched)
+	if err != nil {
+		return model.APIKey{}, errors.Wrap(err, "mapping api key")
+	}
+
+	return mappedAPIKey, nil
+}
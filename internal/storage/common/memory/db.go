@@ -0,0 +1,87 @@
+package memory
+
+import (
+	"context"
+	"sync"
+)
+
+// DB keeps the committed State. Transactions are serialized and work
+// on a private copy of the state, which replaces the committed one
+// only when the transaction succeeds. Reads outside of a transaction
+// see the last committed state, like READ COMMITTED in Postgres.
+type DB struct {
+	txMu  sync.Mutex
+	mu    sync.RWMutex
+	state *State
+}
+
+type txKey struct{}
+
+type tx struct {
+	db    *DB
+	state *State
+}
+
+func New() *DB {
+	return &DB{state: newState()}
+}
+
+// Read runs fn on the state of the transaction in ctx,
+// or on the committed state. fn must not modify the state.
+func (db *DB) Read(ctx context.Context, fn func(state *State) error) error {
+	if t, ok := db.txFromContext(ctx); ok {
+		return fn(t.state)
+	}
+
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	return fn(db.state)
+}
+
+// Write runs fn on the state of the transaction in ctx. Outside of
+// a transaction fn runs in its own one, so that a failed statement
+// leaves no partial changes behind.
+func (db *DB) Write(ctx context.Context, fn func(state *State) error) error {
+	if t, ok := db.txFromContext(ctx); ok {
+		return fn(t.state)
+	}
+
+	return db.transact(ctx, func(ctx context.Context) error {
+		t, _ := db.txFromContext(ctx)
+		return fn(t.state)
+	})
+}
+
+// transact joins the transaction in ctx or starts a new one.
+func (db *DB) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := db.txFromContext(ctx); ok {
+		return fn(ctx)
+	}
+
+	db.txMu.Lock()
+	defer db.txMu.Unlock()
+
+	db.mu.RLock()
+	t := &tx{db: db, state: db.state.clone()}
+	db.mu.RUnlock()
+
+	if err := fn(context.WithValue(ctx, txKey{}, t)); err != nil {
+		return err
+	}
+
+	db.mu.Lock()
+	db.state = t.state
+	db.mu.Unlock()
+
+	return nil
+}
+
+func (db *DB) txFromContext(ctx context.Context) (*tx, bool) {
+	t, ok := ctx.Value(txKey{}).(*tx)
+	if !ok || t.db != db {
+		return nil, false
+	}
+
+	return t, true
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+// Manager is a trm.Manager over DB. A nested Do joins the outer
+// transaction, and the changes of a failed or panicked transaction
+// are dropped. Settings are ignored, transactions are serializable.
+type Manager struct {
+	db *DB
+}
+
+func NewManager(db *DB) *Manager {
+	return &Manager{db: db}
+}
+
+func (m *Manager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return m.db.transact(ctx, fn)
+}
+
+func (m *Manager) DoWithSettings(
+	ctx context.Context,
+	_ trm.Settings,
+	fn func(ctx context.Context) error,
+) error {
+	return m.db.transact(ctx, fn)
+}
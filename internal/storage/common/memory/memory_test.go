@@ -0,0 +1,147 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	teamstorage "github.com/hizu77/avito-autumn-2025/internal/storage/team/memory"
+	userstorage "github.com/hizu77/avito-autumn-2025/internal/storage/user/memory"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+var errRollback = errors.New("rollback")
+
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		fn         func(ctx context.Context, teams *teamstorage.Storage) error
+		wantErr    error
+		wantPanic  bool
+		wantTeams  []string
+		wantAbsent []string
+	}{
+		{
+			name: "commits on success",
+			fn: func(ctx context.Context, teams *teamstorage.Storage) error {
+				_, err := teams.SaveTeam(ctx, model.Team{Name: "backend"})
+				return err
+			},
+			wantTeams: []string{"backend"},
+		},
+		{
+			name: "rolls back on error",
+			fn: func(ctx context.Context, teams *teamstorage.Storage) error {
+				if _, err := teams.SaveTeam(ctx, model.Team{Name: "backend"}); err != nil {
+					return err
+				}
+				return errRollback
+			},
+			wantErr:    errRollback,
+			wantAbsent: []string{"backend"},
+		},
+		{
+			name: "rolls back on panic",
+			fn: func(ctx context.Context, teams *teamstorage.Storage) error {
+				if _, err := teams.SaveTeam(ctx, model.Team{Name: "backend"}); err != nil {
+					return err
+				}
+				panic(errRollback)
+			},
+			wantPanic:  true,
+			wantAbsent: []string{"backend"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := memory.New()
+			manager := memory.NewManager(db)
+			teams := teamstorage.New(db)
+
+			do := func() error {
+				return manager.Do(context.Background(), func(ctx context.Context) error {
+					return tt.fn(ctx, teams)
+				})
+			}
+
+			if tt.wantPanic {
+				require.Panics(t, func() { _ = do() })
+			} else {
+				require.ErrorIs(t, do(), tt.wantErr)
+			}
+
+			for _, name := range tt.wantTeams {
+				_, err := teams.GetTeamByName(context.Background(), name)
+				require.NoError(t, err)
+			}
+			for _, name := range tt.wantAbsent {
+				_, err := teams.GetTeamByName(context.Background(), name)
+				require.ErrorIs(t, err, model.ErrTeamDoesNotExist)
+			}
+		})
+	}
+}
+
+func TestManagerIsolation(t *testing.T) {
+	t.Parallel()
+
+	db := memory.New()
+	manager := memory.NewManager(db)
+	teams := teamstorage.New(db)
+	users := userstorage.New(db)
+
+	err := manager.Do(context.Background(), func(ctx context.Context) error {
+		_, err := teams.SaveTeam(ctx, model.Team{Name: "backend"})
+		require.NoError(t, err)
+
+		// A nested transaction joins the outer one.
+		err = manager.Do(ctx, func(ctx context.Context) error {
+			_, err = users.SaveUsers(ctx, []model.User{{ID: "u1", Name: "Alice", TeamName: "backend", IsActive: true}})
+			return err
+		})
+		require.NoError(t, err)
+
+		team, err := teams.GetTeamByName(ctx, "backend")
+		require.NoError(t, err)
+		require.Len(t, team.Members, 1)
+
+		// Uncommitted changes are not seen outside of the transaction.
+		_, err = teams.GetTeamByName(context.Background(), "backend")
+		require.ErrorIs(t, err, model.ErrTeamDoesNotExist)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	team, err := teams.GetTeamByName(context.Background(), "backend")
+	require.NoError(t, err)
+	require.Equal(t, []model.User{{ID: "u1", Name: "Alice", TeamName: "backend", IsActive: true}}, team.Members)
+}
+
+func TestWriteIsAtomic(t *testing.T) {
+	t.Parallel()
+
+	db := memory.New()
+	teams := teamstorage.New(db)
+	users := userstorage.New(db)
+
+	_, err := teams.SaveTeam(context.Background(), model.Team{Name: "backend"})
+	require.NoError(t, err)
+
+	_, err = users.SaveUsers(context.Background(), []model.User{
+		{ID: "u1", Name: "Alice", TeamName: "backend"},
+		{ID: "u2", Name: "Bob", TeamName: "frontend"},
+	})
+	require.ErrorIs(t, err, memory.ErrForeignKeyViolation)
+
+	fetched, err := users.GetUsersByIDs(context.Background(), []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Empty(t, fetched)
+}
@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+// ErrForeignKeyViolation is returned by writes referencing a missing row,
+// or deleting a row that is still referenced.
+var ErrForeignKeyViolation = errors.New("violates foreign key constraint")
+
+// State holds the tables of the in-memory storage. Teams keep no members,
+// the users table does. Rows are stored by value and pointer fields
+// are never written through, so a clone shares nothing mutable.
+type State struct {
+	Teams               map[string]model.Team
+	TeamSettings        map[string]model.TeamSettings
+	Users               map[string]model.User
+	Admins              map[string]Admin
+	RefreshTokens       map[string]model.RefreshToken
+	RevokedAccessTokens map[string]time.Time
+	APIKeys             map[string]model.APIKey
+	LoginMappings       map[LoginKey]model.GitLoginMapping
+	PullRequests        map[string]model.PullRequest
+	AssignmentEvents    []model.AssignmentEvent
+	MembershipChanges   []model.MembershipChange
+	Webhooks            map[int64]model.Webhook
+	Deliveries          map[int64]model.WebhookDelivery
+	IdempotencyKeys     map[IdempotencyKey]model.IdempotencyRecord
+
+	LastWebhookID  int64
+	LastDeliveryID int64
+}
+
+// Admin is an admins row with the column the domain model does not expose.
+type Admin struct {
+	model.Admin
+	PasswordChangedAt *time.Time
+}
+
+// IdempotencyKey is the primary key of idempotency keys.
+type IdempotencyKey struct {
+	CallerID string
+	Key      string
+}
+
+// LoginKey is the primary key of git login mappings.
+type LoginKey struct {
+	Provider model.GitProvider
+	Login    string
+}
+
+func newState() *State {
+	return &State{
+		Teams:               make(map[string]model.Team),
+		TeamSettings:        make(map[string]model.TeamSettings),
+		Users:               make(map[string]model.User),
+		Admins:              make(map[string]Admin),
+		RefreshTokens:       make(map[string]model.RefreshToken),
+		RevokedAccessTokens: make(map[string]time.Time),
+		APIKeys:             make(map[string]model.APIKey),
+		LoginMappings:       make(map[LoginKey]model.GitLoginMapping),
+		PullRequests:        make(map[string]model.PullRequest),
+		Webhooks:            make(map[int64]model.Webhook),
+		Deliveries:          make(map[int64]model.WebhookDelivery),
+		IdempotencyKeys:     make(map[IdempotencyKey]model.IdempotencyRecord),
+	}
+}
+
+func (s *State) clone() *State {
+	cloned := &State{
+		Teams:               maps.Clone(s.Teams),
+		TeamSettings:        maps.Clone(s.TeamSettings),
+		Users:               maps.Clone(s.Users),
+		Admins:              maps.Clone(s.Admins),
+		RefreshTokens:       maps.Clone(s.RefreshTokens),
+		RevokedAccessTokens: maps.Clone(s.RevokedAccessTokens),
+		APIKeys:             maps.Clone(s.APIKeys),
+		LoginMappings:       maps.Clone(s.LoginMappings),
+		PullRequests:        maps.Clone(s.PullRequests),
+		AssignmentEvents:    slices.Clone(s.AssignmentEvents),
+		MembershipChanges:   slices.Clone(s.MembershipChanges),
+		Webhooks:            maps.Clone(s.Webhooks),
+		Deliveries:          maps.Clone(s.Deliveries),
+		IdempotencyKeys:     maps.Clone(s.IdempotencyKeys),
+		LastWebhookID:       s.LastWebhookID,
+		LastDeliveryID:      s.LastDeliveryID,
+	}
+
+	for id, apiKey := range cloned.APIKeys {
+		apiKey.Scopes = slices.Clone(apiKey.Scopes)
+		cloned.APIKeys[id] = apiKey
+	}
+	for id, pr := range cloned.PullRequests {
+		pr.ReviewersIDs = slices.Clone(pr.ReviewersIDs)
+		cloned.PullRequests[id] = pr
+	}
+	for id, delivery := range cloned.Deliveries {
+		delivery.Payload = slices.Clone(delivery.Payload)
+		cloned.Deliveries[id] = delivery
+	}
+
+	return cloned
+}
+
+// GetTeamSettings returns the settings of the team,
+// or the defaults if none were saved.
+func (s *State) GetTeamSettings(teamName string) model.TeamSettings {
+	settings, ok := s.TeamSettings[teamName]
+	if !ok {
+		return model.DefaultTeamSettings()
+	}
+
+	return settings
+}
+
+// DeletePullRequest deletes the pull request along with its assignment events.
+func (s *State) DeletePullRequest(id string) {
+	delete(s.PullRequests, id)
+	s.AssignmentEvents = slices.DeleteFunc(s.AssignmentEvents, func(event model.AssignmentEvent) bool {
+		return event.PullRequestID == id
+	})
+}
+
+// DeleteWebhook deletes the webhook along with its outbox messages.
+func (s *State) DeleteWebhook(id int64) {
+	delete(s.Webhooks, id)
+	for deliveryID, delivery := range s.Deliveries {
+		if delivery.WebhookID == id {
+			delete(s.Deliveries, deliveryID)
+		}
+	}
+}
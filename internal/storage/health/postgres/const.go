@@ -0,0 +1,9 @@
+package health
+
+const (
+	schemaHistoryTableName = "flyway_schema_history"
+
+	schemaHistoryColumnVersion       = "version"
+	schemaHistoryColumnSuccess       = "success"
+	schemaHistoryColumnInstalledRank = "installed_rank"
+)
@@ -0,0 +1,45 @@
+package health
+
+import (
+	"context"
+	db "database/sql"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// GetMigrationVersion returns the version of the last migration
+// successfully applied by Flyway, 0 if none was applied.
+func (s *Storage) GetMigrationVersion(ctx context.Context) (int, error) {
+	sql, args, err := squirrel.
+		Select(schemaHistoryColumnVersion).
+		From(schemaHistoryTableName).
+		Where(squirrel.And{
+			squirrel.Eq{schemaHistoryColumnSuccess: true},
+			squirrel.NotEq{schemaHistoryColumnVersion: nil},
+		}).
+		OrderBy(schemaHistoryColumnInstalledRank + " DESC").
+		Limit(1).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "building sql")
+	}
+
+	var version string
+	err = s.getter.DefaultTrOrDB(ctx, s.pool).QueryRow(ctx, sql, args...).Scan(&version)
+	if errors.Is(err, db.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "query migration version")
+	}
+
+	parsed, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse migration version %q", version)
+	}
+
+	return parsed, nil
+}
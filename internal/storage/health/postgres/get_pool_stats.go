@@ -0,0 +1,14 @@
+package health
+
+import "github.com/hizu77/avito-autumn-2025/internal/model"
+
+func (s *Storage) GetPoolStats() model.PoolStats {
+	stat := s.pool.Stat()
+
+	return model.PoolStats{
+		AcquiredConns: stat.AcquiredConns(),
+		IdleConns:     stat.IdleConns(),
+		TotalConns:    stat.TotalConns(),
+		MaxConns:      stat.MaxConns(),
+	}
+}
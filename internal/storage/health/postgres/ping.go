@@ -0,0 +1,11 @@
+package health
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) Ping(ctx context.Context) error {
+	return errors.Wrap(s.pool.Ping(ctx), "ping")
+}
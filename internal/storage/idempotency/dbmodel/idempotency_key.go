@@ -0,0 +1,13 @@
+package dbmodel
+
+import "time"
+
+type IdempotencyKey struct {
+	CallerID     string    `db:"caller_id"`
+	Key          string    `db:"key"`
+	RequestHash  string    `db:"request_hash"`
+	StatusCode   *int      `db:"status_code"`
+	ResponseBody []byte    `db:"response_body"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+}
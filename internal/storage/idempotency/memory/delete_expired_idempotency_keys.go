@@ -0,0 +1,30 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// DeleteExpiredIdempotencyKeys returns how many keys expired by now were deleted.
+func (s *Storage) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	var deleted int64
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for key, record := range state.IdempotencyKeys {
+			if !record.ExpiresAt.After(now) {
+				delete(state.IdempotencyKeys, key)
+				deleted++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "deleting expired idempotency keys")
+	}
+
+	return deleted, nil
+}
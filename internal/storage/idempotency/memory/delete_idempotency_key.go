@@ -0,0 +1,20 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, callerID string, key string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		delete(state.IdempotencyKeys, memory.IdempotencyKey{CallerID: callerID, Key: key})
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "deleting idempotency key")
+	}
+
+	return nil
+}
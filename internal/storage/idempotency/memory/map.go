@@ -0,0 +1,17 @@
+package idempotency
+
+import (
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+)
+
+func copyIdempotencyRecord(record model.IdempotencyRecord) model.IdempotencyRecord {
+	if record.Response != nil {
+		response := *record.Response
+		response.Body = slices.Clone(response.Body)
+		record.Response = &response
+	}
+
+	return record
+}
@@ -0,0 +1,47 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// ReserveIdempotencyKey inserts the record unless an unexpired one
+// with its key exists. It reports whether the record was inserted,
+// and returns the existing record otherwise.
+func (s *Storage) ReserveIdempotencyKey(
+	ctx context.Context,
+	record model.IdempotencyRecord,
+) (model.IdempotencyRecord, bool, error) {
+	record.Response = nil
+
+	var (
+		existing model.IdempotencyRecord
+		reserved bool
+	)
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		key := memory.IdempotencyKey{CallerID: record.CallerID, Key: record.Key}
+
+		stored, ok := state.IdempotencyKeys[key]
+		if ok && stored.ExpiresAt.After(record.CreatedAt) {
+			existing = copyIdempotencyRecord(stored)
+			return nil
+		}
+
+		state.IdempotencyKeys[key] = record
+		reserved = true
+
+		return nil
+	})
+	if err != nil {
+		return model.IdempotencyRecord{}, false, errors.Wrap(err, "reserving idempotency key")
+	}
+
+	if reserved {
+		return record, true, nil
+	}
+
+	return existing, false, nil
+}
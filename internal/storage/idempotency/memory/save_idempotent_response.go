@@ -0,0 +1,43 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// SaveIdempotentResponse completes a reserved key with its response
+// and moves its expiration to expiresAt.
+func (s *Storage) SaveIdempotentResponse(
+	ctx context.Context,
+	callerID string,
+	key string,
+	response model.IdempotentResponse,
+	expiresAt time.Time,
+) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		primaryKey := memory.IdempotencyKey{CallerID: callerID, Key: key}
+
+		record, ok := state.IdempotencyKeys[primaryKey]
+		if !ok {
+			return model.ErrIdempotencyKeyDoesNotExist
+		}
+
+		record.Response = &response
+		record.ExpiresAt = expiresAt
+		state.IdempotencyKeys[primaryKey] = copyIdempotencyRecord(record)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrIdempotencyKeyDoesNotExist) {
+		return model.ErrIdempotencyKeyDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "saving idempotent response")
+	}
+
+	return nil
+}
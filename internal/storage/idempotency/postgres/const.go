@@ -0,0 +1,23 @@
+package idempotency
+
+const (
+	idempotencyKeyTableName = "idempotency_keys"
+
+	idempotencyKeyColumnCallerID     = "caller_id"
+	idempotencyKeyColumnKey          = "key"
+	idempotencyKeyColumnRequestHash  = "request_hash"
+	idempotencyKeyColumnStatusCode   = "status_code"
+	idempotencyKeyColumnResponseBody = "response_body"
+	idempotencyKeyColumnCreatedAt    = "created_at"
+	idempotencyKeyColumnExpiresAt    = "expires_at"
+)
+
+var idempotencyKeyColumns = []string{
+	idempotencyKeyColumnCallerID,
+	idempotencyKeyColumnKey,
+	idempotencyKeyColumnRequestHash,
+	idempotencyKeyColumnStatusCode,
+	idempotencyKeyColumnResponseBody,
+	idempotencyKeyColumnCreatedAt,
+	idempotencyKeyColumnExpiresAt,
+}
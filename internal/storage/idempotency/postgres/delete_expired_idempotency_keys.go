@@ -0,0 +1,28 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// DeleteExpiredIdempotencyKeys returns how many keys expired by now were deleted.
+func (s *Storage) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	sql, args, err := squirrel.
+		Delete(idempotencyKeyTableName).
+		Where(squirrel.LtOrEq{idempotencyKeyColumnExpiresAt: now}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "executing sql")
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,28 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteIdempotencyKey(ctx context.Context, callerID string, key string) error {
+	sql, args, err := squirrel.
+		Delete(idempotencyKeyTableName).
+		Where(squirrel.Eq{
+			idempotencyKeyColumnCallerID: callerID,
+			idempotencyKeyColumnKey:      key,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	if _, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...); err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
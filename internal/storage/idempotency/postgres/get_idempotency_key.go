@@ -0,0 +1,42 @@
+package idempotency
+
+import (
+	"context"
+	db "database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/idempotency/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) getIdempotencyKey(ctx context.Context, callerID string, key string) (model.IdempotencyRecord, error) {
+	sql, args, err := squirrel.
+		Select(idempotencyKeyColumns...).
+		From(idempotencyKeyTableName).
+		Where(squirrel.Eq{
+			idempotencyKeyColumnCallerID: callerID,
+			idempotencyKeyColumnKey:      key,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.IdempotencyRecord{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.IdempotencyRecord{}, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.IdempotencyKey])
+	if errors.Is(err, db.ErrNoRows) {
+		return model.IdempotencyRecord{}, model.ErrIdempotencyKeyDoesNotExist
+	}
+	if err != nil {
+		return model.IdempotencyRecord{}, errors.Wrap(err, "collecting row")
+	}
+
+	return mapDBIdempotencyKeyToDomainIdempotencyRecord(fetched), nil
+}
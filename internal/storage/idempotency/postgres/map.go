@@ -0,0 +1,24 @@
+package idempotency
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/idempotency/dbmodel"
+)
+
+func mapDBIdempotencyKeyToDomainIdempotencyRecord(key dbmodel.IdempotencyKey) model.IdempotencyRecord {
+	record := model.IdempotencyRecord{
+		CallerID:    key.CallerID,
+		Key:         key.Key,
+		RequestHash: key.RequestHash,
+		CreatedAt:   key.CreatedAt,
+		ExpiresAt:   key.ExpiresAt,
+	}
+	if key.StatusCode != nil {
+		record.Response = &model.IdempotentResponse{
+			StatusCode: *key.StatusCode,
+			Body:       key.ResponseBody,
+		}
+	}
+
+	return record
+}
@@ -0,0 +1,56 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// ReserveIdempotencyKey inserts the record unless an unexpired one
+// with its key exists. It reports whether the record was inserted,
+// and returns the existing record otherwise.
+func (s *Storage) ReserveIdempotencyKey(
+	ctx context.Context,
+	record model.IdempotencyRecord,
+) (model.IdempotencyRecord, bool, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			INSERT INTO idempotency_keys (caller_id, key, request_hash, created_at, expires_at)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (caller_id, key) DO UPDATE
+			SET
+				request_hash  = EXCLUDED.request_hash,
+				status_code   = NULL,
+				response_body = NULL,
+				created_at    = EXCLUDED.created_at,
+				expires_at    = EXCLUDED.expires_at
+			WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+			record.CallerID,
+			record.Key,
+			record.RequestHash,
+			record.CreatedAt,
+			record.ExpiresAt,
+		).
+		ToSql()
+	if err != nil {
+		return model.IdempotencyRecord{}, false, errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() > 0 {
+		record.Response = nil
+		return record, true, nil
+	}
+
+	existing, err := s.getIdempotencyKey(ctx, record.CallerID, record.Key)
+	if err != nil {
+		return model.IdempotencyRecord{}, false, errors.Wrap(err, "getting existing key")
+	}
+
+	return existing, false, nil
+}
@@ -0,0 +1,45 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// SaveIdempotentResponse completes a reserved key with its response
+// and moves its expiration to expiresAt.
+func (s *Storage) SaveIdempotentResponse(
+	ctx context.Context,
+	callerID string,
+	key string,
+	response model.IdempotentResponse,
+	expiresAt time.Time,
+) error {
+	sql, args, err := squirrel.
+		Update(idempotencyKeyTableName).
+		Set(idempotencyKeyColumnStatusCode, response.StatusCode).
+		Set(idempotencyKeyColumnResponseBody, response.Body).
+		Set(idempotencyKeyColumnExpiresAt, expiresAt).
+		Where(squirrel.Eq{
+			idempotencyKeyColumnCallerID: callerID,
+			idempotencyKeyColumnKey:      key,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrIdempotencyKeyDoesNotExist
+	}
+
+	return nil
+}
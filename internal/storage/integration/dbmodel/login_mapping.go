@@ -0,0 +1,7 @@
+package dbmodel
+
+type LoginMapping struct {
+	Provider string `db:"provider"`
+	Login    string `db:"login"`
+	UserID   string `db:"user_id"`
+}
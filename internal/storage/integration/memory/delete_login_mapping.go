@@ -0,0 +1,30 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		key := memory.LoginKey{Provider: provider, Login: login}
+		if _, ok := state.LoginMappings[key]; !ok {
+			return model.ErrGitLoginNotMapped
+		}
+
+		delete(state.LoginMappings, key)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrGitLoginNotMapped) {
+		return model.ErrGitLoginNotMapped
+	}
+	if err != nil {
+		return errors.Wrap(err, "deleting login mapping")
+	}
+
+	return nil
+}
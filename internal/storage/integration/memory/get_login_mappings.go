@@ -0,0 +1,34 @@
+package integration
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error) {
+	fetched := make([]model.GitLoginMapping, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, mapping := range state.LoginMappings {
+			if provider == nil || mapping.Provider == *provider {
+				fetched = append(fetched, mapping)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading login mappings")
+	}
+
+	slices.SortFunc(fetched, func(a, b model.GitLoginMapping) int {
+		return cmp.Or(cmp.Compare(a.Provider, b.Provider), cmp.Compare(a.Login, b.Login))
+	})
+
+	return fetched, nil
+}
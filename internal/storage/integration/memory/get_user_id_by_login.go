@@ -0,0 +1,32 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetUserIDByLogin(ctx context.Context, provider model.GitProvider, login string) (string, error) {
+	var userID string
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		mapping, ok := state.LoginMappings[memory.LoginKey{Provider: provider, Login: login}]
+		if !ok {
+			return model.ErrGitLoginNotMapped
+		}
+
+		userID = mapping.UserID
+
+		return nil
+	})
+	if errors.Is(err, model.ErrGitLoginNotMapped) {
+		return "", model.ErrGitLoginNotMapped
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "reading login mapping")
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,29 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) SaveLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Users[mapping.UserID]; !ok {
+			return model.ErrUserDoesNotExist
+		}
+
+		state.LoginMappings[memory.LoginKey{Provider: mapping.Provider, Login: mapping.Login}] = mapping
+
+		return nil
+	})
+	if errors.Is(err, model.ErrUserDoesNotExist) {
+		return model.GitLoginMapping{}, model.ErrUserDoesNotExist
+	}
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "saving login mapping")
+	}
+
+	return mapping, nil
+}
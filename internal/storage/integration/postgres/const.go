@@ -0,0 +1,15 @@
+package integration
+
+const (
+	loginMappingTableName = "git_login_mappings"
+
+	loginMappingColumnProvider = "provider"
+	loginMappingColumnLogin    = "login"
+	loginMappingColumnUserID   = "user_id"
+)
+
+var loginMappingColumns = []string{
+	loginMappingColumnProvider,
+	loginMappingColumnLogin,
+	loginMappingColumnUserID,
+}
@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteLoginMapping(ctx context.Context, provider model.GitProvider, login string) error {
+	sql, args, err := squirrel.
+		Delete(loginMappingTableName).
+		Where(squirrel.Eq{
+			loginMappingColumnProvider: provider.String(),
+			loginMappingColumnLogin:    login,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrGitLoginNotMapped
+	}
+
+	return nil
+}
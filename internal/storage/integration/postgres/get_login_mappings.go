@@ -0,0 +1,46 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/integration/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetLoginMappings(ctx context.Context, provider *model.GitProvider) ([]model.GitLoginMapping, error) {
+	builder := squirrel.
+		Select(loginMappingColumns...).
+		From(loginMappingTableName).
+		OrderBy(loginMappingColumnProvider, loginMappingColumnLogin).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if provider != nil {
+		builder = builder.Where(squirrel.Eq{loginMappingColumnProvider: provider.String()})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.LoginMapping])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedMappings, err := collection.MapWithError(fetched, mapDBLoginMappingToDomainLoginMapping)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping login mappings")
+	}
+
+	return mappedMappings, nil
+}
@@ -0,0 +1,36 @@
+package integration
+
+import (
+	"context"
+	db "database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetUserIDByLogin(ctx context.Context, provider model.GitProvider, login string) (string, error) {
+	sql, args, err := squirrel.
+		Select(loginMappingColumnUserID).
+		From(loginMappingTableName).
+		Where(squirrel.Eq{
+			loginMappingColumnProvider: provider.String(),
+			loginMappingColumnLogin:    login,
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return "", errors.Wrap(err, "building sql")
+	}
+
+	var userID string
+	err = s.getter.DefaultTrOrDB(ctx, s.pool).QueryRow(ctx, sql, args...).Scan(&userID)
+	if errors.Is(err, db.ErrNoRows) {
+		return "", model.ErrGitLoginNotMapped
+	}
+	if err != nil {
+		return "", errors.Wrap(err, "scanning row")
+	}
+
+	return userID, nil
+}
@@ -0,0 +1,20 @@
+package integration
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/integration/dbmodel"
+	"github.com/pkg/errors"
+)
+
+func mapDBLoginMappingToDomainLoginMapping(mapping dbmodel.LoginMapping) (model.GitLoginMapping, error) {
+	provider, err := model.ParseGitProvider(mapping.Provider)
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "parsing provider")
+	}
+
+	return model.GitLoginMapping{
+		Provider: provider,
+		Login:    mapping.Login,
+		UserID:   mapping.UserID,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package integration
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/integration/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) SaveLoginMapping(ctx context.Context, mapping model.GitLoginMapping) (model.GitLoginMapping, error) {
+	sql, args, err := squirrel.
+		Insert(loginMappingTableName).
+		Columns(loginMappingColumns...).
+		Values(mapping.Provider.String(), mapping.Login, mapping.UserID).
+		Suffix(
+			"ON CONFLICT (" + loginMappingColumnProvider + ", " + loginMappingColumnLogin + ") " +
+				"DO UPDATE SET " + loginMappingColumnUserID + " = EXCLUDED." + loginMappingColumnUserID + " " +
+				"RETURNING " + strings.Join(loginMappingColumns, ", "),
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dbmodel.LoginMapping])
+	if constraint.IsForeignKeyViolation(err) {
+		return model.GitLoginMapping{}, model.ErrUserDoesNotExist
+	}
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "collecting row")
+	}
+
+	mappedMapping, err := mapDBLoginMappingToDomainLoginMapping(fetched)
+	if err != nil {
+		return model.GitLoginMapping{}, errors.Wrap(err, "mapping login mapping")
+	}
+
+	return mappedMapping, nil
+}
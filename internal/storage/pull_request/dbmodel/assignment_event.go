@@ -0,0 +1,13 @@
+package dbmodel
+
+import "time"
+
+type AssignmentEvent struct {
+	PullRequestID string    `db:"pull_request_id"`
+	Type          string    `db:"event_type"`
+	Actor         string    `db:"actor"`
+	OldReviewerID *string   `db:"old_reviewer_id"`
+	NewReviewerID *string   `db:"new_reviewer_id"`
+	Reason        string    `db:"reason"`
+	CreatedAt     time.Time `db:"created_at"`
+}
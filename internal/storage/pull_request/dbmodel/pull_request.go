@@ -3,11 +3,13 @@ package dbmodel
 import "time"
 
 type PullRequest struct {
-	ID          string   `db:"pr_id"`
-	Name        string   `db:"pr_name"`
-	AuthorID    string   `db:"author_id"`
-	Status      string   `db:"status"`
-	ReviewerIDs []string `db:"reviewer_ids"`
+	ID                string   `db:"pr_id"`
+	Name              string   `db:"pr_name"`
+	AuthorID          string   `db:"author_id"`
+	Status            string   `db:"status"`
+	ReviewerIDs       []string `db:"reviewer_ids"`
+	NeedMoreReviewers bool     `db:"need_more_reviewers"`
+	Version           int      `db:"version"`
 
 	CreatedAt time.Time  `db:"created_at"`
 	MergedAt  *time.Time `db:"merged_at"`
@@ -0,0 +1,6 @@
+package dbmodel
+
+type RemovedReviewer struct {
+	PullRequestID string `db:"pull_request_id"`
+	ReviewerID    string `db:"reviewer_id"`
+}
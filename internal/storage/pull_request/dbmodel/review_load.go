@@ -0,0 +1,6 @@
+package dbmodel
+
+type ReviewLoad struct {
+	ReviewerID string `db:"reviewer_id"`
+	OpenCount  int    `db:"open_count"`
+}
@@ -0,0 +1,8 @@
+package dbmodel
+
+type Reviewer struct {
+	ID       string `db:"id"`
+	Name     string `db:"name"`
+	TeamName string `db:"team_name"`
+	IsActive bool   `db:"is_active"`
+}
@@ -0,0 +1,19 @@
+package dbmodel
+
+type ReviewerStats struct {
+	UserID         string `db:"user_id"`
+	Username       string `db:"user_name"`
+	TeamName       string `db:"team_name"`
+	OpenCount      int    `db:"open_count"`
+	MergedCount    int    `db:"merged_count"`
+	TotalCount     int    `db:"total_count"`
+	ReassignedAway int    `db:"reassigned_away_count"`
+}
+
+type PullRequestStats struct {
+	ID             string `db:"pr_id"`
+	Name           string `db:"pr_name"`
+	AuthorID       string `db:"author_id"`
+	Status         string `db:"status"`
+	ReviewersCount int    `db:"reviewers_count"`
+}
@@ -0,0 +1,39 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteOpenPullRequestsByAuthors(
+	ctx context.Context,
+	authorIDs []string,
+) ([]string, error) {
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	deletedIDs := make([]string, 0)
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for id, pr := range state.PullRequests {
+			if pr.Status == model.StatusOpen && slices.Contains(authorIDs, pr.AuthorID) {
+				state.DeletePullRequest(id)
+				deletedIDs = append(deletedIDs, id)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "deleting pull requests")
+	}
+
+	slices.Sort(deletedIDs)
+
+	return deletedIDs, nil
+}
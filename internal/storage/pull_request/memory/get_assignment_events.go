@@ -0,0 +1,38 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// GetAssignmentEvents orders the events by creation time,
+// and the events created at once by insertion order.
+func (s *Storage) GetAssignmentEvents(
+	ctx context.Context,
+	pullRequestID string,
+) ([]model.AssignmentEvent, error) {
+	fetched := make([]model.AssignmentEvent, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, event := range state.AssignmentEvents {
+			if event.PullRequestID == pullRequestID {
+				fetched = append(fetched, event)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading assignment events")
+	}
+
+	slices.SortStableFunc(fetched, func(a, b model.AssignmentEvent) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return fetched, nil
+}
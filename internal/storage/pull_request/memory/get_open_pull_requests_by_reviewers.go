@@ -0,0 +1,38 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetOpenPullRequestsByReviewers(
+	ctx context.Context,
+	ids []string,
+) ([]model.PullRequest, error) {
+	fetched := make([]model.PullRequest, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			if pr.Status != model.StatusOpen {
+				continue
+			}
+
+			if slices.ContainsFunc(pr.ReviewersIDs, func(reviewerID string) bool {
+				return slices.Contains(ids, reviewerID)
+			}) {
+				fetched = append(fetched, copyPullRequest(pr))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pull requests")
+	}
+
+	return sortPullRequestsByID(fetched), nil
+}
@@ -0,0 +1,38 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetOpenReviewCounts(
+	ctx context.Context,
+	reviewerIDs []string,
+) (map[string]int, error) {
+	counts := make(map[string]int)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			if pr.Status != model.StatusOpen {
+				continue
+			}
+
+			for _, reviewerID := range pr.ReviewersIDs {
+				if slices.Contains(reviewerIDs, reviewerID) {
+					counts[reviewerID]++
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading review counts")
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,32 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestByID(ctx context.Context, id string) (model.PullRequest, error) {
+	var fetched model.PullRequest
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		pr, ok := state.PullRequests[id]
+		if !ok {
+			return model.ErrPullRequestDoesNotExist
+		}
+
+		fetched = copyPullRequest(pr)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrPullRequestDoesNotExist) {
+		return model.PullRequest{}, model.ErrPullRequestDoesNotExist
+	}
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "reading pull request")
+	}
+
+	return fetched, nil
+}
@@ -0,0 +1,37 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestReviewers(ctx context.Context, id string) ([]model.User, error) {
+	reviewers := make([]model.User, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		pr, ok := state.PullRequests[id]
+		if !ok {
+			return nil
+		}
+
+		for _, reviewerID := range copyPullRequest(pr).ReviewersIDs {
+			user := state.Users[reviewerID]
+			reviewers = append(reviewers, model.User{
+				ID:       user.ID,
+				Name:     user.Name,
+				TeamName: user.TeamName,
+				IsActive: user.IsActive,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading reviewers")
+	}
+
+	return reviewers, nil
+}
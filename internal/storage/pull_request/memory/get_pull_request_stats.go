@@ -0,0 +1,49 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestStats(
+	ctx context.Context,
+	filter model.StatsFilter,
+) ([]model.PullRequestStats, error) {
+	stats := make([]model.PullRequestStats, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			author := state.Users[pr.AuthorID]
+			if filter.TeamName != nil && author.TeamName != *filter.TeamName {
+				continue
+			}
+			if !createdBetween(pr, filter.From, filter.To) {
+				continue
+			}
+
+			stats = append(stats, model.PullRequestStats{
+				ID:             pr.ID,
+				Name:           pr.Name,
+				AuthorID:       pr.AuthorID,
+				Status:         pr.Status,
+				ReviewersCount: len(pr.ReviewersIDs),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pull request stats")
+	}
+
+	slices.SortFunc(stats, func(a, b model.PullRequestStats) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,42 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestsNeedingReviewers(
+	ctx context.Context,
+	teamName string,
+) ([]model.PullRequest, error) {
+	fetched := make([]model.PullRequest, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			if pr.Status != model.StatusOpen || !pr.NeedMoreReviewers {
+				continue
+			}
+			if state.Users[pr.AuthorID].TeamName != teamName {
+				continue
+			}
+
+			fetched = append(fetched, copyPullRequest(pr))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pull requests")
+	}
+
+	slices.SortFunc(fetched, func(a, b model.PullRequest) int {
+		return cmp.Or(a.CreatedAt.Compare(*b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	return fetched, nil
+}
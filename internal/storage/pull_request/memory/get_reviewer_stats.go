@@ -0,0 +1,73 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// GetReviewerStats counts only the pull requests created in the period
+// and the reassignments made in it.
+func (s *Storage) GetReviewerStats(
+	ctx context.Context,
+	filter model.StatsFilter,
+) ([]model.ReviewerStats, error) {
+	stats := make([]model.ReviewerStats, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		reassignedAway := make(map[string]int)
+		for _, event := range state.AssignmentEvents {
+			if event.Type != model.AssignmentEventTypeReassigned || event.OldReviewerID == nil {
+				continue
+			}
+
+			if between(event.CreatedAt, filter.From, filter.To) {
+				reassignedAway[*event.OldReviewerID]++
+			}
+		}
+
+		for _, user := range state.Users {
+			if filter.TeamName != nil && user.TeamName != *filter.TeamName {
+				continue
+			}
+
+			userStats := model.ReviewerStats{
+				UserID:         user.ID,
+				Username:       user.Name,
+				TeamName:       user.TeamName,
+				ReassignedAway: reassignedAway[user.ID],
+			}
+
+			for _, pr := range state.PullRequests {
+				if !slices.Contains(pr.ReviewersIDs, user.ID) || !createdBetween(pr, filter.From, filter.To) {
+					continue
+				}
+
+				switch pr.Status {
+				case model.StatusOpen:
+					userStats.OpenCount++
+				case model.StatusMerged:
+					userStats.MergedCount++
+				}
+				userStats.TotalCount++
+			}
+
+			stats = append(stats, userStats)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading reviewer stats")
+	}
+
+	slices.SortFunc(stats, func(a, b model.ReviewerStats) int {
+		return cmp.Compare(a.UserID, b.UserID)
+	})
+
+	return stats, nil
+}
@@ -0,0 +1,41 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// HasMergedPullRequests tells whether any of the users authored
+// or reviewed a MERGED pull request.
+func (s *Storage) HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error) {
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+
+	var exists bool
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			if pr.Status != model.StatusMerged {
+				continue
+			}
+
+			if slices.Contains(userIDs, pr.AuthorID) ||
+				slices.ContainsFunc(pr.ReviewersIDs, func(id string) bool { return slices.Contains(userIDs, id) }) {
+				exists = true
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "checking merged pull requests")
+	}
+
+	return exists, nil
+}
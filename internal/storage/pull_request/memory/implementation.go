@@ -0,0 +1,15 @@
+package pullrequest
+
+import "github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+
+const initialVersion = 1
+
+type Storage struct {
+	db *memory.DB
+}
+
+func New(db *memory.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
@@ -0,0 +1,35 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertAssignmentEvents(
+	ctx context.Context,
+	events []model.AssignmentEvent,
+) ([]model.AssignmentEvent, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for _, event := range events {
+			if _, ok := state.PullRequests[event.PullRequestID]; !ok {
+				return errors.Wrapf(memory.ErrForeignKeyViolation, "pull request %q", event.PullRequestID)
+			}
+		}
+
+		state.AssignmentEvents = append(state.AssignmentEvents, events...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "inserting assignment events")
+	}
+
+	return events, nil
+}
@@ -0,0 +1,46 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertPullRequest(
+	ctx context.Context,
+	request model.PullRequest,
+) (model.PullRequest, error) {
+	request.Version = initialVersion
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.PullRequests[request.ID]; ok {
+			return model.ErrPullRequestAlreadyExists
+		}
+		if _, ok := state.Users[request.AuthorID]; !ok {
+			return errors.Wrapf(memory.ErrForeignKeyViolation, "author %q", request.AuthorID)
+		}
+
+		reviewerIDs, err := checkReviewers(state, request.ReviewersIDs)
+		if err != nil {
+			return err
+		}
+
+		stored := request
+		stored.ReviewersIDs = reviewerIDs
+		stored.CreatedAt = truncateTime(request.CreatedAt)
+		stored.MergedAt = truncateTime(request.MergedAt)
+		state.PullRequests[request.ID] = stored
+
+		return nil
+	})
+	if errors.Is(err, model.ErrPullRequestAlreadyExists) {
+		return model.PullRequest{}, model.ErrPullRequestAlreadyExists
+	}
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "inserting pull request")
+	}
+
+	return request, nil
+}
@@ -0,0 +1,62 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter model.PullRequestFilter,
+) ([]model.PullRequest, error) {
+	fetched := make([]model.PullRequest, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, pr := range state.PullRequests {
+			if matchesFilter(state, pr, filter) {
+				fetched = append(fetched, copyPullRequest(pr))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading pull requests")
+	}
+
+	slices.SortFunc(fetched, func(a, b model.PullRequest) int {
+		return -cmp.Or(a.CreatedAt.Compare(*b.CreatedAt), cmp.Compare(a.ID, b.ID))
+	})
+
+	if len(fetched) > filter.Limit {
+		fetched = fetched[:filter.Limit]
+	}
+
+	return fetched, nil
+}
+
+func matchesFilter(state *memory.State, pr model.PullRequest, filter model.PullRequestFilter) bool {
+	switch {
+	case filter.Status != nil && pr.Status != *filter.Status:
+		return false
+	case filter.AuthorID != nil && pr.AuthorID != *filter.AuthorID:
+		return false
+	case filter.ReviewerID != nil && !slices.Contains(pr.ReviewersIDs, *filter.ReviewerID):
+		return false
+	case filter.TeamName != nil && state.Users[pr.AuthorID].TeamName != *filter.TeamName:
+		return false
+	case !createdBetween(pr, filter.CreatedFrom, filter.CreatedTo):
+		return false
+	}
+
+	if filter.After == nil {
+		return true
+	}
+
+	return cmp.Or(pr.CreatedAt.Compare(filter.After.CreatedAt), cmp.Compare(pr.ID, filter.After.ID)) < 0
+}
@@ -0,0 +1,74 @@
+package pullrequest
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// copyPullRequest detaches the reviewers from the state
+// and orders them by id, as the Postgres storage does.
+func copyPullRequest(pr model.PullRequest) model.PullRequest {
+	reviewerIDs := make([]string, 0, len(pr.ReviewersIDs))
+	reviewerIDs = append(reviewerIDs, pr.ReviewersIDs...)
+	slices.Sort(reviewerIDs)
+
+	pr.ReviewersIDs = reviewerIDs
+
+	return pr
+}
+
+func sortPullRequestsByID(prs []model.PullRequest) []model.PullRequest {
+	slices.SortFunc(prs, func(a, b model.PullRequest) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return prs
+}
+
+// truncateTime keeps the microsecond precision of timestamptz,
+// so that the cursors of both storages behave the same.
+func truncateTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	truncated := t.Truncate(time.Microsecond)
+
+	return &truncated
+}
+
+func createdBetween(pr model.PullRequest, from *time.Time, to *time.Time) bool {
+	return between(*pr.CreatedAt, from, to)
+}
+
+func between(at time.Time, from *time.Time, to *time.Time) bool {
+	if from != nil && at.Before(*from) {
+		return false
+	}
+	if to != nil && !at.Before(*to) {
+		return false
+	}
+
+	return true
+}
+
+// checkReviewers emulates the foreign key of pull_request_reviewers
+// and drops duplicates like ON CONFLICT DO NOTHING.
+func checkReviewers(state *memory.State, reviewerIDs []string) ([]string, error) {
+	unique := make([]string, 0, len(reviewerIDs))
+	for _, id := range reviewerIDs {
+		if _, ok := state.Users[id]; !ok {
+			return nil, errors.Wrapf(memory.ErrForeignKeyViolation, "reviewer %q", id)
+		}
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+
+	return unique, nil
+}
@@ -0,0 +1,42 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// RefreshNeedMoreReviewers sets NeedMoreReviewers of the OPEN pull requests
+// of the team's authors to whether they have fewer than minReviewers
+// reviewers. Only pull requests whose flag changes get a new version.
+func (s *Storage) RefreshNeedMoreReviewers(
+	ctx context.Context,
+	teamName string,
+	minReviewers int,
+) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for id, pr := range state.PullRequests {
+			if pr.Status != model.StatusOpen || state.Users[pr.AuthorID].TeamName != teamName {
+				continue
+			}
+
+			needMoreReviewers := len(pr.ReviewersIDs) < minReviewers
+			if pr.NeedMoreReviewers == needMoreReviewers {
+				continue
+			}
+
+			pr.NeedMoreReviewers = needMoreReviewers
+			pr.Version++
+			state.PullRequests[id] = pr
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "refreshing need more reviewers")
+	}
+
+	return nil
+}
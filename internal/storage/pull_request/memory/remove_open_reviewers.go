@@ -0,0 +1,68 @@
+package pullrequest
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// RemoveOpenReviewers drops the given reviewers from every OPEN pull request
+// and flags those left with fewer reviewers than the minimum of the author's
+// team as needing more reviewers.
+func (s *Storage) RemoveOpenReviewers(
+	ctx context.Context,
+	reviewerIDs []string,
+) ([]model.ReviewerReplacement, error) {
+	if len(reviewerIDs) == 0 {
+		return nil, nil
+	}
+
+	removals := make([]model.ReviewerReplacement, 0)
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for id, pr := range state.PullRequests {
+			if pr.Status != model.StatusOpen {
+				continue
+			}
+
+			kept := make([]string, 0, len(pr.ReviewersIDs))
+			for _, reviewerID := range pr.ReviewersIDs {
+				if !slices.Contains(reviewerIDs, reviewerID) {
+					kept = append(kept, reviewerID)
+					continue
+				}
+
+				removals = append(removals, model.ReviewerReplacement{
+					PullRequestID: id,
+					OldReviewerID: reviewerID,
+				})
+			}
+
+			if len(kept) < len(pr.ReviewersIDs) {
+				pr.ReviewersIDs = kept
+				minReviewers := state.GetTeamSettings(state.Users[pr.AuthorID].TeamName).MinReviewers
+				pr.NeedMoreReviewers = len(kept) < minReviewers
+				pr.Version++
+				state.PullRequests[id] = pr
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "removing reviewers")
+	}
+
+	slices.SortFunc(removals, func(a, b model.ReviewerReplacement) int {
+		return cmp.Or(
+			cmp.Compare(a.PullRequestID, b.PullRequestID),
+			cmp.Compare(a.OldReviewerID, b.OldReviewerID),
+		)
+	})
+
+	return removals, nil
+}
@@ -0,0 +1,73 @@
+package pullrequest
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// ReplaceReviewers fails with model.ErrPullRequestConflict if any of the
+// pull requests has changed since its version was read or is no longer OPEN.
+func (s *Storage) ReplaceReviewers(
+	ctx context.Context,
+	reassignments []model.Reassignment,
+) ([]model.Reassignment, error) {
+	if len(reassignments) == 0 {
+		return nil, nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		// Several reassignments of one pull request share the version read,
+		// which is bumped only once.
+		replaced := make(map[string]struct{}, len(reassignments))
+
+		for _, reassignment := range reassignments {
+			pr, ok := state.PullRequests[reassignment.PullRequestID]
+			if !ok || pr.Status != model.StatusOpen {
+				return model.ErrPullRequestConflict
+			}
+
+			_, again := replaced[reassignment.PullRequestID]
+			if !again && pr.Version != reassignment.Version {
+				return model.ErrPullRequestConflict
+			}
+
+			index := slices.Index(pr.ReviewersIDs, reassignment.OldReviewerID)
+			if index < 0 {
+				return model.ErrPullRequestConflict
+			}
+
+			if _, err := checkReviewers(state, []string{reassignment.NewReviewerID}); err != nil {
+				return err
+			}
+			if slices.Contains(pr.ReviewersIDs, reassignment.NewReviewerID) {
+				return errors.Errorf(
+					"reviewer %q is already assigned to %q",
+					reassignment.NewReviewerID,
+					reassignment.PullRequestID,
+				)
+			}
+
+			pr.ReviewersIDs = slices.Clone(pr.ReviewersIDs)
+			pr.ReviewersIDs[index] = reassignment.NewReviewerID
+			if !again {
+				pr.Version++
+			}
+			state.PullRequests[reassignment.PullRequestID] = pr
+			replaced[reassignment.PullRequestID] = struct{}{}
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrPullRequestConflict) {
+		return nil, model.ErrPullRequestConflict
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "replacing reviewers")
+	}
+
+	return reassignments, nil
+}
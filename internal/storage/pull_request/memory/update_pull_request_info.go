@@ -0,0 +1,53 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// UpdatePullRequestInfo fails with model.ErrPullRequestConflict
+// if the pull request has changed since req was read.
+func (s *Storage) UpdatePullRequestInfo(
+	ctx context.Context,
+	req model.PullRequest,
+) (model.PullRequest, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		pr, ok := state.PullRequests[req.ID]
+		if !ok {
+			return model.ErrPullRequestDoesNotExist
+		}
+		if pr.Version != req.Version {
+			return model.ErrPullRequestConflict
+		}
+		if _, ok = state.Users[req.AuthorID]; !ok {
+			return errors.Wrapf(memory.ErrForeignKeyViolation, "author %q", req.AuthorID)
+		}
+
+		pr.Name = req.Name
+		pr.AuthorID = req.AuthorID
+		pr.Status = req.Status
+		pr.CreatedAt = truncateTime(req.CreatedAt)
+		pr.MergedAt = truncateTime(req.MergedAt)
+		pr.NeedMoreReviewers = req.NeedMoreReviewers
+		pr.Version++
+		state.PullRequests[req.ID] = pr
+
+		return nil
+	})
+	if errors.Is(err, model.ErrPullRequestDoesNotExist) {
+		return model.PullRequest{}, model.ErrPullRequestDoesNotExist
+	}
+	if errors.Is(err, model.ErrPullRequestConflict) {
+		return model.PullRequest{}, model.ErrPullRequestConflict
+	}
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "updating pull request")
+	}
+
+	req.Version++
+
+	return req, nil
+}
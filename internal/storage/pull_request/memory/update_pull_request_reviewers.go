@@ -0,0 +1,51 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// UpdatePullRequestReviewers fails with model.ErrPullRequestConflict
+// if the pull request has changed since req was read.
+func (s *Storage) UpdatePullRequestReviewers(
+	ctx context.Context,
+	req model.PullRequest,
+) (model.PullRequest, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		pr, ok := state.PullRequests[req.ID]
+		if !ok {
+			return model.ErrPullRequestDoesNotExist
+		}
+		if pr.Version != req.Version {
+			return model.ErrPullRequestConflict
+		}
+
+		reviewerIDs, err := checkReviewers(state, req.ReviewersIDs)
+		if err != nil {
+			return err
+		}
+
+		pr.NeedMoreReviewers = req.NeedMoreReviewers
+		pr.ReviewersIDs = reviewerIDs
+		pr.Version++
+		state.PullRequests[req.ID] = pr
+
+		return nil
+	})
+	if errors.Is(err, model.ErrPullRequestDoesNotExist) {
+		return model.PullRequest{}, model.ErrPullRequestDoesNotExist
+	}
+	if errors.Is(err, model.ErrPullRequestConflict) {
+		return model.PullRequest{}, model.ErrPullRequestConflict
+	}
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "updating reviewers")
+	}
+
+	req.Version++
+
+	return req, nil
+}
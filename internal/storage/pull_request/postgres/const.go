@@ -1,8 +1,15 @@
 package pullrequest
 
+const initialVersion = 1
+
 const (
+	pullRequestsTable         = "pull_requests"
 	pullRequestReviewersTable = "pull_request_reviewers"
 
+	columnID                = "id"
+	columnNeedMoreReviewers = "need_more_reviewers"
+	columnVersion           = "version"
+
 	columnPullRequestID = "pull_request_id"
 	columnReviewerID    = "reviewer_id"
 )
@@ -0,0 +1,46 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteOpenPullRequestsByAuthors(
+	ctx context.Context,
+	authorIDs []string,
+) ([]string, error) {
+	if len(authorIDs) == 0 {
+		return nil, nil
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			WITH deleted AS (
+				DELETE FROM pull_requests pr
+				USING pull_request_statuses s
+				WHERE s.id = pr.status_id
+				  AND s.name = 'OPEN'
+				  AND pr.author_id = ANY($1::text[])
+				RETURNING pr.id
+			)
+			SELECT id FROM deleted ORDER BY id`, authorIDs).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	deletedIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	return deletedIDs, nil
+}
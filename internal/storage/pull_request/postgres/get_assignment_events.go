@@ -0,0 +1,52 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetAssignmentEvents(
+	ctx context.Context,
+	pullRequestID string,
+) ([]model.AssignmentEvent, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				pull_request_id,
+				event_type,
+				actor,
+				old_reviewer_id,
+				new_reviewer_id,
+				reason,
+				created_at
+			FROM assignment_events
+			WHERE pull_request_id = $1
+			ORDER BY created_at, id`, pullRequestID).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.AssignmentEvent])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedEvents, err := collection.MapWithError(fetched, mapDBAssignmentEventToDomainAssignmentEvent)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping assignment events")
+	}
+
+	return mappedEvents, nil
+}
@@ -0,0 +1,71 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetOpenPullRequestsByReviewers(
+	ctx context.Context,
+	ids []string,
+) ([]model.PullRequest, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				pr.id                                                          AS pr_id,
+				pr.name                                                        AS pr_name,
+				pr.author_id                                                   AS author_id,
+				s.name                                                         AS status,
+				pr.created_at                                                  AS created_at,
+				pr.merged_at                                                   AS merged_at,
+				pr.need_more_reviewers                                         AS need_more_reviewers,
+				pr.version                                                     AS version,
+				COALESCE(array_agg(r.reviewer_id ORDER BY r.reviewer_id), '{}') AS reviewer_ids
+			FROM pull_requests pr
+			JOIN pull_request_statuses s ON s.id = pr.status_id
+			JOIN pull_request_reviewers r ON r.pull_request_id = pr.id
+			WHERE s.name = 'OPEN'
+			  AND EXISTS (
+				SELECT 1
+				FROM pull_request_reviewers prr
+				WHERE prr.pull_request_id = pr.id
+				  AND prr.reviewer_id = ANY($1::text[])
+			  )
+			GROUP BY
+				pr_id,
+				pr_name,
+				author_id,
+				status,
+				created_at,
+				merged_at,
+				need_more_reviewers,
+				version
+			ORDER BY pr_id`, ids).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.PullRequest])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedPullRequests, err := collection.MapWithError(fetched, mapDBPullRequestToDomainPullRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping pull requests")
+	}
+
+	return mappedPullRequests, nil
+}
@@ -0,0 +1,48 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetOpenReviewCounts(
+	ctx context.Context,
+	reviewerIDs []string,
+) (map[string]int, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				r.reviewer_id AS reviewer_id,
+				COUNT(*)      AS open_count
+			FROM pull_request_reviewers r
+			JOIN pull_requests pr ON pr.id = r.pull_request_id
+			JOIN pull_request_statuses s ON s.id = pr.status_id
+			WHERE s.name = 'OPEN'
+			  AND r.reviewer_id = ANY($1::text[])
+			GROUP BY r.reviewer_id`, reviewerIDs).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.ReviewLoad])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	counts := make(map[string]int, len(fetched))
+	for _, load := range fetched {
+		counts[load.ReviewerID] = load.OpenCount
+	}
+
+	return counts, nil
+}
@@ -14,12 +14,14 @@ func (s *Storage) GetPullRequestByID(ctx context.Context, id string) (model.Pull
 	sql, args, err := squirrel.
 		Expr(`
         	SELECT
-            	pr.id         AS pr_id,
-            	pr.name       AS pr_name,
-            	pr.author_id  AS author_id,
-            	s.name        AS status,
-            	pr.created_at AS created_at,
-            	pr.merged_at  AS merged_at,
+            	pr.id                  AS pr_id,
+            	pr.name                AS pr_name,
+            	pr.author_id           AS author_id,
+            	s.name                 AS status,
+            	pr.created_at          AS created_at,
+            	pr.merged_at           AS merged_at,
+            	pr.need_more_reviewers AS need_more_reviewers,
+            	pr.version             AS version,
 				COALESCE(
   					array_agg(r.reviewer_id ORDER BY r.reviewer_id)
     				FILTER (WHERE r.reviewer_id IS NOT NULL),
@@ -35,7 +37,9 @@ func (s *Storage) GetPullRequestByID(ctx context.Context, id string) (model.Pull
             	author_id,
             	status,
             	created_at,
-            	merged_at
+            	merged_at,
+            	need_more_reviewers,
+            	version
         `, id).
 		ToSql()
 	if err != nil {
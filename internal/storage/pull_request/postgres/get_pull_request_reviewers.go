@@ -0,0 +1,44 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestReviewers(ctx context.Context, id string) ([]model.User, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				u.id,
+				u.name,
+				COALESCE(u.team_name, '') AS team_name,
+				u.is_active
+			FROM pull_request_reviewers r
+			JOIN users u ON u.id = r.reviewer_id
+			WHERE r.pull_request_id = $1
+			ORDER BY u.id`, id).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.Reviewer])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedReviewers := collection.Map(fetched, mapDBReviewerToDomainUser)
+
+	return mappedReviewers, nil
+}
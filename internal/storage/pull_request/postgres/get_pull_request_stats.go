@@ -0,0 +1,64 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetPullRequestStats(
+	ctx context.Context,
+	filter model.StatsFilter,
+) ([]model.PullRequestStats, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				pr.id                AS pr_id,
+				pr.name              AS pr_name,
+				pr.author_id         AS author_id,
+				s.name               AS status,
+				COUNT(r.reviewer_id) AS reviewers_count
+			FROM pull_requests pr
+			JOIN pull_request_statuses s ON s.id = pr.status_id
+			JOIN users a ON a.id = pr.author_id
+			LEFT JOIN pull_request_reviewers r ON r.pull_request_id = pr.id
+			WHERE ($1::text IS NULL OR a.team_name = $1)
+			  AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+			  AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+			GROUP BY
+				pr.id,
+				pr.name,
+				pr.author_id,
+				s.name
+			ORDER BY pr.id`,
+			filter.TeamName,
+			filter.From,
+			filter.To,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.PullRequestStats])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedStats, err := collection.MapWithError(fetched, mapDBPullRequestStatsToDomainPullRequestStats)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping pull request stats")
+	}
+
+	return mappedStats, nil
+}
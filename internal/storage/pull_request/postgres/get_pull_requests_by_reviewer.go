@@ -21,6 +21,8 @@ func (s *Storage) GetPullRequestsByReviewer(ctx context.Context, id string) ([]m
             	s.name 															  AS status,
             	pr.created_at 													  AS created_at,
             	pr.merged_at 													  AS merged_at,
+            	pr.need_more_reviewers 											  AS need_more_reviewers,
+            	pr.version 														  AS version,
             	COALESCE(array_agg(r2.reviewer_id ORDER BY r2.reviewer_id), '{}') AS reviewer_ids
         	FROM pull_requests pr 
 			JOIN pull_request_reviewers prr ON prr.pull_request_id = pr.id
@@ -33,7 +35,9 @@ func (s *Storage) GetPullRequestsByReviewer(ctx context.Context, id string) ([]m
             	author_id,
             	status,
             	created_at,
-            	merged_at`, id).
+            	merged_at,
+            	need_more_reviewers,
+            	version`, id).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "building sql")
@@ -0,0 +1,77 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// GetReviewerStats counts only the pull requests created in the period
+// and the reassignments made in it.
+func (s *Storage) GetReviewerStats(
+	ctx context.Context,
+	filter model.StatsFilter,
+) ([]model.ReviewerStats, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			WITH reassigned AS (
+				SELECT
+					e.old_reviewer_id AS reviewer_id,
+					COUNT(*)          AS reassigned_count
+				FROM assignment_events e
+				WHERE e.event_type = 'REASSIGNED'
+				  AND ($2::timestamptz IS NULL OR e.created_at >= $2)
+				  AND ($3::timestamptz IS NULL OR e.created_at < $3)
+				GROUP BY e.old_reviewer_id
+			)
+			SELECT
+				u.id                                          AS user_id,
+				u.name                                        AS user_name,
+				COALESCE(u.team_name, '')                     AS team_name,
+				COUNT(pr.id) FILTER (WHERE s.name = 'OPEN')   AS open_count,
+				COUNT(pr.id) FILTER (WHERE s.name = 'MERGED') AS merged_count,
+				COUNT(pr.id)                                  AS total_count,
+				COALESCE(ra.reassigned_count, 0)              AS reassigned_away_count
+			FROM users u
+			LEFT JOIN pull_request_reviewers r ON r.reviewer_id = u.id
+			LEFT JOIN pull_requests pr
+				ON pr.id = r.pull_request_id
+				AND ($2::timestamptz IS NULL OR pr.created_at >= $2)
+				AND ($3::timestamptz IS NULL OR pr.created_at < $3)
+			LEFT JOIN pull_request_statuses s ON s.id = pr.status_id
+			LEFT JOIN reassigned ra ON ra.reviewer_id = u.id
+			WHERE ($1::text IS NULL OR u.team_name = $1)
+			GROUP BY
+				u.id,
+				u.name,
+				u.team_name,
+				ra.reassigned_count
+			ORDER BY u.id`,
+			filter.TeamName,
+			filter.From,
+			filter.To,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.ReviewerStats])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedStats := collection.Map(fetched, mapDBReviewerStatsToDomainReviewerStats)
+
+	return mappedStats, nil
+}
@@ -0,0 +1,45 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// HasMergedPullRequests tells whether any of the users authored
+// or reviewed a MERGED pull request.
+func (s *Storage) HasMergedPullRequests(ctx context.Context, userIDs []string) (bool, error) {
+	if len(userIDs) == 0 {
+		return false, nil
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT EXISTS (
+				SELECT 1
+				FROM pull_requests pr
+				JOIN pull_request_statuses s ON s.id = pr.status_id
+				WHERE s.name = 'MERGED'
+				  AND (
+				      pr.author_id = ANY($1::text[])
+				      OR EXISTS (
+				          SELECT 1
+				          FROM pull_request_reviewers r
+				          WHERE r.pull_request_id = pr.id
+				            AND r.reviewer_id = ANY($1::text[])
+				      )
+				  )
+			)`, userIDs).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "building sql")
+	}
+
+	var exists bool
+	if err = s.getter.DefaultTrOrDB(ctx, s.pool).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		return false, errors.Wrap(err, "querying sql")
+	}
+
+	return exists, nil
+}
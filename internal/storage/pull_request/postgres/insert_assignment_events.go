@@ -0,0 +1,62 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertAssignmentEvents(
+	ctx context.Context,
+	events []model.AssignmentEvent,
+) ([]model.AssignmentEvent, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	pullRequestIDs := collection.Map(events, model.AssignmentEvent.GetPullRequestID)
+	types := collection.Map(events, func(event model.AssignmentEvent) string {
+		return event.Type.String()
+	})
+	actors := collection.Map(events, model.AssignmentEvent.GetActor)
+	oldReviewerIDs := collection.Map(events, model.AssignmentEvent.GetOldReviewerID)
+	newReviewerIDs := collection.Map(events, model.AssignmentEvent.GetNewReviewerID)
+	reasons := collection.Map(events, model.AssignmentEvent.GetReason)
+	createdAt := collection.Map(events, model.AssignmentEvent.GetCreatedAt)
+
+	sql, args, err := squirrel.
+		Expr(`
+			INSERT INTO assignment_events (
+				pull_request_id,
+				event_type,
+				actor,
+				old_reviewer_id,
+				new_reviewer_id,
+				reason,
+				created_at
+			)
+			SELECT * FROM unnest(
+				$1::text[],
+				$2::text[],
+				$3::text[],
+				$4::text[],
+				$5::text[],
+				$6::text[],
+				$7::timestamptz[]
+			)`,
+			pullRequestIDs, types, actors, oldReviewerIDs, newReviewerIDs, reasons, createdAt).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "executing sql")
+	}
+
+	return events, nil
+}
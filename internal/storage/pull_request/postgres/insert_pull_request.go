@@ -13,6 +13,8 @@ func (s *Storage) InsertPullRequest(
 	ctx context.Context,
 	request model.PullRequest,
 ) (model.PullRequest, error) {
+	request.Version = initialVersion
+
 	sql, args, err := squirrel.
 		Expr(`
 			INSERT INTO pull_requests (
@@ -21,7 +23,9 @@ func (s *Storage) InsertPullRequest(
 				author_id,
 				status_id,
 				created_at,
-				merged_at
+				merged_at,
+				need_more_reviewers,
+				version
 			)
 			VALUES (
 				$1,
@@ -29,7 +33,9 @@ func (s *Storage) InsertPullRequest(
 				$3,
 				(SELECT id FROM pull_request_statuses WHERE name = $4),
 				$5,
-				$6
+				$6,
+				$7,
+				$8
 			)
 		`,
 			request.ID,
@@ -38,6 +44,8 @@ func (s *Storage) InsertPullRequest(
 			request.Status,
 			request.CreatedAt,
 			request.MergedAt,
+			request.NeedMoreReviewers,
+			initialVersion,
 		).ToSql()
 	if err != nil {
 		return model.PullRequest{}, errors.Wrap(err, "building sql")
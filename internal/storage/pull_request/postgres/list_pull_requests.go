@@ -0,0 +1,112 @@
+package pullrequest
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) ListPullRequests(
+	ctx context.Context,
+	filter model.PullRequestFilter,
+) ([]model.PullRequest, error) {
+	var status *string
+	if filter.Status != nil {
+		raw := filter.Status.String()
+		status = &raw
+	}
+
+	var (
+		afterCreatedAt *time.Time
+		afterID        *string
+	)
+	if filter.After != nil {
+		afterCreatedAt = &filter.After.CreatedAt
+		afterID = &filter.After.ID
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				pr.id                  AS pr_id,
+				pr.name                AS pr_name,
+				pr.author_id           AS author_id,
+				s.name                 AS status,
+				pr.created_at          AS created_at,
+				pr.merged_at           AS merged_at,
+				pr.need_more_reviewers AS need_more_reviewers,
+				pr.version             AS version,
+				COALESCE(
+					array_agg(r.reviewer_id ORDER BY r.reviewer_id)
+					FILTER (WHERE r.reviewer_id IS NOT NULL),
+					'{}'
+				) AS reviewer_ids
+			FROM pull_requests pr
+			JOIN pull_request_statuses s ON s.id = pr.status_id
+			LEFT JOIN pull_request_reviewers r ON r.pull_request_id = pr.id
+			WHERE ($1::text IS NULL OR s.name = $1)
+			  AND ($2::text IS NULL OR pr.author_id = $2)
+			  AND ($3::text IS NULL OR EXISTS (
+				SELECT 1
+				FROM pull_request_reviewers fr
+				WHERE fr.pull_request_id = pr.id
+				  AND fr.reviewer_id = $3
+			  ))
+			  AND ($4::text IS NULL OR EXISTS (
+				SELECT 1
+				FROM users a
+				WHERE a.id = pr.author_id
+				  AND a.team_name = $4
+			  ))
+			  AND ($5::timestamptz IS NULL OR pr.created_at >= $5)
+			  AND ($6::timestamptz IS NULL OR pr.created_at < $6)
+			  AND ($7::timestamptz IS NULL OR (pr.created_at, pr.id) < ($7, $8::text))
+			GROUP BY
+				pr_id,
+				pr_name,
+				author_id,
+				status,
+				created_at,
+				merged_at,
+				need_more_reviewers,
+				version
+			ORDER BY pr.created_at DESC, pr.id DESC
+			LIMIT $9`,
+			status,
+			filter.AuthorID,
+			filter.ReviewerID,
+			filter.TeamName,
+			filter.CreatedFrom,
+			filter.CreatedTo,
+			afterCreatedAt,
+			afterID,
+			filter.Limit,
+		).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.PullRequest])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedPullRequests, err := collection.MapWithError(fetched, mapDBPullRequestToDomainPullRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping pull requests")
+	}
+
+	return mappedPullRequests, nil
+}
@@ -13,12 +13,74 @@ func mapDBPullRequestToDomainPullRequest(pr dbmodel.PullRequest) (model.PullRequ
 	}
 
 	return model.PullRequest{
-		ID:           pr.ID,
-		Name:         pr.Name,
-		AuthorID:     pr.AuthorID,
-		Status:       mappedStatus,
-		ReviewersIDs: pr.ReviewerIDs,
-		CreatedAt:    &pr.CreatedAt,
-		MergedAt:     pr.MergedAt,
+		ID:                pr.ID,
+		Name:              pr.Name,
+		AuthorID:          pr.AuthorID,
+		Status:            mappedStatus,
+		ReviewersIDs:      pr.ReviewerIDs,
+		NeedMoreReviewers: pr.NeedMoreReviewers,
+		Version:           pr.Version,
+		CreatedAt:         &pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
 	}, nil
 }
+
+func mapDBReviewerStatsToDomainReviewerStats(stats dbmodel.ReviewerStats) model.ReviewerStats {
+	return model.ReviewerStats{
+		UserID:         stats.UserID,
+		Username:       stats.Username,
+		TeamName:       stats.TeamName,
+		OpenCount:      stats.OpenCount,
+		MergedCount:    stats.MergedCount,
+		TotalCount:     stats.TotalCount,
+		ReassignedAway: stats.ReassignedAway,
+	}
+}
+
+func mapDBPullRequestStatsToDomainPullRequestStats(stats dbmodel.PullRequestStats) (model.PullRequestStats, error) {
+	mappedStatus, err := model.ParseStatus(stats.Status)
+	if err != nil {
+		return model.PullRequestStats{}, errors.Wrap(err, "mapping status")
+	}
+
+	return model.PullRequestStats{
+		ID:             stats.ID,
+		Name:           stats.Name,
+		AuthorID:       stats.AuthorID,
+		Status:         mappedStatus,
+		ReviewersCount: stats.ReviewersCount,
+	}, nil
+}
+
+func mapDBAssignmentEventToDomainAssignmentEvent(event dbmodel.AssignmentEvent) (model.AssignmentEvent, error) {
+	mappedType, err := model.ParseAssignmentEventType(event.Type)
+	if err != nil {
+		return model.AssignmentEvent{}, errors.Wrap(err, "mapping event type")
+	}
+
+	return model.AssignmentEvent{
+		PullRequestID: event.PullRequestID,
+		Type:          mappedType,
+		Actor:         event.Actor,
+		OldReviewerID: event.OldReviewerID,
+		NewReviewerID: event.NewReviewerID,
+		Reason:        event.Reason,
+		CreatedAt:     event.CreatedAt,
+	}, nil
+}
+
+func mapDBReviewerToDomainUser(reviewer dbmodel.Reviewer) model.User {
+	return model.User{
+		ID:       reviewer.ID,
+		Name:     reviewer.Name,
+		TeamName: reviewer.TeamName,
+		IsActive: reviewer.IsActive,
+	}
+}
+
+func mapDBRemovedReviewerToDomainReviewerReplacement(removed dbmodel.RemovedReviewer) model.ReviewerReplacement {
+	return model.ReviewerReplacement{
+		PullRequestID: removed.PullRequestID,
+		OldReviewerID: removed.ReviewerID,
+	}
+}
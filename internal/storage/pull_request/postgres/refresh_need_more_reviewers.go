@@ -0,0 +1,46 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// RefreshNeedMoreReviewers sets need_more_reviewers of the OPEN pull requests
+// of the team's authors to whether they have fewer than minReviewers
+// reviewers. Only pull requests whose flag changes get a new version.
+func (s *Storage) RefreshNeedMoreReviewers(
+	ctx context.Context,
+	teamName string,
+	minReviewers int,
+) error {
+	sql, args, err := squirrel.
+		Expr(`
+			UPDATE pull_requests pr
+			SET need_more_reviewers = c.reviewers_count < $2,
+			    version = pr.version + 1
+			FROM (
+				SELECT p.id, COUNT(r.reviewer_id) AS reviewers_count
+				FROM pull_requests p
+				JOIN pull_request_statuses s ON s.id = p.status_id
+				JOIN users a ON a.id = p.author_id
+				LEFT JOIN pull_request_reviewers r ON r.pull_request_id = p.id
+				WHERE s.name = 'OPEN'
+				  AND a.team_name = $1
+				GROUP BY p.id
+			) c
+			WHERE pr.id = c.id
+			  AND pr.need_more_reviewers <> (c.reviewers_count < $2)`, teamName, minReviewers).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/pull_request/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// RemoveOpenReviewers drops the given reviewers from every OPEN pull request
+// and flags those left with fewer reviewers than the minimum of the author's
+// team as needing more reviewers. All parts of the statement see the
+// reviewers before the delete, so the removed ones are subtracted.
+func (s *Storage) RemoveOpenReviewers(
+	ctx context.Context,
+	reviewerIDs []string,
+) ([]model.ReviewerReplacement, error) {
+	if len(reviewerIDs) == 0 {
+		return nil, nil
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			WITH removed AS (
+				DELETE FROM pull_request_reviewers r
+				USING pull_requests pr, pull_request_statuses s
+				WHERE pr.id = r.pull_request_id
+				  AND s.id = pr.status_id
+				  AND s.name = 'OPEN'
+				  AND r.reviewer_id = ANY($1::text[])
+				RETURNING r.pull_request_id, r.reviewer_id
+			), removed_counts AS (
+				SELECT pull_request_id, COUNT(*) AS removed_count
+				FROM removed
+				GROUP BY pull_request_id
+			), flagged AS (
+				UPDATE pull_requests pr
+				SET need_more_reviewers = (
+				        SELECT COUNT(*)
+				        FROM pull_request_reviewers r
+				        WHERE r.pull_request_id = pr.id
+				    ) - c.removed_count < COALESCE(
+				        (
+				            SELECT ts.min_reviewers
+				            FROM users a
+				            JOIN team_settings ts ON ts.team_name = a.team_name
+				            WHERE a.id = pr.author_id
+				        ),
+				        $2
+				    ),
+				    version = pr.version + 1
+				FROM removed_counts c
+				WHERE pr.id = c.pull_request_id
+			)
+			SELECT pull_request_id, reviewer_id
+			FROM removed
+			ORDER BY pull_request_id, reviewer_id`, reviewerIDs, model.DefaultTeamSettings().MinReviewers).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.RemovedReviewer])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedRemovals := collection.Map(fetched, mapDBRemovedReviewerToDomainReviewerReplacement)
+
+	return mappedRemovals, nil
+}
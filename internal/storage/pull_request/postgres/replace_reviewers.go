@@ -0,0 +1,94 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+// ReplaceReviewers fails with model.ErrPullRequestConflict if any of the
+// pull requests has changed since its version was read or is no longer OPEN.
+// The versions are bumped first, so the rows stay locked while the reviewers
+// are replaced.
+func (s *Storage) ReplaceReviewers(
+	ctx context.Context,
+	reassignments []model.Reassignment,
+) ([]model.Reassignment, error) {
+	if len(reassignments) == 0 {
+		return nil, nil
+	}
+
+	versions := make(map[string]int, len(reassignments))
+	for _, reassignment := range reassignments {
+		versions[reassignment.PullRequestID] = reassignment.Version
+	}
+
+	versionedIDs := make([]string, 0, len(versions))
+	versionedVersions := make([]int, 0, len(versions))
+	for id, version := range versions {
+		versionedIDs = append(versionedIDs, id)
+		versionedVersions = append(versionedVersions, version)
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			UPDATE pull_requests pr
+			SET version = pr.version + 1
+			FROM unnest(
+				$1::text[],
+				$2::int[]
+			) AS t(pull_request_id, version), pull_request_statuses s
+			WHERE pr.id = t.pull_request_id
+			  AND pr.version = t.version
+			  AND s.id = pr.status_id
+			  AND s.name = 'OPEN'`,
+			versionedIDs, versionedVersions).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building version sql")
+	}
+
+	tx := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "executing version sql")
+	}
+	if tag.RowsAffected() != int64(len(versions)) {
+		return nil, model.ErrPullRequestConflict
+	}
+
+	pullRequestIDs := collection.Map(reassignments, model.Reassignment.GetPullRequestID)
+	oldReviewerIDs := collection.Map(reassignments, model.Reassignment.GetOldReviewerID)
+	newReviewerIDs := collection.Map(reassignments, model.Reassignment.GetNewReviewerID)
+
+	sql, args, err = squirrel.
+		Expr(`
+			UPDATE pull_request_reviewers r
+			SET reviewer_id = t.new_reviewer_id
+			FROM unnest(
+				$1::text[],
+				$2::text[],
+				$3::text[]
+			) AS t(pull_request_id, old_reviewer_id, new_reviewer_id)
+			WHERE r.pull_request_id = t.pull_request_id
+			  AND r.reviewer_id = t.old_reviewer_id`,
+			pullRequestIDs, oldReviewerIDs, newReviewerIDs).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building replace sql")
+	}
+
+	tag, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "executing replace sql")
+	}
+	if tag.RowsAffected() != int64(len(reassignments)) {
+		return nil, model.ErrPullRequestConflict
+	}
+
+	return reassignments, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+// UpdatePullRequestInfo fails with model.ErrPullRequestConflict
+// if the pull request has changed since req was read.
 func (s *Storage) UpdatePullRequestInfo(
 	ctx context.Context,
 	req model.PullRequest,
@@ -16,19 +18,23 @@ func (s *Storage) UpdatePullRequestInfo(
 		Expr(`
         UPDATE pull_requests
         SET
-            name       = $1,
-            author_id  = $2,
-            status_id  = (SELECT id FROM pull_request_statuses WHERE name = $3),
-            created_at = $4,
-            merged_at  = $5
-        WHERE id = $6
+            name                = $1,
+            author_id           = $2,
+            status_id           = (SELECT id FROM pull_request_statuses WHERE name = $3),
+            created_at          = $4,
+            merged_at           = $5,
+            need_more_reviewers = $6,
+            version             = version + 1
+        WHERE id = $7 AND version = $8
     	`,
 			req.Name,
 			req.AuthorID,
 			req.Status,
 			req.CreatedAt,
 			req.MergedAt,
+			req.NeedMoreReviewers,
 			req.ID,
+			req.Version,
 		).
 		ToSql()
 	if err != nil {
@@ -40,8 +46,10 @@ func (s *Storage) UpdatePullRequestInfo(
 		return model.PullRequest{}, errors.Wrap(err, "executing sql")
 	}
 	if tag.RowsAffected() == 0 {
-		return model.PullRequest{}, model.ErrPullRequestDoesNotExist
+		return model.PullRequest{}, s.staleVersionError(ctx, req.ID)
 	}
 
+	req.Version++
+
 	return req, nil
 }
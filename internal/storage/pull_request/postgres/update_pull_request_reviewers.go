@@ -8,11 +8,36 @@ import (
 	"github.com/pkg/errors"
 )
 
+// UpdatePullRequestReviewers fails with model.ErrPullRequestConflict
+// if the pull request has changed since req was read.
 func (s *Storage) UpdatePullRequestReviewers(
 	ctx context.Context,
 	req model.PullRequest,
 ) (model.PullRequest, error) {
 	sql, args, err := squirrel.
+		Update(pullRequestsTable).
+		Set(columnNeedMoreReviewers, req.NeedMoreReviewers).
+		Set(columnVersion, squirrel.Expr(columnVersion+" + 1")).
+		Where(squirrel.Eq{columnID: req.ID, columnVersion: req.Version}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "building update sql")
+	}
+
+	tx := s.getter.DefaultTrOrDB(ctx, s.pool)
+
+	tag, err := tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return model.PullRequest{}, errors.Wrap(err, "executing update sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.PullRequest{}, s.staleVersionError(ctx, req.ID)
+	}
+
+	req.Version++
+
+	sql, args, err = squirrel.
 		Delete(pullRequestReviewersTable).
 		Where(squirrel.Eq{columnPullRequestID: req.ID}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -21,8 +46,6 @@ func (s *Storage) UpdatePullRequestReviewers(
 		return model.PullRequest{}, errors.Wrap(err, "building delete sql")
 	}
 
-	tx := s.getter.DefaultTrOrDB(ctx, s.pool)
-
 	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		return model.PullRequest{}, errors.Wrap(err, "executing delete sql")
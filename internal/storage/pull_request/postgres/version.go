@@ -0,0 +1,31 @@
+package pullrequest
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+// staleVersionError tells why an update guarded by the version matched
+// no rows: the pull request is gone, or someone changed it first.
+func (s *Storage) staleVersionError(ctx context.Context, id string) error {
+	sql, args, err := squirrel.
+		Expr(`SELECT EXISTS (SELECT 1 FROM pull_requests WHERE id = $1)`, id).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	var exists bool
+	if err = s.getter.DefaultTrOrDB(ctx, s.pool).QueryRow(ctx, sql, args...).Scan(&exists); err != nil {
+		return errors.Wrap(err, "querying sql")
+	}
+
+	if !exists {
+		return model.ErrPullRequestDoesNotExist
+	}
+
+	return model.ErrPullRequestConflict
+}
@@ -1,8 +1,13 @@
 package dbmodel
 
 type Row struct {
-	TName     string  `db:"team_name"`
-	UID       *string `db:"user_id"`
-	UName     *string `db:"user_name"`
-	UIsActive *bool   `db:"user_is_active"`
+	TName             string  `db:"team_name"`
+	TReviewerStrategy string  `db:"team_reviewer_strategy"`
+	TRoundRobinCursor *string `db:"team_round_robin_cursor"`
+	TMinReviewers     *int    `db:"team_min_reviewers"`
+	TMaxReviewers     *int    `db:"team_max_reviewers"`
+	UID               *string `db:"user_id"`
+	UName             *string `db:"user_name"`
+	UIsActive         *bool   `db:"user_is_active"`
+	USeniority        *int    `db:"user_seniority"`
 }
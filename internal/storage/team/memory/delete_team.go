@@ -0,0 +1,51 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// DeleteTeam fails while the team has members. Settings and webhooks
+// of the team are deleted with it and its admins are left without a team.
+func (s *Storage) DeleteTeam(ctx context.Context, name string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Teams[name]; !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		for _, user := range state.Users {
+			if user.TeamName == name {
+				return errors.Wrapf(memory.ErrForeignKeyViolation, "user %q", user.ID)
+			}
+		}
+
+		delete(state.Teams, name)
+		delete(state.TeamSettings, name)
+
+		for id, webhook := range state.Webhooks {
+			if webhook.TeamName != nil && *webhook.TeamName == name {
+				state.DeleteWebhook(id)
+			}
+		}
+
+		for id, admin := range state.Admins {
+			if admin.TeamName != nil && *admin.TeamName == name {
+				admin.TeamName = nil
+				state.Admins[id] = admin
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "deleting team")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetTeamByName(ctx context.Context, name string) (model.Team, error) {
+	var fetched model.Team
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		team, ok := state.Teams[name]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		fetched = teamWithMembers(state, team)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.Team{}, model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "reading team")
+	}
+
+	return fetched, nil
+}
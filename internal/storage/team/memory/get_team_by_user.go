@@ -0,0 +1,37 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetTeamByUserID(ctx context.Context, userID string) (model.Team, error) {
+	var fetched model.Team
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		user, ok := state.Users[userID]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		team, ok := state.Teams[user.TeamName]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		fetched = teamWithMembers(state, team)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.Team{}, model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "reading team")
+	}
+
+	return fetched, nil
+}
@@ -0,0 +1,13 @@
+package team
+
+import "github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+
+type Storage struct {
+	db *memory.DB
+}
+
+func New(db *memory.DB) *Storage {
+	return &Storage{
+		db: db,
+	}
+}
@@ -0,0 +1,25 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertMembershipChanges(ctx context.Context, changes []model.MembershipChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		state.MembershipChanges = append(state.MembershipChanges, changes...)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "inserting membership changes")
+	}
+
+	return nil
+}
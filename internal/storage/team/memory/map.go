@@ -0,0 +1,30 @@
+package team
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+)
+
+// teamWithMembers joins the team with its users ordered by id
+// and its settings, falling back to the defaults.
+func teamWithMembers(state *memory.State, team model.Team) model.Team {
+	members := make([]model.User, 0)
+	for _, user := range state.Users {
+		if user.TeamName == team.Name {
+			members = append(members, user)
+		}
+	}
+
+	slices.SortFunc(members, func(a, b model.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	team.Members = members
+
+	team.Settings = state.GetTeamSettings(team.Name)
+
+	return team
+}
@@ -0,0 +1,63 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// RenameTeam moves the members, settings, webhooks and admins of the team along,
+// like ON UPDATE CASCADE does in Postgres.
+func (s *Storage) RenameTeam(ctx context.Context, oldName string, newName string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		team, ok := state.Teams[oldName]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+		if _, ok = state.Teams[newName]; ok && newName != oldName {
+			return model.ErrTeamAlreadyExists
+		}
+
+		delete(state.Teams, oldName)
+		team.Name = newName
+		state.Teams[newName] = team
+
+		if settings, ok := state.TeamSettings[oldName]; ok {
+			delete(state.TeamSettings, oldName)
+			state.TeamSettings[newName] = settings
+		}
+
+		for id, user := range state.Users {
+			if user.TeamName == oldName {
+				user.TeamName = newName
+				state.Users[id] = user
+			}
+		}
+
+		for id, webhook := range state.Webhooks {
+			if webhook.TeamName != nil && *webhook.TeamName == oldName {
+				webhook.TeamName = &newName
+				state.Webhooks[id] = webhook
+			}
+		}
+
+		for id, admin := range state.Admins {
+			if admin.TeamName != nil && *admin.TeamName == oldName {
+				admin.TeamName = &newName
+				state.Admins[id] = admin
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) || errors.Is(err, model.ErrTeamAlreadyExists) {
+		return err
+	}
+	if err != nil {
+		return errors.Wrap(err, "renaming team")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) SaveTeam(ctx context.Context, team model.Team) (model.Team, error) {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Teams[team.Name]; ok {
+			return model.ErrTeamAlreadyExists
+		}
+
+		state.Teams[team.Name] = model.Team{
+			Name:             team.Name,
+			ReviewerStrategy: team.ReviewerStrategy,
+		}
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamAlreadyExists) {
+		return model.Team{}, model.ErrTeamAlreadyExists
+	}
+	if err != nil {
+		return model.Team{}, errors.Wrap(err, "saving team")
+	}
+
+	// A new team has no settings yet.
+	team.Settings = model.DefaultTeamSettings()
+
+	return team, nil
+}
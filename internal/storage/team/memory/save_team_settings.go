@@ -0,0 +1,29 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) SaveTeamSettings(ctx context.Context, name string, settings model.TeamSettings) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Teams[name]; !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		state.TeamSettings[name] = settings
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "saving team settings")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateReviewerStrategy(
+	ctx context.Context,
+	name string,
+	strategy model.ReviewerStrategy,
+) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		team, ok := state.Teams[name]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		team.ReviewerStrategy = strategy
+		team.RoundRobinCursor = nil
+		state.Teams[name] = team
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "updating reviewer strategy")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package team
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateRoundRobinCursor(ctx context.Context, name string, userID string) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		team, ok := state.Teams[name]
+		if !ok {
+			return model.ErrTeamDoesNotExist
+		}
+
+		team.RoundRobinCursor = &userID
+		state.Teams[name] = team
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "updating round robin cursor")
+	}
+
+	return nil
+}
@@ -3,5 +3,13 @@ package team
 const (
 	teamTableName = "teams"
 
-	teamColumnName = "name"
+	teamColumnName             = "name"
+	teamColumnReviewerStrategy = "reviewer_strategy"
+	teamColumnRoundRobinCursor = "round_robin_cursor"
+
+	settingsTableName = "team_settings"
+
+	settingsColumnTeamName     = "team_name"
+	settingsColumnMinReviewers = "min_reviewers"
+	settingsColumnMaxReviewers = "max_reviewers"
 )
@@ -15,13 +15,20 @@ func (s *Storage) GetTeamByName(ctx context.Context, name string) (model.Team, e
 	sql, args, err := squirrel.
 		Expr(`
         	SELECT
-            	t.name 		AS team_name,
-            	u.id        AS user_id,
-            	u.name      AS user_name,
-            	u.is_active AS user_is_active
+            	t.name 				 AS team_name,
+            	t.reviewer_strategy  AS team_reviewer_strategy,
+            	t.round_robin_cursor AS team_round_robin_cursor,
+            	ts.min_reviewers     AS team_min_reviewers,
+            	ts.max_reviewers     AS team_max_reviewers,
+            	u.id        		 AS user_id,
+            	u.name      		 AS user_name,
+            	u.is_active 		 AS user_is_active,
+            	u.seniority 		 AS user_seniority
         	FROM teams t
+        	LEFT JOIN team_settings ts ON ts.team_name = t.name
         	LEFT JOIN users u ON t.name = u.team_name
-        	WHERE t.name = $1`, name).
+        	WHERE t.name = $1
+        	ORDER BY u.id`, name).
 		ToSql()
 	if err != nil {
 		return model.Team{}, errors.Wrap(err, "building sql")
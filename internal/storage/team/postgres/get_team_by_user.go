@@ -20,11 +20,18 @@ func (s *Storage) GetTeamByUserID(ctx context.Context, userID string) (model.Tea
     			WHERE id = $1
 			)
 			SELECT
-				t.team_name  AS team_name,
-    			u.id         AS user_id,
-    			u.name       AS user_name,
-    			u.is_active  AS user_is_active
+				t.team_name           AS team_name,
+				tm.reviewer_strategy  AS team_reviewer_strategy,
+				tm.round_robin_cursor AS team_round_robin_cursor,
+				ts.min_reviewers      AS team_min_reviewers,
+				ts.max_reviewers      AS team_max_reviewers,
+    			u.id                  AS user_id,
+    			u.name                AS user_name,
+    			u.is_active           AS user_is_active,
+    			u.seniority           AS user_seniority
 			FROM target_team t
+			JOIN teams tm ON tm.name = t.team_name
+			LEFT JOIN team_settings ts ON ts.team_name = t.team_name
 			JOIN users u ON u.team_name = t.team_name
 			ORDER BY u.id`, userID).
 		ToSql()
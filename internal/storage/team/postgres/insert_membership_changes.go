@@ -0,0 +1,51 @@
+package team
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertMembershipChanges(ctx context.Context, changes []model.MembershipChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	userIDs := collection.Map(changes, model.MembershipChange.GetUserID)
+	oldTeamNames := collection.Map(changes, model.MembershipChange.GetOldTeamName)
+	newTeamNames := collection.Map(changes, model.MembershipChange.GetNewTeamName)
+	actors := collection.Map(changes, model.MembershipChange.GetActor)
+	changedAt := collection.Map(changes, model.MembershipChange.GetChangedAt)
+
+	sql, args, err := squirrel.
+		Expr(`
+			INSERT INTO team_membership_events (
+				user_id,
+				old_team_name,
+				new_team_name,
+				actor,
+				created_at
+			)
+			SELECT * FROM unnest(
+				$1::text[],
+				$2::text[],
+				$3::text[],
+				$4::text[],
+				$5::timestamptz[]
+			)`,
+			userIDs, oldTeamNames, newTeamNames, actors, changedAt).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
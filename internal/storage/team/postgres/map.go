@@ -7,15 +7,32 @@ import (
 
 func mapDBRowToDomainUser(row dbmodel.Row) model.User {
 	return model.User{
-		ID:       *row.UID,
-		Name:     *row.UName,
-		TeamName: row.TName,
-		IsActive: *row.UIsActive,
+		ID:        *row.UID,
+		Name:      *row.UName,
+		TeamName:  row.TName,
+		IsActive:  *row.UIsActive,
+		Seniority: *row.USeniority,
 	}
 }
 
 func mapDBRowToDomainTeams(row dbmodel.Row) model.Team {
 	return model.Team{
-		Name: row.TName,
+		Name:             row.TName,
+		ReviewerStrategy: model.ReviewerStrategy(row.TReviewerStrategy),
+		RoundRobinCursor: row.TRoundRobinCursor,
+		Settings:         mapDBRowToDomainTeamSettings(row),
+	}
+}
+
+// mapDBRowToDomainTeamSettings falls back to the defaults
+// for teams without a team_settings row.
+func mapDBRowToDomainTeamSettings(row dbmodel.Row) model.TeamSettings {
+	if row.TMinReviewers == nil || row.TMaxReviewers == nil {
+		return model.DefaultTeamSettings()
+	}
+
+	return model.TeamSettings{
+		MinReviewers: *row.TMinReviewers,
+		MaxReviewers: *row.TMaxReviewers,
 	}
 }
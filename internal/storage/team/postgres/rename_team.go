@@ -0,0 +1,37 @@
+package team
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/pkg/errors"
+)
+
+// RenameTeam relies on ON UPDATE CASCADE of users.team_name
+// to move the members along with the team.
+func (s *Storage) RenameTeam(ctx context.Context, oldName string, newName string) error {
+	sql, args, err := squirrel.
+		Update(teamTableName).
+		Set(teamColumnName, newName).
+		Where(squirrel.Eq{teamColumnName: oldName}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if constraint.IsUniqueViolation(err) {
+		return model.ErrTeamAlreadyExists
+	}
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrTeamDoesNotExist
+	}
+
+	return nil
+}
@@ -12,8 +12,8 @@ import (
 func (s *Storage) SaveTeam(ctx context.Context, team model.Team) (model.Team, error) {
 	sql, args, err := squirrel.
 		Insert(teamTableName).
-		Columns(teamColumnName).
-		Values(team.Name).
+		Columns(teamColumnName, teamColumnReviewerStrategy).
+		Values(team.Name, team.ReviewerStrategy).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -28,5 +28,8 @@ func (s *Storage) SaveTeam(ctx context.Context, team model.Team) (model.Team, er
 		return model.Team{}, errors.Wrap(err, "querying sql")
 	}
 
+	// A new team has no settings yet.
+	team.Settings = model.DefaultTeamSettings()
+
 	return team, nil
 }
@@ -0,0 +1,37 @@
+package team
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) SaveTeamSettings(ctx context.Context, name string, settings model.TeamSettings) error {
+	sql, args, err := squirrel.
+		Insert(settingsTableName).
+		Columns(settingsColumnTeamName, settingsColumnMinReviewers, settingsColumnMaxReviewers).
+		Values(name, settings.MinReviewers, settings.MaxReviewers).
+		Suffix(
+			"ON CONFLICT (" + settingsColumnTeamName + ") DO UPDATE SET " +
+				settingsColumnMinReviewers + " = EXCLUDED." + settingsColumnMinReviewers + ", " +
+				settingsColumnMaxReviewers + " = EXCLUDED." + settingsColumnMaxReviewers,
+		).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if constraint.IsForeignKeyViolation(err) {
+		return model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package team
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateRoundRobinCursor(ctx context.Context, name string, userID string) error {
+	sql, args, err := squirrel.
+		Update(teamTableName).
+		Set(teamColumnRoundRobinCursor, userID).
+		Where(squirrel.Eq{teamColumnName: name}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrTeamDoesNotExist
+	}
+
+	return nil
+}
@@ -1,8 +1,9 @@
 package dbmodel
 
 type User struct {
-	ID       string `db:"id"`
-	Name     string `db:"name"`
-	TeamName string `db:"team_name"`
-	IsActive bool   `db:"is_active"`
+	ID        string  `db:"id"`
+	Name      string  `db:"name"`
+	TeamName  *string `db:"team_name"`
+	IsActive  bool    `db:"is_active"`
+	Seniority int     `db:"seniority"`
 }
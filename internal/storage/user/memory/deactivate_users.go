@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeactivateUsers(
+	ctx context.Context,
+	teamName *string,
+	ids []string,
+) ([]model.User, error) {
+	deactivated := make([]model.User, 0)
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for id, user := range state.Users {
+			if teamName != nil && user.TeamName != *teamName {
+				continue
+			}
+			if len(ids) > 0 && !slices.Contains(ids, id) {
+				continue
+			}
+
+			user.IsActive = false
+			state.Users[id] = user
+			deactivated = append(deactivated, user)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "deactivating users")
+	}
+
+	return sortUsers(deactivated), nil
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// DeleteUsers takes the login mappings of the users with them and refuses
+// users still referenced by pull requests, as the foreign keys of Postgres do.
+func (s *Storage) DeleteUsers(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for prID, pr := range state.PullRequests {
+			if slices.Contains(ids, pr.AuthorID) ||
+				slices.ContainsFunc(pr.ReviewersIDs, func(id string) bool { return slices.Contains(ids, id) }) {
+				return errors.Errorf("pull request %q references the deleted users", prID)
+			}
+		}
+
+		for _, id := range ids {
+			delete(state.Users, id)
+		}
+
+		for key, mapping := range state.LoginMappings {
+			if slices.Contains(ids, mapping.UserID) {
+				delete(state.LoginMappings, key)
+			}
+		}
+
+		for adminID, admin := range state.Admins {
+			if admin.UserID != nil && slices.Contains(ids, *admin.UserID) {
+				admin.UserID = nil
+				state.Admins[adminID] = admin
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "deleting users")
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetUsersByIDs(ctx context.Context, ids []string) ([]model.User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	fetched := make([]model.User, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, id := range ids {
+			if user, ok := state.Users[id]; ok {
+				fetched = append(fetched, user)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading users")
+	}
+
+	return sortUsers(fetched), nil
+}
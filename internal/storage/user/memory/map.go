@@ -0,0 +1,37 @@
+package user
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func sortUsers(users []model.User) []model.User {
+	slices.SortFunc(users, func(a, b model.User) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return users
+}
+
+func teamNameOrEmpty(teamName *string) string {
+	if teamName == nil {
+		return ""
+	}
+
+	return *teamName
+}
+
+func checkTeam(state *memory.State, teamName string) error {
+	if teamName == "" {
+		return nil
+	}
+	if _, ok := state.Teams[teamName]; !ok {
+		return errors.Wrapf(memory.ErrForeignKeyViolation, "team %q", teamName)
+	}
+
+	return nil
+}
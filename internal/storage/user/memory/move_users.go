@@ -0,0 +1,38 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// MoveUsers moves every member of fromTeam into toTeam.
+// A nil toTeam leaves the users without a team.
+func (s *Storage) MoveUsers(ctx context.Context, fromTeam string, toTeam *string) ([]model.User, error) {
+	moved := make([]model.User, 0)
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if err := checkTeam(state, teamNameOrEmpty(toTeam)); err != nil {
+			return err
+		}
+
+		for id, user := range state.Users {
+			if user.TeamName != fromTeam {
+				continue
+			}
+
+			user.TeamName = teamNameOrEmpty(toTeam)
+			state.Users[id] = user
+			moved = append(moved, user)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "moving users")
+	}
+
+	return sortUsers(moved), nil
+}
@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// SaveUsers only moves the already existing users to their new team,
+// like the ON CONFLICT clause of the Postgres storage.
+func (s *Storage) SaveUsers(ctx context.Context, users []model.User) ([]model.User, error) {
+	if len(users) == 0 {
+		return nil, nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for _, user := range users {
+			if err := checkTeam(state, user.TeamName); err != nil {
+				return err
+			}
+
+			if existing, ok := state.Users[user.ID]; ok {
+				existing.TeamName = user.TeamName
+				state.Users[user.ID] = existing
+				continue
+			}
+
+			state.Users[user.ID] = user
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "saving users")
+	}
+
+	return users, nil
+}
@@ -0,0 +1,34 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateActivity(ctx context.Context, id string, activity bool) (model.User, error) {
+	var updated model.User
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		user, ok := state.Users[id]
+		if !ok {
+			return model.ErrUserDoesNotExist
+		}
+
+		user.IsActive = activity
+		state.Users[id] = user
+		updated = user
+
+		return nil
+	})
+	if errors.Is(err, model.ErrUserDoesNotExist) {
+		return model.User{}, model.ErrUserDoesNotExist
+	}
+	if err != nil {
+		return model.User{}, errors.Wrap(err, "updating user")
+	}
+
+	return updated, nil
+}
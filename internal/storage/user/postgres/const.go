@@ -3,10 +3,11 @@ package user
 const (
 	tableName = "users"
 
-	columnID       = "id"
-	columnName     = "name"
-	columnTeamName = "team_name"
-	columnIsActive = "is_active"
+	columnID        = "id"
+	columnName      = "name"
+	columnTeamName  = "team_name"
+	columnIsActive  = "is_active"
+	columnSeniority = "seniority"
 )
 
 var allColumns = []string{
@@ -14,4 +15,5 @@ var allColumns = []string{
 	columnName,
 	columnTeamName,
 	columnIsActive,
+	columnSeniority,
 }
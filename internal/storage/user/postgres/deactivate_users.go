@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/user/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeactivateUsers(
+	ctx context.Context,
+	teamName *string,
+	ids []string,
+) ([]model.User, error) {
+	if len(ids) == 0 {
+		ids = nil
+	}
+
+	sql, args, err := squirrel.
+		Expr(`
+			UPDATE users
+			SET is_active = FALSE
+			WHERE ($1::text IS NULL OR team_name = $1)
+			  AND ($2::text[] IS NULL OR id = ANY($2))
+			RETURNING id, name, team_name, is_active, seniority`,
+			teamName, ids).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.User])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedUsers := collection.Map(fetched, mapDBUserToDomain)
+
+	return mappedUsers, nil
+}
@@ -0,0 +1,31 @@
+package user
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+)
+
+// DeleteUsers fails while the users are referenced by pull requests.
+func (s *Storage) DeleteUsers(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	sql, args, err := squirrel.
+		Delete(tableName).
+		Where(squirrel.Eq{columnID: ids}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
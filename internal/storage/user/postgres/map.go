@@ -6,10 +6,16 @@ import (
 )
 
 func mapDBUserToDomain(user dbmodel.User) model.User {
+	var teamName string
+	if user.TeamName != nil {
+		teamName = *user.TeamName
+	}
+
 	return model.User{
-		ID:       user.ID,
-		Name:     user.Name,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		ID:        user.ID,
+		Name:      user.Name,
+		TeamName:  teamName,
+		IsActive:  user.IsActive,
+		Seniority: user.Seniority,
 	}
 }
@@ -0,0 +1,42 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/user/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// MoveUsers moves every member of fromTeam into toTeam.
+// A nil toTeam leaves the users without a team.
+func (s *Storage) MoveUsers(ctx context.Context, fromTeam string, toTeam *string) ([]model.User, error) {
+	sql, args, err := squirrel.
+		Update(tableName).
+		Set(columnTeamName, toTeam).
+		Where(squirrel.Eq{columnTeamName: fromTeam}).
+		Suffix("RETURNING " + strings.Join(allColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.User])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedUsers := collection.Map(fetched, mapDBUserToDomain)
+
+	return mappedUsers, nil
+}
@@ -18,19 +18,21 @@ func (s *Storage) SaveUsers(ctx context.Context, users []model.User) ([]model.Us
 	names := collection.Map(users, model.User.GetName)
 	teamNames := collection.Map(users, model.User.GetTeamName)
 	actives := collection.Map(users, model.User.GetIsActive)
+	seniorities := collection.Map(users, model.User.GetSeniority)
 
 	sql, args, err := squirrel.
 		Expr(`
-            INSERT INTO users (id, name, team_name, is_active)
+            INSERT INTO users (id, name, team_name, is_active, seniority)
             SELECT * FROM unnest(
                 $1::text[],
                 $2::text[],
                 $3::text[],
-                $4::bool[]
-            ) AS t(id, name, team_name, is_active)
+                $4::bool[],
+                $5::int[]
+            ) AS t(id, name, team_name, is_active, seniority)
             ON CONFLICT (id) DO UPDATE
             SET team_name = EXCLUDED.team_name`,
-			ids, names, teamNames, actives).
+			ids, names, teamNames, actives, seniorities).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrap(err, "building sql")
@@ -31,6 +31,7 @@ func (s *Storage) UpdateActivity(ctx context.Context, id string, activity bool)
 			&dbUser.Name,
 			&dbUser.TeamName,
 			&dbUser.IsActive,
+			&dbUser.Seniority,
 		)
 	if errors.Is(err, db.ErrNoRows) {
 		return model.User{}, model.ErrUserDoesNotExist
@@ -0,0 +1,18 @@
+package dbmodel
+
+import "time"
+
+type Delivery struct {
+	ID            int64      `db:"id"`
+	WebhookID     int64      `db:"webhook_id"`
+	URL           string     `db:"url"`
+	Secret        string     `db:"secret"`
+	EventType     string     `db:"event_type"`
+	Payload       string     `db:"payload"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	LastError     *string    `db:"last_error"`
+	DeliveredAt   *time.Time `db:"delivered_at"`
+	FailedAt      *time.Time `db:"failed_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+}
@@ -0,0 +1,13 @@
+package dbmodel
+
+import "time"
+
+type Subscription struct {
+	PullRequestID    string    `db:"pull_request_id"`
+	TeamName         string    `db:"team_name"`
+	WebhookID        int64     `db:"webhook_id"`
+	WebhookURL       string    `db:"webhook_url"`
+	WebhookTeamName  *string   `db:"webhook_team_name"`
+	WebhookSecret    string    `db:"webhook_secret"`
+	WebhookCreatedAt time.Time `db:"webhook_created_at"`
+}
@@ -0,0 +1,11 @@
+package dbmodel
+
+import "time"
+
+type Webhook struct {
+	ID        int64     `db:"id"`
+	URL       string    `db:"url"`
+	TeamName  *string   `db:"team_name"`
+	Secret    string    `db:"secret"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// ClaimPendingDeliveries selects due outbox messages and moves their
+// NextAttemptAt to leaseUntil, so that they are not selected again
+// while being sent.
+func (s *Storage) ClaimPendingDeliveries(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]model.WebhookDelivery, error) {
+	pending := make([]model.WebhookDelivery, 0)
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for _, delivery := range state.Deliveries {
+			if delivery.DeliveredAt != nil || delivery.FailedAt != nil || delivery.NextAttemptAt.After(now) {
+				continue
+			}
+
+			pending = append(pending, delivery)
+		}
+
+		slices.SortFunc(pending, func(a, b model.WebhookDelivery) int {
+			return cmp.Or(a.NextAttemptAt.Compare(b.NextAttemptAt), cmp.Compare(a.ID, b.ID))
+		})
+
+		if len(pending) > limit {
+			pending = pending[:limit]
+		}
+
+		for i, delivery := range pending {
+			delivery.NextAttemptAt = leaseUntil
+			state.Deliveries[delivery.ID] = delivery
+
+			webhook := state.Webhooks[delivery.WebhookID]
+			delivery.URL = webhook.URL
+			delivery.Secret = webhook.Secret
+			delivery.Payload = slices.Clone(delivery.Payload)
+			pending[i] = delivery
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "claiming deliveries")
+	}
+
+	return pending, nil
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteWebhook(ctx context.Context, id int64) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if _, ok := state.Webhooks[id]; !ok {
+			return model.ErrWebhookDoesNotExist
+		}
+
+		state.DeleteWebhook(id)
+
+		return nil
+	})
+	if errors.Is(err, model.ErrWebhookDoesNotExist) {
+		return model.ErrWebhookDoesNotExist
+	}
+	if err != nil {
+		return errors.Wrap(err, "deleting webhook")
+	}
+
+	return nil
+}
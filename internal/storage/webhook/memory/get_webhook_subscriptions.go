@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+// GetWebhookSubscriptions matches pull requests with global webhooks
+// and webhooks of their author's team.
+func (s *Storage) GetWebhookSubscriptions(
+	ctx context.Context,
+	pullRequestIDs []string,
+) ([]model.WebhookSubscription, error) {
+	subscriptions := make([]model.WebhookSubscription, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, id := range pullRequestIDs {
+			pr, ok := state.PullRequests[id]
+			if !ok {
+				continue
+			}
+
+			author, ok := state.Users[pr.AuthorID]
+			if !ok {
+				continue
+			}
+
+			for _, webhook := range state.Webhooks {
+				if webhook.TeamName != nil && *webhook.TeamName != author.TeamName {
+					continue
+				}
+
+				subscriptions = append(subscriptions, model.WebhookSubscription{
+					PullRequestID: pr.ID,
+					TeamName:      author.TeamName,
+					Webhook:       webhook,
+				})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading webhook subscriptions")
+	}
+
+	slices.SortFunc(subscriptions, func(a, b model.WebhookSubscription) int {
+		return cmp.Or(cmp.Compare(a.PullRequestID, b.PullRequestID), cmp.Compare(a.Webhook.ID, b.Webhook.ID))
+	})
+
+	// A pull request requested twice still matches each webhook once.
+	subscriptions = slices.CompactFunc(subscriptions, func(a, b model.WebhookSubscription) bool {
+		return a.PullRequestID == b.PullRequestID && a.Webhook.ID == b.Webhook.ID
+	})
+
+	return subscriptions, nil
+}
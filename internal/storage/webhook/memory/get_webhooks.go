@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) GetWebhooks(ctx context.Context) ([]model.Webhook, error) {
+	fetched := make([]model.Webhook, 0)
+
+	err := s.db.Read(ctx, func(state *memory.State) error {
+		for _, webhook := range state.Webhooks {
+			fetched = append(fetched, webhook)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "reading webhooks")
+	}
+
+	slices.SortFunc(fetched, func(a, b model.Webhook) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return fetched, nil
+}
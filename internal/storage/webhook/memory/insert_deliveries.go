@@ -0,0 +1,41 @@
+package webhook
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		for _, delivery := range deliveries {
+			if _, ok := state.Webhooks[delivery.WebhookID]; !ok {
+				return errors.Wrapf(memory.ErrForeignKeyViolation, "webhook %d", delivery.WebhookID)
+			}
+
+			state.LastDeliveryID++
+			state.Deliveries[state.LastDeliveryID] = model.WebhookDelivery{
+				ID:            state.LastDeliveryID,
+				WebhookID:     delivery.WebhookID,
+				EventType:     delivery.EventType,
+				Payload:       slices.Clone(delivery.Payload),
+				NextAttemptAt: delivery.NextAttemptAt,
+				CreatedAt:     delivery.CreatedAt,
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "inserting deliveries")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	var inserted model.Webhook
+
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		if webhook.TeamName != nil {
+			if _, ok := state.Teams[*webhook.TeamName]; !ok {
+				return model.ErrTeamDoesNotExist
+			}
+		}
+
+		state.LastWebhookID++
+		inserted = webhook
+		inserted.ID = state.LastWebhookID
+		state.Webhooks[inserted.ID] = inserted
+
+		return nil
+	})
+	if errors.Is(err, model.ErrTeamDoesNotExist) {
+		return model.Webhook{}, model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return model.Webhook{}, errors.Wrap(err, "inserting webhook")
+	}
+
+	return inserted, nil
+}
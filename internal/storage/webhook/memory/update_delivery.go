@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/memory"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	err := s.db.Write(ctx, func(state *memory.State) error {
+		stored, ok := state.Deliveries[delivery.ID]
+		if !ok {
+			return nil
+		}
+
+		stored.Attempts = delivery.Attempts
+		stored.NextAttemptAt = delivery.NextAttemptAt
+		stored.LastError = delivery.LastError
+		stored.DeliveredAt = delivery.DeliveredAt
+		stored.FailedAt = delivery.FailedAt
+		state.Deliveries[delivery.ID] = stored
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "updating delivery")
+	}
+
+	return nil
+}
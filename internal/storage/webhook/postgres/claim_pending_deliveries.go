@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/webhook/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// ClaimPendingDeliveries selects due outbox messages and moves their
+// next_attempt_at to leaseUntil, so that other dispatchers skip them while
+// they are being sent. A message whose result is never recorded becomes due
+// again once the lease expires.
+func (s *Storage) ClaimPendingDeliveries(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]model.WebhookDelivery, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			WITH due AS (
+				SELECT id
+				FROM webhook_outbox
+				WHERE delivered_at IS NULL
+				  AND failed_at IS NULL
+				  AND next_attempt_at <= $1
+				ORDER BY next_attempt_at, id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			), claimed AS (
+				UPDATE webhook_outbox o
+				SET next_attempt_at = $3
+				FROM due
+				WHERE o.id = due.id
+				RETURNING o.*
+			)
+			SELECT
+				c.id,
+				c.webhook_id,
+				w.url,
+				w.secret,
+				c.event_type,
+				c.payload::text AS payload,
+				c.attempts,
+				c.next_attempt_at,
+				c.last_error,
+				c.delivered_at,
+				c.failed_at,
+				c.created_at
+			FROM claimed c
+			JOIN webhooks w ON w.id = c.webhook_id
+			ORDER BY c.id`, now, limit, leaseUntil).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.Delivery])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedDeliveries, err := collection.MapWithError(fetched, mapDBDeliveryToDomainWebhookDelivery)
+	if err != nil {
+		return nil, errors.Wrap(err, "mapping deliveries")
+	}
+
+	return mappedDeliveries, nil
+}
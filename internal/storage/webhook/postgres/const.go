@@ -0,0 +1,28 @@
+package webhook
+
+const (
+	webhookTableName = "webhooks"
+
+	webhookColumnID        = "id"
+	webhookColumnURL       = "url"
+	webhookColumnTeamName  = "team_name"
+	webhookColumnSecret    = "secret"
+	webhookColumnCreatedAt = "created_at"
+
+	outboxTableName = "webhook_outbox"
+
+	outboxColumnID            = "id"
+	outboxColumnAttempts      = "attempts"
+	outboxColumnNextAttemptAt = "next_attempt_at"
+	outboxColumnLastError     = "last_error"
+	outboxColumnDeliveredAt   = "delivered_at"
+	outboxColumnFailedAt      = "failed_at"
+)
+
+var webhookColumns = []string{
+	webhookColumnID,
+	webhookColumnURL,
+	webhookColumnTeamName,
+	webhookColumnSecret,
+	webhookColumnCreatedAt,
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) DeleteWebhook(ctx context.Context, id int64) error {
+	sql, args, err := squirrel.
+		Delete(webhookTableName).
+		Where(squirrel.Eq{webhookColumnID: id}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	tag, err := s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrWebhookDoesNotExist
+	}
+
+	return nil
+}
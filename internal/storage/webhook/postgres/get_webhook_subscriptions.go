@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/webhook/dbmodel"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+// GetWebhookSubscriptions matches pull requests with global webhooks
+// and webhooks of their author's team.
+func (s *Storage) GetWebhookSubscriptions(
+	ctx context.Context,
+	pullRequestIDs []string,
+) ([]model.WebhookSubscription, error) {
+	sql, args, err := squirrel.
+		Expr(`
+			SELECT
+				pr.id                     AS pull_request_id,
+				COALESCE(u.team_name, '') AS team_name,
+				w.id                      AS webhook_id,
+				w.url                     AS webhook_url,
+				w.team_name               AS webhook_team_name,
+				w.secret                  AS webhook_secret,
+				w.created_at              AS webhook_created_at
+			FROM pull_requests pr
+			JOIN users u ON u.id = pr.author_id
+			JOIN webhooks w ON w.team_name IS NULL OR w.team_name = u.team_name
+			WHERE pr.id = ANY($1::text[])
+			ORDER BY pr.id, w.id`, pullRequestIDs).
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.Subscription])
+	if err != nil {
+		return nil, errors.Wrap(err, "collecting rows")
+	}
+
+	mappedSubscriptions := collection.Map(fetched, mapDBSubscriptionToDomainWebhookSubscription)
+
+	return mappedSubscriptions, nil
+}
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/pkg/utils/collection"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	webhookIDs := collection.Map(deliveries, model.WebhookDelivery.GetWebhookID)
+	eventTypes := collection.Map(deliveries, func(delivery model.WebhookDelivery) string {
+		return delivery.EventType.String()
+	})
+	payloads := collection.Map(deliveries, model.WebhookDelivery.GetPayload)
+	nextAttemptAt := collection.Map(deliveries, model.WebhookDelivery.GetNextAttemptAt)
+	createdAt := collection.Map(deliveries, model.WebhookDelivery.GetCreatedAt)
+
+	sql, args, err := squirrel.
+		Expr(`
+			INSERT INTO webhook_outbox (
+				webhook_id,
+				event_type,
+				payload,
+				next_attempt_at,
+				created_at
+			)
+			SELECT
+				t.webhook_id,
+				t.event_type,
+				t.payload::jsonb,
+				t.next_attempt_at,
+				t.created_at
+			FROM unnest(
+				$1::bigint[],
+				$2::text[],
+				$3::text[],
+				$4::timestamptz[],
+				$5::timestamptz[]
+			) AS t(webhook_id, event_type, payload, next_attempt_at, created_at)`,
+			webhookIDs, eventTypes, payloads, nextAttemptAt, createdAt).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
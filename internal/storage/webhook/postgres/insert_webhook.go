@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/common/constraint"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/webhook/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) InsertWebhook(ctx context.Context, webhook model.Webhook) (model.Webhook, error) {
+	sql, args, err := squirrel.
+		Insert(webhookTableName).
+		Columns(
+			webhookColumnURL,
+			webhookColumnTeamName,
+			webhookColumnSecret,
+			webhookColumnCreatedAt,
+		).
+		Values(webhook.URL, webhook.TeamName, webhook.Secret, webhook.CreatedAt).
+		Suffix("RETURNING " + strings.Join(webhookColumns, ", ")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return model.Webhook{}, errors.Wrap(err, "building sql")
+	}
+
+	rows, err := s.getter.DefaultTrOrDB(ctx, s.pool).Query(ctx, sql, args...)
+	if err != nil {
+		return model.Webhook{}, errors.Wrap(err, "querying sql")
+	}
+
+	fetched, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByName[dbmodel.Webhook])
+	if constraint.IsForeignKeyViolation(err) {
+		return model.Webhook{}, model.ErrTeamDoesNotExist
+	}
+	if err != nil {
+		return model.Webhook{}, errors.Wrap(err, "collecting row")
+	}
+
+	mappedWebhook := mapDBWebhookToDomainWebhook(fetched)
+
+	return mappedWebhook, nil
+}
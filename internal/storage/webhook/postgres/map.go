@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/hizu77/avito-autumn-2025/internal/storage/webhook/dbmodel"
+	"github.com/pkg/errors"
+)
+
+func mapDBWebhookToDomainWebhook(webhook dbmodel.Webhook) model.Webhook {
+	return model.Webhook{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		TeamName:  webhook.TeamName,
+		Secret:    webhook.Secret,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func mapDBSubscriptionToDomainWebhookSubscription(subscription dbmodel.Subscription) model.WebhookSubscription {
+	return model.WebhookSubscription{
+		PullRequestID: subscription.PullRequestID,
+		TeamName:      subscription.TeamName,
+		Webhook: model.Webhook{
+			ID:        subscription.WebhookID,
+			URL:       subscription.WebhookURL,
+			TeamName:  subscription.WebhookTeamName,
+			Secret:    subscription.WebhookSecret,
+			CreatedAt: subscription.WebhookCreatedAt,
+		},
+	}
+}
+
+func mapDBDeliveryToDomainWebhookDelivery(delivery dbmodel.Delivery) (model.WebhookDelivery, error) {
+	eventType, err := model.ParseAssignmentEventType(delivery.EventType)
+	if err != nil {
+		return model.WebhookDelivery{}, errors.Wrap(err, "parsing event type")
+	}
+
+	return model.WebhookDelivery{
+		ID:            delivery.ID,
+		WebhookID:     delivery.WebhookID,
+		URL:           delivery.URL,
+		Secret:        delivery.Secret,
+		EventType:     eventType,
+		Payload:       []byte(delivery.Payload),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		LastError:     delivery.LastError,
+		DeliveredAt:   delivery.DeliveredAt,
+		FailedAt:      delivery.FailedAt,
+		CreatedAt:     delivery.CreatedAt,
+	}, nil
+}
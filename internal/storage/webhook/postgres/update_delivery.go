@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/hizu77/avito-autumn-2025/internal/model"
+	"github.com/pkg/errors"
+)
+
+func (s *Storage) UpdateDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	sql, args, err := squirrel.
+		Update(outboxTableName).
+		Set(outboxColumnAttempts, delivery.Attempts).
+		Set(outboxColumnNextAttemptAt, delivery.NextAttemptAt).
+		Set(outboxColumnLastError, delivery.LastError).
+		Set(outboxColumnDeliveredAt, delivery.DeliveredAt).
+		Set(outboxColumnFailedAt, delivery.FailedAt).
+		Where(squirrel.Eq{outboxColumnID: delivery.ID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "building sql")
+	}
+
+	_, err = s.getter.DefaultTrOrDB(ctx, s.pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return errors.Wrap(err, "executing sql")
+	}
+
+	return nil
+}
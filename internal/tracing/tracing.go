@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/hizu77/avito-autumn-2025"
+
+// Attributes shared by the spans of services.
+const (
+	PullRequestID = attribute.Key("pull_request.id")
+	TeamName      = attribute.Key("team.name")
+	UserID        = attribute.Key("user.id")
+	UserIDs       = attribute.Key("user.ids")
+	ReviewerIDs   = attribute.Key("pull_request.reviewer_ids")
+)
+
+// Start starts a span with the globally registered tracer provider,
+// which stays a no-op when tracing is disabled.
+func Start(
+	ctx context.Context,
+	name string,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+func recordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
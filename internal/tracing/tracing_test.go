@@ -0,0 +1,57 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hizu77/avito-autumn-2025/internal/api/httperr"
+	trmanager "github.com/hizu77/avito-autumn-2025/internal/mock/tr_manager"
+	"github.com/hizu77/avito-autumn-2025/internal/tracing"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMiddleware(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	manager := tracing.NewManager(trmanager.NewMockTrManager())
+
+	router := chi.NewRouter()
+	router.Use(tracing.Middleware)
+	router.Post("/pullRequest/reassign", func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracing.Start(r.Context(), "pullrequest.ReassignPullRequest", tracing.PullRequestID.String("pr-1"))
+		defer span.End()
+
+		err := manager.Do(ctx, func(context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+
+		httperr.WriteError(w, r, httperr.CodeNoCandidate)
+	})
+
+	router.ServeHTTP(
+		httptest.NewRecorder(),
+		httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", nil),
+	)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+
+	transaction, service, server := spans[0], spans[1], spans[2]
+	require.Equal(t, "transaction", transaction.Name())
+	require.Equal(t, "pullrequest.ReassignPullRequest", service.Name())
+	require.Equal(t, "POST /pullRequest/reassign", server.Name())
+
+	require.Equal(t, service.SpanContext().SpanID(), transaction.Parent().SpanID())
+	require.Equal(t, server.SpanContext().SpanID(), service.Parent().SpanID())
+	require.Contains(t, service.Attributes(), tracing.PullRequestID.String("pr-1"))
+	require.Contains(t, server.Attributes(), attribute.String("error.code", "NO_CANDIDATE"))
+}
@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+)
+
+// Manager wraps a trm.Manager to trace every transaction,
+// so that its duration is seen apart from the queries before it.
+type Manager struct {
+	manager trm.Manager
+}
+
+func NewManager(manager trm.Manager) *Manager {
+	return &Manager{manager: manager}
+}
+
+func (m *Manager) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, "transaction")
+	defer span.End()
+
+	err := m.manager.Do(ctx, fn)
+	recordError(span, err)
+
+	return err
+}
+
+func (m *Manager) DoWithSettings(
+	ctx context.Context,
+	settings trm.Settings,
+	fn func(ctx context.Context) error,
+) error {
+	ctx, span := Start(ctx, "transaction")
+	defer span.End()
+
+	err := m.manager.DoWithSettings(ctx, settings, fn)
+	recordError(span, err)
+
+	return err
+}
@@ -0,0 +1,247 @@
+// Package jwtkeys loads the keys access tokens are signed and verified with.
+//
+// With HS256 a single shared secret does both. With RS256 and EdDSA the
+// private signing key is loaded from a PEM file and public keys are published
+// as a JWKS, so other services can verify tokens without the secret.
+// Every asymmetric key gets a kid, the RFC 7638 thumbprint of its public part.
+// Public keys of previous signing keys stay accepted during a rotation.
+package jwtkeys
+
+import (
+	"crypto"
+	"encoding/base64"
+	"os"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/pkg/errors"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrKeyMismatch          = errors.New("key does not match the algorithm")
+)
+
+// SigningKey signs new access tokens.
+// Key is a []byte secret, an *rsa.PrivateKey or an ed25519.PrivateKey.
+// ID is empty for HS256.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Key       any
+}
+
+// Ring holds the signing key and every key tokens are verified with.
+type Ring struct {
+	signing      SigningKey
+	verification jwk.Set
+	public       jwk.Set
+	requireKeyID bool
+}
+
+// NewSymmetric creates a ring that signs and verifies with the shared secret.
+// Tokens carry no kid and nothing is published.
+func NewSymmetric(secret []byte) (*Ring, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("secret is empty")
+	}
+
+	key, err := jwk.FromRaw(secret)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating key")
+	}
+
+	if err = key.Set(jwk.AlgorithmKey, jwa.HS256); err != nil {
+		return nil, errors.Wrap(err, "setting algorithm")
+	}
+
+	verification := jwk.NewSet()
+	if err = verification.AddKey(key); err != nil {
+		return nil, errors.Wrap(err, "adding key")
+	}
+
+	return &Ring{
+		signing: SigningKey{
+			Algorithm: AlgorithmHS256,
+			Key:       secret,
+		},
+		verification: verification,
+		public:       jwk.NewSet(),
+	}, nil
+}
+
+// Load creates a ring that signs with the private key from signingKeyFile
+// and additionally accepts tokens signed by keys from verificationKeyFiles.
+// Verification keys may use another asymmetric algorithm than the signing one.
+func Load(algorithm string, signingKeyFile string, verificationKeyFiles []string) (*Ring, error) {
+	if algorithm != AlgorithmRS256 && algorithm != AlgorithmEdDSA {
+		return nil, errors.Wrap(ErrUnsupportedAlgorithm, algorithm)
+	}
+
+	privateKey, err := readKey(signingKeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading signing key")
+	}
+
+	asymmetricKey, ok := privateKey.(jwk.AsymmetricKey)
+	if !ok || !asymmetricKey.IsPrivate() {
+		return nil, errors.Errorf("signing key %s is not a private key", signingKeyFile)
+	}
+
+	keyAlgorithm, err := algorithmOf(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "detecting signing key algorithm")
+	}
+
+	if keyAlgorithm != algorithm {
+		return nil, errors.Wrapf(ErrKeyMismatch, "%s key for %s", keyAlgorithm, algorithm)
+	}
+
+	signingPublicKey, err := publicKey(privateKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "preparing signing public key")
+	}
+
+	public := jwk.NewSet()
+	if err = public.AddKey(signingPublicKey); err != nil {
+		return nil, errors.Wrap(err, "adding signing public key")
+	}
+
+	for _, file := range verificationKeyFiles {
+		if file == "" {
+			continue
+		}
+
+		key, err := readKey(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading verification key %s", file)
+		}
+
+		verificationKey, err := publicKey(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "preparing verification key %s", file)
+		}
+
+		if _, found := public.LookupKeyID(verificationKey.KeyID()); found {
+			continue
+		}
+
+		if err = public.AddKey(verificationKey); err != nil {
+			return nil, errors.Wrapf(err, "adding verification key %s", file)
+		}
+	}
+
+	var rawPrivateKey any
+	if err = privateKey.Raw(&rawPrivateKey); err != nil {
+		return nil, errors.Wrap(err, "exporting signing key")
+	}
+
+	return &Ring{
+		signing: SigningKey{
+			ID:        signingPublicKey.KeyID(),
+			Algorithm: algorithm,
+			Key:       rawPrivateKey,
+		},
+		verification: public,
+		public:       public,
+		requireKeyID: true,
+	}, nil
+}
+
+func (r *Ring) SigningKey() SigningKey {
+	return r.signing
+}
+
+// PublicKeys returns the keys to publish as a JWKS. It is empty for HS256.
+func (r *Ring) PublicKeys() jwk.Set {
+	return r.public
+}
+
+// Verify parses the token, checks its signature with the key of its kid
+// and validates the registered claims.
+func (r *Ring) Verify(token string) (jwt.Token, error) {
+	parsed, err := jwt.ParseString(
+		token,
+		jwt.WithKeySet(
+			r.verification,
+			jws.WithRequireKid(r.requireKeyID),
+			jws.WithUseDefault(!r.requireKeyID),
+		),
+		jwt.WithValidate(true),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "verifying token")
+	}
+
+	return parsed, nil
+}
+
+func readKey(file string) (jwk.Key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "reading file")
+	}
+
+	key, err := jwk.ParseKey(data, jwk.WithPEM(true))
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing pem")
+	}
+
+	return key, nil
+}
+
+// publicKey returns the public part of the key with its kid and algorithm set.
+func publicKey(key jwk.Key) (jwk.Key, error) {
+	public, err := jwk.PublicKeyOf(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting public key")
+	}
+
+	algorithm, err := algorithmOf(public)
+	if err != nil {
+		return nil, err
+	}
+
+	thumbprint, err := public.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, errors.Wrap(err, "computing thumbprint")
+	}
+
+	if err = public.Set(jwk.KeyIDKey, base64.RawURLEncoding.EncodeToString(thumbprint)); err != nil {
+		return nil, errors.Wrap(err, "setting kid")
+	}
+
+	if err = public.Set(jwk.AlgorithmKey, jwa.SignatureAlgorithm(algorithm)); err != nil {
+		return nil, errors.Wrap(err, "setting algorithm")
+	}
+
+	if err = public.Set(jwk.KeyUsageKey, jwk.ForSignature); err != nil {
+		return nil, errors.Wrap(err, "setting usage")
+	}
+
+	return public, nil
+}
+
+func algorithmOf(key jwk.Key) (string, error) {
+	switch key.KeyType() {
+	case jwa.RSA:
+		return AlgorithmRS256, nil
+	case jwa.OKP:
+		okp, ok := key.(interface {
+			Crv() jwa.EllipticCurveAlgorithm
+		})
+		if ok && okp.Crv() == jwa.Ed25519 {
+			return AlgorithmEdDSA, nil
+		}
+	}
+
+	return "", errors.Wrapf(ErrUnsupportedAlgorithm, "key type %s", key.KeyType())
+}
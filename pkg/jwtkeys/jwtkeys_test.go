@@ -0,0 +1,145 @@
+package jwtkeys_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/hizu77/avito-autumn-2025/pkg/jwtkeys"
+	"github.com/stretchr/testify/require"
+)
+
+func writePrivateKey(t *testing.T, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return writePEM(t, "PRIVATE KEY", der)
+}
+
+func writePublicKey(t *testing.T, key any) string {
+	t.Helper()
+
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+
+	return writePEM(t, "PUBLIC KEY", der)
+}
+
+func writePEM(t *testing.T, blockType string, der []byte) string {
+	t.Helper()
+
+	file, err := os.CreateTemp(t.TempDir(), "*.pem")
+	require.NoError(t, err)
+	defer file.Close()
+
+	require.NoError(t, pem.Encode(file, &pem.Block{Type: blockType, Bytes: der}))
+
+	return filepath.Clean(file.Name())
+}
+
+func sign(t *testing.T, key jwtkeys.SigningKey) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), jwt.MapClaims{
+		"admin_id": "admin",
+		"exp":      time.Now().Add(time.Hour).Unix(),
+	})
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+
+	signed, err := token.SignedString(key.Key)
+	require.NoError(t, err)
+
+	return signed
+}
+
+func TestSymmetric(t *testing.T) {
+	t.Parallel()
+
+	ring, err := jwtkeys.NewSymmetric([]byte("secret"))
+	require.NoError(t, err)
+	require.Empty(t, ring.SigningKey().ID)
+	require.Zero(t, ring.PublicKeys().Len())
+
+	token, err := ring.Verify(sign(t, ring.SigningKey()))
+	require.NoError(t, err)
+	claim, ok := token.Get("admin_id")
+	require.True(t, ok)
+	require.Equal(t, "admin", claim)
+
+	other, err := jwtkeys.NewSymmetric([]byte("other"))
+	require.NoError(t, err)
+	_, err = ring.Verify(sign(t, other.SigningKey()))
+	require.Error(t, err)
+
+	_, err = jwtkeys.NewSymmetric(nil)
+	require.Error(t, err)
+}
+
+func TestRotation(t *testing.T) {
+	t.Parallel()
+
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, newKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	oldRing, err := jwtkeys.Load(jwtkeys.AlgorithmRS256, writePrivateKey(t, oldKey), nil)
+	require.NoError(t, err)
+	oldToken := sign(t, oldRing.SigningKey())
+
+	newRing, err := jwtkeys.Load(
+		jwtkeys.AlgorithmEdDSA,
+		writePrivateKey(t, newKey),
+		[]string{writePublicKey(t, &oldKey.PublicKey), ""},
+	)
+	require.NoError(t, err)
+	require.NotEqual(t, oldRing.SigningKey().ID, newRing.SigningKey().ID)
+	require.Equal(t, 2, newRing.PublicKeys().Len())
+
+	_, err = newRing.Verify(oldToken)
+	require.NoError(t, err)
+	_, err = newRing.Verify(sign(t, newRing.SigningKey()))
+	require.NoError(t, err)
+
+	rotatedRing, err := jwtkeys.Load(jwtkeys.AlgorithmEdDSA, writePrivateKey(t, newKey), nil)
+	require.NoError(t, err)
+	_, err = rotatedRing.Verify(oldToken)
+	require.Error(t, err)
+
+	published, err := json.Marshal(newRing.PublicKeys())
+	require.NoError(t, err)
+	require.NotContains(t, string(published), `"d"`)
+	require.Contains(t, string(published), newRing.SigningKey().ID)
+}
+
+func TestLoadErrors(t *testing.T) {
+	t.Parallel()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edFile := writePrivateKey(t, edKey)
+
+	_, err = jwtkeys.Load(jwtkeys.AlgorithmRS256, edFile, nil)
+	require.ErrorIs(t, err, jwtkeys.ErrKeyMismatch)
+
+	_, err = jwtkeys.Load("ES256", edFile, nil)
+	require.ErrorIs(t, err, jwtkeys.ErrUnsupportedAlgorithm)
+
+	_, err = jwtkeys.Load(jwtkeys.AlgorithmEdDSA, writePublicKey(t, edKey.Public()), nil)
+	require.Error(t, err)
+
+	_, err = jwtkeys.Load(jwtkeys.AlgorithmEdDSA, filepath.Join(t.TempDir(), "missing.pem"), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package migrator
+
+import (
+	"bytes"
+	"hash/crc32"
+)
+
+var byteOrderMark = []byte("\xef\xbb\xbf")
+
+// checksum computes the checksum Flyway stores in its history table:
+// CRC32 over the lines of the script without line breaks and BOM.
+func checksum(content []byte) int32 {
+	content = bytes.TrimPrefix(content, byteOrderMark)
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	content = bytes.ReplaceAll(content, []byte("\r"), []byte("\n"))
+
+	hash := crc32.NewIEEE()
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		_, _ = hash.Write(line)
+	}
+
+	// Flyway keeps the unsigned CRC32 in a signed integer column.
+	return int32(hash.Sum32())
+}
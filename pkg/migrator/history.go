@@ -0,0 +1,109 @@
+package migrator
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	historyTypeSQL      = "SQL"
+	historyTypeBaseline = "BASELINE"
+)
+
+// The history table is created the same way as by Flyway,
+// so that either of them can continue after the other.
+const createHistoryTable = `
+CREATE TABLE IF NOT EXISTS flyway_schema_history (
+    installed_rank INT NOT NULL,
+    version        VARCHAR(50),
+    description    VARCHAR(200) NOT NULL,
+    type           VARCHAR(20) NOT NULL,
+    script         VARCHAR(1000) NOT NULL,
+    checksum       INTEGER,
+    installed_by   VARCHAR(100) NOT NULL,
+    installed_on   TIMESTAMP NOT NULL DEFAULT now(),
+    execution_time INTEGER NOT NULL,
+    success        BOOLEAN NOT NULL,
+    CONSTRAINT flyway_schema_history_pk PRIMARY KEY (installed_rank)
+);
+
+CREATE INDEX IF NOT EXISTS flyway_schema_history_s_idx ON flyway_schema_history (success);
+`
+
+const historyExists = `
+SELECT to_regclass('flyway_schema_history') IS NOT NULL
+`
+
+const selectHistory = `
+SELECT installed_rank, version, description, type, script, checksum, installed_on, success
+FROM flyway_schema_history
+ORDER BY installed_rank
+`
+
+const insertHistory = `
+INSERT INTO flyway_schema_history (
+    installed_rank, version, description, type, script, checksum, installed_by, execution_time, success
+)
+SELECT COALESCE(MAX(installed_rank), 0) + 1, $1, $2, $3, $4, $5, current_user, $6, TRUE
+FROM flyway_schema_history
+`
+
+const deleteHistory = `
+DELETE FROM flyway_schema_history
+WHERE installed_rank = $1
+`
+
+type historyRow struct {
+	InstalledRank int
+	Version       *string
+	Description   string
+	Type          string
+	Script        string
+	Checksum      *int32
+	InstalledOn   time.Time
+	Success       bool
+}
+
+func getHistory(ctx context.Context, conn *pgx.Conn) ([]historyRow, error) {
+	rows, err := conn.Query(ctx, selectHistory)
+	if err != nil {
+		return nil, errors.Wrap(err, "query history")
+	}
+
+	history, err := pgx.CollectRows(rows, pgx.RowToStructByPos[historyRow])
+	if err != nil {
+		return nil, errors.Wrap(err, "collect history")
+	}
+
+	return history, nil
+}
+
+func insertHistoryRow(
+	ctx context.Context,
+	tx pgx.Tx,
+	migration Migration,
+	executionTime time.Duration,
+) error {
+	_, err := tx.Exec(
+		ctx,
+		insertHistory,
+		strconv.Itoa(migration.Version),
+		migration.Description,
+		historyTypeSQL,
+		migration.Script,
+		migration.Checksum,
+		executionTime.Milliseconds(),
+	)
+
+	return errors.Wrap(err, "insert history")
+}
+
+func deleteHistoryRow(ctx context.Context, tx pgx.Tx, installedRank int) error {
+	_, err := tx.Exec(ctx, deleteHistory, installedRank)
+
+	return errors.Wrap(err, "delete history")
+}
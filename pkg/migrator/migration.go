@@ -0,0 +1,111 @@
+package migrator
+
+import (
+	"io/fs"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	migrationName = regexp.MustCompile(`^V(\d+)__(.+)\.sql$`)
+	undoName      = regexp.MustCompile(`^U(\d+)__(.+)\.sql$`)
+)
+
+// Migration is a versioned Flyway migration, V<version>__<description>.sql,
+// with an optional undo script U<version>__<description>.sql.
+type Migration struct {
+	Version     int
+	Description string
+	Script      string
+	Checksum    int32
+	SQL         string
+	UndoScript  string
+	UndoSQL     string
+}
+
+// Load reads the migrations from the root of migrations
+// and their undo scripts from the root of undo.
+func Load(migrations fs.FS, undo fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "read migrations")
+	}
+
+	byVersion := make(map[int]*Migration, len(entries))
+	for _, entry := range entries {
+		version, description, err := parseName(migrationName, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := byVersion[version]; ok {
+			return nil, errors.Errorf("duplicate migration version %d", version)
+		}
+
+		content, err := fs.ReadFile(migrations, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "read migration %q", entry.Name())
+		}
+
+		byVersion[version] = &Migration{
+			Version:     version,
+			Description: description,
+			Script:      entry.Name(),
+			Checksum:    checksum(content),
+			SQL:         string(content),
+		}
+	}
+
+	undoEntries, err := fs.ReadDir(undo, ".")
+	if err != nil {
+		return nil, errors.Wrap(err, "read undo migrations")
+	}
+
+	for _, entry := range undoEntries {
+		version, _, err := parseName(undoName, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			return nil, errors.Errorf("undo migration %q has no migration", entry.Name())
+		}
+
+		content, err := fs.ReadFile(undo, entry.Name())
+		if err != nil {
+			return nil, errors.Wrapf(err, "read undo migration %q", entry.Name())
+		}
+
+		migration.UndoScript = entry.Name()
+		migration.UndoSQL = string(content)
+	}
+
+	loaded := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		loaded = append(loaded, *migration)
+	}
+	slices.SortFunc(loaded, func(lhs, rhs Migration) int {
+		return lhs.Version - rhs.Version
+	})
+
+	return loaded, nil
+}
+
+func parseName(pattern *regexp.Regexp, name string) (int, string, error) {
+	match := pattern.FindStringSubmatch(name)
+	if match == nil {
+		return 0, "", errors.Errorf("unexpected migration name %q", name)
+	}
+
+	version, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, "", errors.Wrapf(err, "parse version of %q", name)
+	}
+
+	return version, strings.ReplaceAll(match[2], "_", " "), nil
+}
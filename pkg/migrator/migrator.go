@@ -0,0 +1,219 @@
+package migrator
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrFailedMigration  = errors.New("history has a failed migration")
+	ErrChecksumMismatch = errors.New("applied migration was changed")
+	ErrOutOfOrder       = errors.New("pending migration is older than the applied ones")
+	ErrNothingToUndo    = errors.New("no applied migration to undo")
+	ErrNoUndo           = errors.New("migration has no undo script")
+)
+
+// lockID keys the session advisory lock held while migrating,
+// so that instances started at the same time do not race.
+const lockID = 0x6d696772
+
+type State string
+
+const (
+	StatePending  State = "pending"
+	StateApplied  State = "applied"
+	StateBaseline State = "baseline"
+	StateFailed   State = "failed"
+	StateChanged  State = "changed"
+	// StateMissing is an applied migration without a script,
+	// e.g. one applied by a newer version of the service.
+	StateMissing State = "missing"
+)
+
+type Status struct {
+	Version     int
+	Description string
+	State       State
+	InstalledOn *time.Time
+}
+
+// Migrator applies migrations the way Flyway does and records them
+// in the Flyway history table, so the two can be used interchangeably.
+type Migrator struct {
+	pool       *pgxpool.Pool
+	migrations []Migration
+}
+
+func New(pool *pgxpool.Pool, migrations []Migration) *Migrator {
+	return &Migrator{
+		pool:       pool,
+		migrations: migrations,
+	}
+}
+
+// Up applies all pending migrations, each in its own transaction.
+// Like Flyway's validation it refuses to run when an applied migration
+// failed or was changed, or when a pending one is older than the applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		history, err := getHistory(ctx, conn)
+		if err != nil {
+			return errors.Wrap(err, "getting history")
+		}
+
+		statuses, err := resolve(m.migrations, history)
+		if err != nil {
+			return errors.Wrap(err, "resolving migrations")
+		}
+
+		pending, err := pendingMigrations(m.migrations, statuses)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range pending {
+			if err := apply(ctx, conn, migration); err != nil {
+				return errors.Wrapf(err, "applying migration %d", migration.Version)
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return applied, errors.Wrap(err, "migrating up")
+	}
+
+	return applied, nil
+}
+
+// Down reverts the last applied migration with its undo script.
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var undone Migration
+	err := m.withLock(ctx, func(conn *pgx.Conn) error {
+		history, err := getHistory(ctx, conn)
+		if err != nil {
+			return errors.Wrap(err, "getting history")
+		}
+
+		row, ok := lastApplied(history)
+		if !ok {
+			return ErrNothingToUndo
+		}
+
+		version, err := strconv.Atoi(*row.Version)
+		if err != nil {
+			return errors.Wrapf(err, "parse version %q", *row.Version)
+		}
+
+		index := slices.IndexFunc(m.migrations, func(migration Migration) bool {
+			return migration.Version == version
+		})
+		if index < 0 || m.migrations[index].UndoSQL == "" {
+			return errors.Wrapf(ErrNoUndo, "version %d", version)
+		}
+
+		undone = m.migrations[index]
+		if err := undo(ctx, conn, undone, row.InstalledRank); err != nil {
+			return errors.Wrapf(err, "undoing migration %d", version)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Migration{}, errors.Wrap(err, "migrating down")
+	}
+
+	return undone, nil
+}
+
+// Status reports the state of every known migration
+// without creating the history table.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "acquire connection")
+	}
+	defer conn.Release()
+
+	var exists bool
+	if err := conn.QueryRow(ctx, historyExists).Scan(&exists); err != nil {
+		return nil, errors.Wrap(err, "check history table")
+	}
+
+	var history []historyRow
+	if exists {
+		history, err = getHistory(ctx, conn.Conn())
+		if err != nil {
+			return nil, errors.Wrap(err, "getting history")
+		}
+	}
+
+	statuses, err := resolve(m.migrations, history)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving migrations")
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *pgx.Conn) error) error {
+	conn, err := m.pool.Acquire(ctx)
+	if err != nil {
+		return errors.Wrap(err, "acquire connection")
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", lockID); err != nil {
+		return errors.Wrap(err, "acquire migration lock")
+	}
+	defer func() {
+		_, _ = conn.Exec(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", lockID)
+	}()
+
+	if _, err := conn.Exec(ctx, createHistoryTable); err != nil {
+		return errors.Wrap(err, "create history table")
+	}
+
+	return fn(conn.Conn())
+}
+
+func apply(ctx context.Context, conn *pgx.Conn, migration Migration) error {
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		start := time.Now()
+		if _, err := tx.Exec(ctx, migration.SQL); err != nil {
+			return errors.Wrapf(err, "execute %s", migration.Script)
+		}
+
+		return insertHistoryRow(ctx, tx, migration, time.Since(start))
+	})
+}
+
+func undo(ctx context.Context, conn *pgx.Conn, migration Migration, installedRank int) error {
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, migration.UndoSQL); err != nil {
+			return errors.Wrapf(err, "execute %s", migration.UndoScript)
+		}
+
+		return deleteHistoryRow(ctx, tx, installedRank)
+	})
+}
+
+func lastApplied(history []historyRow) (historyRow, bool) {
+	for i := len(history) - 1; i >= 0; i-- {
+		row := history[i]
+		if row.Type == historyTypeSQL && row.Version != nil && row.Success {
+			return row, true
+		}
+	}
+
+	return historyRow{}, false
+}
@@ -0,0 +1,218 @@
+package migrator
+
+import (
+	"hash/crc32"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecksum(t *testing.T) {
+	t.Parallel()
+
+	want := int32(crc32.ChecksumIEEE([]byte("CREATE TABLE teams ();DROP TABLE teams;")))
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "lf", content: "CREATE TABLE teams ();\nDROP TABLE teams;\n"},
+		{name: "crlf", content: "CREATE TABLE teams ();\r\nDROP TABLE teams;\r\n"},
+		{name: "bom", content: "\xef\xbb\xbfCREATE TABLE teams ();\nDROP TABLE teams;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, want, checksum([]byte(tt.content)))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		migrations fstest.MapFS
+		undo       fstest.MapFS
+		want       []Migration
+		wantErr    bool
+	}{
+		{
+			name: "sorted by version with undo scripts",
+			migrations: fstest.MapFS{
+				"V10__add_index.sql":   {Data: []byte("CREATE INDEX;")},
+				"V2__create_teams.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			undo: fstest.MapFS{
+				"U2__create_teams.sql": {Data: []byte("DROP TABLE;")},
+			},
+			want: []Migration{
+				{
+					Version:     2,
+					Description: "create teams",
+					Script:      "V2__create_teams.sql",
+					Checksum:    checksum([]byte("CREATE TABLE;")),
+					SQL:         "CREATE TABLE;",
+					UndoScript:  "U2__create_teams.sql",
+					UndoSQL:     "DROP TABLE;",
+				},
+				{
+					Version:     10,
+					Description: "add index",
+					Script:      "V10__add_index.sql",
+					Checksum:    checksum([]byte("CREATE INDEX;")),
+					SQL:         "CREATE INDEX;",
+				},
+			},
+		},
+		{
+			name: "unexpected name",
+			migrations: fstest.MapFS{
+				"create_teams.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			undo:    fstest.MapFS{},
+			wantErr: true,
+		},
+		{
+			name: "duplicate version",
+			migrations: fstest.MapFS{
+				"V1__create_teams.sql":  {Data: []byte("CREATE TABLE;")},
+				"V01__create_users.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			undo:    fstest.MapFS{},
+			wantErr: true,
+		},
+		{
+			name: "undo without migration",
+			migrations: fstest.MapFS{
+				"V1__create_teams.sql": {Data: []byte("CREATE TABLE;")},
+			},
+			undo: fstest.MapFS{
+				"U2__create_users.sql": {Data: []byte("DROP TABLE;")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Load(tt.migrations, tt.undo)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPendingMigrations(t *testing.T) {
+	t.Parallel()
+
+	migrations := []Migration{
+		{Version: 1, Description: "create teams", Checksum: 11},
+		{Version: 2, Description: "create users", Checksum: 22},
+		{Version: 3, Description: "create admins", Checksum: 33},
+	}
+
+	tests := []struct {
+		name    string
+		history []historyRow
+		want    []int
+		wantErr error
+	}{
+		{
+			name: "empty history",
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "partially applied",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeSQL, "1", 11, true),
+				newHistoryRow(2, historyTypeSQL, "2", 22, true),
+			},
+			want: []int{3},
+		},
+		{
+			name: "baseline",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeBaseline, "2", 0, true),
+			},
+			want: []int{3},
+		},
+		{
+			name: "applied by a newer version",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeSQL, "1", 11, true),
+				newHistoryRow(2, historyTypeSQL, "2", 22, true),
+				newHistoryRow(3, historyTypeSQL, "3", 33, true),
+				newHistoryRow(4, historyTypeSQL, "4", 44, true),
+			},
+			want: []int{},
+		},
+		{
+			name: "changed migration",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeSQL, "1", 12, true),
+			},
+			wantErr: ErrChecksumMismatch,
+		},
+		{
+			name: "failed migration",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeSQL, "1", 11, false),
+			},
+			wantErr: ErrFailedMigration,
+		},
+		{
+			name: "out of order",
+			history: []historyRow{
+				newHistoryRow(1, historyTypeSQL, "1", 11, true),
+				newHistoryRow(2, historyTypeSQL, "3", 33, true),
+			},
+			wantErr: ErrOutOfOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			statuses, err := resolve(migrations, tt.history)
+			require.NoError(t, err)
+
+			pending, err := pendingMigrations(migrations, statuses)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			got := make([]int, 0, len(pending))
+			for _, migration := range pending {
+				got = append(got, migration.Version)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func newHistoryRow(rank int, rowType string, version string, checksum int32, success bool) historyRow {
+	return historyRow{
+		InstalledRank: rank,
+		Version:       &version,
+		Type:          rowType,
+		Checksum:      &checksum,
+		InstalledOn:   time.Now(),
+		Success:       success,
+	}
+}
@@ -0,0 +1,110 @@
+package migrator
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// resolve matches the migrations against the history table.
+// Rows of repeatable migrations have no version and are skipped.
+func resolve(migrations []Migration, history []historyRow) ([]Status, error) {
+	baseline := 0
+	applied := make(map[int]historyRow, len(history))
+	for _, row := range history {
+		if row.Version == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(*row.Version)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse version %q", *row.Version)
+		}
+
+		if row.Type == historyTypeBaseline {
+			baseline = max(baseline, version)
+			continue
+		}
+
+		applied[version] = row
+	}
+
+	statuses := make([]Status, 0, len(migrations))
+	for _, migration := range migrations {
+		status := Status{
+			Version:     migration.Version,
+			Description: migration.Description,
+			State:       StatePending,
+		}
+
+		row, ok := applied[migration.Version]
+		delete(applied, migration.Version)
+
+		switch {
+		case ok && !row.Success:
+			status.State = StateFailed
+			status.InstalledOn = &row.InstalledOn
+		case ok && (row.Checksum == nil || *row.Checksum != migration.Checksum):
+			status.State = StateChanged
+			status.InstalledOn = &row.InstalledOn
+		case ok:
+			status.State = StateApplied
+			status.InstalledOn = &row.InstalledOn
+		case migration.Version <= baseline:
+			status.State = StateBaseline
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	for version, row := range applied {
+		statuses = append(statuses, Status{
+			Version:     version,
+			Description: row.Description,
+			State:       StateMissing,
+			InstalledOn: &row.InstalledOn,
+		})
+	}
+
+	slices.SortFunc(statuses, func(lhs, rhs Status) int {
+		return lhs.Version - rhs.Version
+	})
+
+	return statuses, nil
+}
+
+// pendingMigrations returns the migrations to apply in order
+// or an error when the history does not allow to apply them.
+func pendingMigrations(migrations []Migration, statuses []Status) ([]Migration, error) {
+	latest := 0
+	for _, status := range statuses {
+		switch status.State {
+		case StateFailed:
+			return nil, errors.Wrapf(ErrFailedMigration, "version %d", status.Version)
+		case StateChanged:
+			return nil, errors.Wrapf(ErrChecksumMismatch, "version %d", status.Version)
+		case StateApplied, StateBaseline, StateMissing:
+			latest = max(latest, status.Version)
+		case StatePending:
+		}
+	}
+
+	var pending []Migration
+	for _, migration := range migrations {
+		index := slices.IndexFunc(statuses, func(status Status) bool {
+			return status.Version == migration.Version
+		})
+		if statuses[index].State != StatePending {
+			continue
+		}
+
+		if migration.Version < latest {
+			return nil, errors.Wrapf(ErrOutOfOrder, "version %d", migration.Version)
+		}
+
+		pending = append(pending, migration)
+	}
+
+	return pending, nil
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createAPIKey(t *testing.T, scopes []string) (string, string) {
+	t.Helper()
+	base := mustGetAppURL()
+
+	status, body := post(t, base+apiKeysCreate, map[string]any{
+		"name":   uniqueID("e2e-ci"),
+		"scopes": scopes,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	return getString(t, asMap(t, resp["api_key"]), "id"), getString(t, resp, "key")
+}
+
+func TestAPIKey_Create_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	cases := []map[string]any{
+		{"scopes": []string{"pr:read"}},
+		{"name": "ci"},
+		{"name": "ci", "scopes": []string{"pr:delete"}},
+	}
+
+	for _, payload := range cases {
+		status, body := post(t, base+apiKeysCreate, payload, adminAuth(t))
+		require.Equal(t, http.StatusBadRequest, status, string(body))
+	}
+}
+
+func TestAPIKey_ScopesLimitAccess(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	_, key := createAPIKey(t, []string{"team:read", "team:write"})
+	headers := map[string]string{"X-API-Key": key}
+	team := uniqueID("e2e-apikey")
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": team,
+		"members": []map[string]any{
+			{"user_id": team + "-u1", "username": "U1", "is_active": true},
+		},
+	}, headers)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+team, headers)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   team + "-pr",
+		"pull_request_name": "feature",
+		"author_id":         team + "-u1",
+	}, headers)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+	requireErrorCode(t, body, "FORBIDDEN")
+
+	status, body = getWithHeaders(t, base+apiKeysList, headers)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+}
+
+func TestAPIKey_InvalidAndRevoked(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	id, key := createAPIKey(t, []string{"pr:read"})
+	url := base + prListPath
+
+	status, body := getWithHeaders(t, url, map[string]string{"X-API-Key": key})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, url, map[string]string{"X-API-Key": id + ".wrong"})
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+apiKeysRevoke, map[string]any{"id": id}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.NotNil(t, asMap(t, resp["api_key"])["revoked_at"])
+
+	status, body = getWithHeaders(t, url, map[string]string{"X-API-Key": key})
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = getWithHeaders(t, base+apiKeysList, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.Contains(t, string(body), id)
+	require.NotContains(t, string(body), key)
+
+	status, body = post(t, base+apiKeysRevoke, map[string]any{"id": "missing"}, adminAuth(t))
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}
@@ -0,0 +1,109 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Concurrent reassignments of one reviewer do not overwrite each other:
+// exactly one wins, the others see its result or fail with CONFLICT.
+func TestPR_Reassign_ConcurrentCallsDoNotLoseUpdates(t *testing.T) {
+	t.Parallel()
+
+	const calls = 5
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-pr-concurrent")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	prID := "pr-" + tn
+
+	members := []any{
+		map[string]any{"user_id": author, "username": "author", "is_active": true},
+		map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+		map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+	}
+	spares := make([]string, 0, calls)
+	for i := range calls {
+		spare := "spare" + strconv.Itoa(i) + "-" + tn
+		spares = append(spares, spare)
+		members = append(members, map[string]any{"user_id": spare, "username": spare, "is_active": false})
+	}
+
+	status, body := post(t, base+teamAddPath, map[string]any{"team_name": tn, "members": members}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "concurrent",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	for _, spare := range spares {
+		status, body = post(t, base+usersSetActive, map[string]any{
+			"user_id":   spare,
+			"is_active": true,
+		}, adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+	}
+
+	var (
+		mu       sync.Mutex
+		statuses []int
+		codes    []string
+	)
+	t.Run("reassign", func(t *testing.T) {
+		for i := range calls {
+			t.Run(strconv.Itoa(i), func(t *testing.T) {
+				t.Parallel()
+
+				status, body := post(t, base+prReassignPath, map[string]any{
+					"pull_request_id": prID,
+					"old_reviewer_id": r1,
+				}, adminAuth(t))
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				statuses = append(statuses, status)
+				if status != http.StatusOK {
+					var resp map[string]any
+					require.NoError(t, json.Unmarshal(body, &resp))
+					codes = append(codes, getString(t, asMap(t, resp["error"]), "code"))
+				}
+			})
+		}
+	})
+
+	succeeded := 0
+	for _, status := range statuses {
+		if status == http.StatusOK {
+			succeeded++
+		}
+	}
+	require.Equal(t, 1, succeeded, "statuses: %v", statuses)
+	for _, code := range codes {
+		require.Contains(t, []string{"CONFLICT", "NOT_ASSIGNED"}, code)
+	}
+
+	status, body = getWithHeaders(t, base+prHistoryPath+"?pull_request_id="+prID, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	reassigned := 0
+	for _, e := range getArray(t, resp, "events") {
+		if getString(t, asMap(t, e), "event_type") == "REASSIGNED" {
+			reassigned++
+		}
+	}
+	require.Equal(t, 1, reassigned)
+}
@@ -0,0 +1,213 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeam_Delete_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := del(t, base+teamDeletePath, map[string]any{
+		"team_name":            uniqueID("e2e-team-delete-unauth"),
+		"users_policy":         "DEACTIVATE",
+		"pull_requests_policy": "KEEP",
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestTeam_Delete_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+	tn := uniqueID("e2e-team-delete-invalid")
+
+	cases := []map[string]any{
+		{"team_name": tn, "users_policy": "UNKNOWN", "pull_requests_policy": "KEEP"},
+		{"team_name": tn, "users_policy": "MOVE", "pull_requests_policy": "KEEP"},
+		{"team_name": tn, "users_policy": "MOVE", "target_team_name": tn, "pull_requests_policy": "KEEP"},
+		{"team_name": tn, "users_policy": "DELETE", "pull_requests_policy": "KEEP"},
+	}
+
+	for _, payload := range cases {
+		status, body := del(t, base+teamDeletePath, payload, auth)
+		require.Equal(t, http.StatusBadRequest, status, string(body))
+	}
+}
+
+// Moving users to another team drops their open reviews and tops the PRs up
+// from the target team.
+func TestTeam_Delete_MovesUsersAndUnassignsReviews(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-team-delete")
+	target := tn + "-target"
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+	targetMember := "u3-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamAddPath, map[string]any{
+		"team_name": target,
+		"members": []any{
+			map[string]any{"user_id": targetMember, "username": "target", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "delete team",
+		"author_id":         author,
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = del(t, base+teamDeletePath, map[string]any{
+		"team_name":            tn,
+		"users_policy":         "MOVE",
+		"target_team_name":     target,
+		"pull_requests_policy": "UNASSIGN",
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Len(t, getArray(t, resp, "users"), 2)
+
+	unassigned := getArray(t, resp, "unassigned_reviews")
+	require.Len(t, unassigned, 1)
+	require.Equal(t, reviewer, getString(t, asMap(t, unassigned[0]), "old_reviewer_id"))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+target, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var targetResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &targetResp))
+	require.Len(t, getArray(t, targetResp, "members"), 3)
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id=pr-"+tn, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var prResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &prResp))
+	pr := asMap(t, prResp["pr"])
+	reviewers := getArray(t, pr, "assigned_reviewers")
+	require.Len(t, reviewers, 2)
+}
+
+func TestTeam_Delete_DeletesUsersAndPullRequests(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-team-delete-all")
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "delete all",
+		"author_id":         author,
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = del(t, base+teamDeletePath, map[string]any{
+		"team_name":            tn,
+		"users_policy":         "DELETE",
+		"pull_requests_policy": "DELETE",
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	deleted := getArray(t, resp, "deleted_pull_request_ids")
+	require.True(t, containsString(deleted, "pr-"+tn))
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id=pr-"+tn, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}
+
+// Users who took part in merged pull requests are not deleted,
+// so the merged pull requests and their history are kept.
+func TestTeam_Delete_RefusesUsersWithMergedHistory(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-team-delete-history")
+	author := "u1-" + tn
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": "u2-" + tn, "username": "reviewer", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "merged history",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prMergePath, map[string]any{
+		"pull_request_id": prID,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = del(t, base+teamDeletePath, map[string]any{
+		"team_name":            tn,
+		"users_policy":         "DELETE",
+		"pull_requests_policy": "DELETE",
+	}, adminAuth(t))
+	require.Equal(t, http.StatusConflict, status, string(body))
+
+	var er map[string]any
+	require.NoError(t, json.Unmarshal(body, &er))
+	require.Equal(t, "USERS_HAVE_HISTORY", getString(t, asMap(t, er["error"]), "code"))
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id="+prID, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+}
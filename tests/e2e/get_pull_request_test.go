@@ -0,0 +1,72 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Get returns the full PR with reviewer details and does not change it.
+func TestPR_Get_ReturnsDetails(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	tn := uniqueID("e2e-get")
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "get",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	for range 2 {
+		status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id="+prID, adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+	}
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	pr := asMap(t, resp["pr"])
+
+	require.Equal(t, prID, getString(t, pr, "pull_request_id"))
+	require.Equal(t, "OPEN", getString(t, pr, "status"))
+	require.Equal(t, author, getString(t, pr, "author_id"))
+	require.Equal(t, tn, getString(t, pr, "author_team"))
+	require.NotEmpty(t, getString(t, pr, "created_at"))
+	require.Nil(t, pr["merged_at"])
+
+	reviewers := getArray(t, pr, "assigned_reviewers")
+	require.Len(t, reviewers, 1)
+	r := asMap(t, reviewers[0])
+	require.Equal(t, reviewer, getString(t, r, "user_id"))
+	require.Equal(t, "reviewer", getString(t, r, "username"))
+	require.True(t, getBool(t, r, "is_active"))
+}
+
+func TestPR_Get_NotFound(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := getWithHeaders(t, base+prGetPath+"?pull_request_id="+uniqueID("missing-pr"), adminAuth(t))
+	require.Equal(t, http.StatusNotFound, status, string(body))
+
+	status, body = getWithHeaders(t, base+prGetPath, adminAuth(t))
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+}
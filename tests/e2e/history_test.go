@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// History records initial assignment, reassignment and merge in order,
+// attributing the reassignment to the admin who made it.
+func TestPR_History_RecordsAllChanges(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+
+	tn := uniqueID("e2e-history")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	spare := "u4-" + tn
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+			map[string]any{"user_id": spare, "username": "spare", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "history",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+usersSetActive, map[string]any{
+		"user_id":   spare,
+		"is_active": true,
+	}, map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": r1,
+	}, map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+prMergePath, map[string]any{
+		"pull_request_id": prID,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+prHistoryPath+"?pull_request_id="+prID, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, prID, getString(t, resp, "pull_request_id"))
+
+	events := getArray(t, resp, "events")
+	require.Len(t, events, 4)
+
+	for _, e := range events[:2] {
+		event := asMap(t, e)
+		require.Equal(t, "ASSIGNED", getString(t, event, "event_type"))
+		require.Equal(t, "pull request created", getString(t, event, "reason"))
+		require.Nil(t, event["old_reviewer_id"])
+	}
+
+	reassigned := asMap(t, events[2])
+	require.Equal(t, "REASSIGNED", getString(t, reassigned, "event_type"))
+	require.Equal(t, getEnvDefault("ADMIN_ID", "admin"), getString(t, reassigned, "actor"))
+	require.Equal(t, r1, getString(t, reassigned, "old_reviewer_id"))
+	require.Equal(t, spare, getString(t, reassigned, "new_reviewer_id"))
+
+	merged := asMap(t, events[3])
+	require.Equal(t, "MERGED", getString(t, merged, "event_type"))
+	require.Equal(t, getEnvDefault("ADMIN_ID", "admin"), getString(t, merged, "actor"))
+}
+
+func TestPR_History_NotFound(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := getWithHeaders(t, base+prHistoryPath+"?pull_request_id="+uniqueID("missing-pr"), adminAuth(t))
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}
@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func withIdempotencyKey(t *testing.T, key string) map[string]string {
+	t.Helper()
+
+	headers := adminAuth(t)
+	headers["Idempotency-Key"] = key
+
+	return headers
+}
+
+// A retried /team/add is replayed instead of failing with TEAM_EXISTS.
+func TestIdempotency_TeamAddRetryIsReplayed(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-idem-team")
+	key := uniqueID("idem")
+	payload := map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": "u1-" + tn, "username": "author", "is_active": true},
+		},
+	}
+
+	status, first := post(t, base+teamAddPath, payload, withIdempotencyKey(t, key))
+	require.Equal(t, http.StatusCreated, status, string(first))
+
+	status, second := post(t, base+teamAddPath, payload, withIdempotencyKey(t, key))
+	require.Equal(t, http.StatusCreated, status, string(second))
+	require.JSONEq(t, string(first), string(second))
+
+	status, body := post(t, base+teamAddPath, payload, adminAuth(t))
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+	requireErrorCode(t, body, "TEAM_EXISTS")
+}
+
+// A retried reassign does not swap a second reviewer, and the key
+// cannot be reused for another request.
+func TestIdempotency_ReassignRetryDoesNotSwapTwice(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-idem-reassign")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	spare1 := "u4-" + tn
+	spare2 := "u5-" + tn
+	prID := "pr-" + tn
+	key := uniqueID("idem")
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+			map[string]any{"user_id": spare1, "username": "spare1", "is_active": false},
+			map[string]any{"user_id": spare2, "username": "spare2", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "idempotency",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	for _, spare := range []string{spare1, spare2} {
+		status, body = post(t, base+usersSetActive, map[string]any{
+			"user_id":   spare,
+			"is_active": true,
+		}, adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+	}
+
+	reassign := map[string]any{"pull_request_id": prID, "old_reviewer_id": r1}
+
+	status, first := post(t, base+prReassignPath, reassign, withIdempotencyKey(t, key))
+	require.Equal(t, http.StatusOK, status, string(first))
+
+	status, second := post(t, base+prReassignPath, reassign, withIdempotencyKey(t, key))
+	require.Equal(t, http.StatusOK, status, string(second))
+	require.JSONEq(t, string(first), string(second))
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": r2,
+	}, withIdempotencyKey(t, key))
+	require.Equal(t, http.StatusUnprocessableEntity, status, string(body))
+	requireErrorCode(t, body, "IDEMPOTENCY_KEY_REUSED")
+
+	status, body = getWithHeaders(t, base+prHistoryPath+"?pull_request_id="+prID, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	reassigned := 0
+	for _, e := range getArray(t, resp, "events") {
+		if getString(t, asMap(t, e), "event_type") == "REASSIGNED" {
+			reassigned++
+		}
+	}
+	require.Equal(t, 1, reassigned)
+}
@@ -0,0 +1,214 @@
+package tests
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func githubHeaders(t *testing.T, event string, payload any) map[string]string {
+	t.Helper()
+
+	body, err := json.Marshal(payload)
+	require.NoError(t, err)
+
+	mac := hmac.New(sha256.New, []byte(getEnvDefault("INTEGRATION_GITHUB_SECRET", "devgithubsecret")))
+	mac.Write(body)
+
+	return map[string]string{
+		"X-GitHub-Event":      event,
+		"X-Hub-Signature-256": "sha256=" + hex.EncodeToString(mac.Sum(nil)),
+	}
+}
+
+func gitlabHeaders() map[string]string {
+	return map[string]string{
+		"X-Gitlab-Event": "Merge Request Hook",
+		"X-Gitlab-Token": getEnvDefault("INTEGRATION_GITLAB_TOKEN", "devgitlabtoken"),
+	}
+}
+
+func githubPullRequestPayload(action string, repo string, number int, login string, merged bool) map[string]any {
+	return map[string]any{
+		"action": action,
+		"pull_request": map[string]any{
+			"number": number,
+			"title":  "e2e " + action,
+			"merged": merged,
+			"user":   map[string]any{"login": login},
+		},
+		"repository": map[string]any{"full_name": repo},
+	}
+}
+
+func TestIntegration_GitHub_InvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	payload := githubPullRequestPayload("opened", "e2e/repo", 1, "octocat", false)
+
+	status, body := post(t, base+githubWebhook, payload, map[string]string{
+		"X-GitHub-Event":      "pull_request",
+		"X-Hub-Signature-256": "sha256=deadbeef",
+	})
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestIntegration_GitHub_IgnoresOtherEvents(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	payload := map[string]any{"zen": "Keep it logically awesome."}
+
+	status, body := post(t, base+githubWebhook, payload, githubHeaders(t, "ping", payload))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "ignored", getString(t, resp, "status"))
+}
+
+func TestIntegration_GitLab_BodyTooLarge(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	payload := map[string]any{"object_kind": "merge_request", "padding": strings.Repeat("x", 6<<20)}
+
+	status, body := post(t, base+gitlabWebhook, payload, gitlabHeaders())
+	require.Equal(t, http.StatusRequestEntityTooLarge, status, string(body))
+	require.Contains(t, string(body), "PAYLOAD_TOO_LARGE")
+}
+
+func TestIntegration_GitHub_UnmappedLogin(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	payload := githubPullRequestPayload("opened", uniqueID("e2e/unmapped"), 1, uniqueID("ghost"), false)
+
+	status, body := post(t, base+githubWebhook, payload, githubHeaders(t, "pull_request", payload))
+	require.Equal(t, http.StatusNotFound, status, string(body))
+	require.Contains(t, string(body), "LOGIN_NOT_MAPPED")
+}
+
+func TestIntegration_GitHub_OpenAndMerge(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-github")
+	author := "u1-" + tn
+	login := "Login-" + tn
+	repo := "e2e/" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": "u2-" + tn, "username": "r1", "is_active": true},
+			map[string]any{"user_id": "u3-" + tn, "username": "r2", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+mappingsSet, map[string]any{
+		"provider": "GITHUB",
+		"login":    login,
+		"user_id":  author,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	opened := githubPullRequestPayload("opened", repo, 7, login, false)
+	status, body = post(t, base+githubWebhook, opened, githubHeaders(t, "pull_request", opened))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "processed", getString(t, resp, "status"))
+	prID := getString(t, resp, "pull_request_id")
+	require.Equal(t, repo+"#7", prID)
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id="+url.QueryEscape(prID), auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.Contains(t, string(body), author)
+
+	// A redelivered event is answered as processed.
+	status, body = post(t, base+githubWebhook, opened, githubHeaders(t, "pull_request", opened))
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "processed", getString(t, resp, "status"))
+
+	merged := githubPullRequestPayload("closed", repo, 7, login, true)
+	status, body = post(t, base+githubWebhook, merged, githubHeaders(t, "pull_request", merged))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id="+url.QueryEscape(prID), auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.Contains(t, string(body), "MERGED")
+
+	status, body = post(t, base+mappingsDelete, map[string]any{
+		"provider": "GITHUB",
+		"login":    login,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+}
+
+func TestIntegration_GitLab_Open(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-gitlab")
+	author := "u1-" + tn
+	login := "login-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+mappingsSet, map[string]any{
+		"provider": "GITLAB",
+		"login":    login,
+		"user_id":  author,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	payload := map[string]any{
+		"object_kind": "merge_request",
+		"user":        map[string]any{"username": login},
+		"project":     map[string]any{"path_with_namespace": "e2e/" + tn},
+		"object_attributes": map[string]any{
+			"iid":    3,
+			"title":  "e2e gitlab",
+			"action": "open",
+		},
+	}
+
+	status, body = post(t, base+gitlabWebhook, payload, map[string]string{"X-Gitlab-Event": "Merge Request Hook"})
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+gitlabWebhook, payload, gitlabHeaders())
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "e2e/"+tn+"!3", getString(t, resp, "pull_request_id"))
+
+	status, body = getWithHeaders(t, base+mappingsList+"?provider=GITLAB", auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+	require.Contains(t, string(body), login)
+}
@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKS_PublishesOnlyPublicKeys(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := get(t, base+jwksPath)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	for _, rawKey := range getArray(t, resp, "keys") {
+		key := asMap(t, rawKey)
+		require.NotEmpty(t, getString(t, key, "kid"))
+		require.NotContains(t, key, "d")
+		require.NotContains(t, key, "k")
+	}
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Listing walks all PRs of a team newest first, page by page, without gaps or duplicates.
+func TestPR_List_PaginatesByTeam(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	tn := uniqueID("e2e-list")
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	created := []string{"pr-1-" + tn, "pr-2-" + tn, "pr-3-" + tn}
+	for _, id := range created {
+		status, body = post(t, base+prCreatePath, map[string]any{
+			"pull_request_id":   id,
+			"pull_request_name": "list",
+			"author_id":         author,
+		}, adminAuth(t))
+		require.Equal(t, http.StatusCreated, status, string(body))
+	}
+
+	status, body = post(t, base+prMergePath, map[string]any{
+		"pull_request_id": created[0],
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var listed []string
+	cursor := ""
+	for range len(created) {
+		query := url.Values{}
+		query.Set("team_name", tn)
+		query.Set("limit", "2")
+		if cursor != "" {
+			query.Set("cursor", cursor)
+		}
+
+		status, body = getWithHeaders(t, base+prListPath+"?"+query.Encode(), adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(body, &resp))
+
+		for _, pr := range getArray(t, resp, "pull_requests") {
+			listed = append(listed, getString(t, asMap(t, pr), "pull_request_id"))
+		}
+
+		next, ok := resp["next_cursor"].(string)
+		if !ok {
+			break
+		}
+		cursor = next
+	}
+
+	require.Equal(t, []string{created[2], created[1], created[0]}, listed)
+
+	query := url.Values{}
+	query.Set("team_name", tn)
+	query.Set("status", "MERGED")
+	query.Set("reviewer_id", reviewer)
+
+	status, body = getWithHeaders(t, base+prListPath+"?"+query.Encode(), adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	merged := getArray(t, resp, "pull_requests")
+	require.Len(t, merged, 1)
+	require.Equal(t, created[0], getString(t, asMap(t, merged[0]), "pull_request_id"))
+	require.Nil(t, resp["next_cursor"])
+}
+
+func TestPR_List_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	for _, query := range []string{
+		"status=CLOSED",
+		"limit=0",
+		"limit=1000",
+		"cursor=not-a-cursor",
+		"from=yesterday",
+	} {
+		status, body := getWithHeaders(t, base+prListPath+"?"+query, adminAuth(t))
+		require.Equal(t, http.StatusBadRequest, status, "%s: %s", query, string(body))
+	}
+}
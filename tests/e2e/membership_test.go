@@ -0,0 +1,199 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeam_AddMember_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := post(t, base+teamAddMember, map[string]any{
+		"team_name": uniqueID("e2e-team-member-unauth"),
+		"member":    map[string]any{"user_id": "u", "username": "u", "is_active": true},
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+// A member added to an existing team is available as a reviewer, and removing
+// a reviewer hands their open review over to the remaining teammates.
+func TestTeam_AddMember_Then_RemoveMember_ReassignsReviews(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-team-member")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	newcomer := "u4-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "membership",
+		"author_id":         author,
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamAddMember, map[string]any{
+		"team_name": tn,
+		"member":    map[string]any{"user_id": newcomer, "username": "newcomer", "is_active": true},
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var addResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &addResp))
+	team := asMap(t, addResp["team"])
+	require.Len(t, getArray(t, team, "members"), 4)
+
+	status, body = post(t, base+teamAddMember, map[string]any{
+		"team_name": tn,
+		"member":    map[string]any{"user_id": newcomer, "username": "newcomer", "is_active": true},
+	}, auth)
+	require.Equal(t, http.StatusConflict, status, string(body))
+
+	status, body = post(t, base+teamRemoveMember, map[string]any{
+		"team_name": tn,
+		"user_id":   r1,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var removeResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &removeResp))
+	require.Equal(t, r1, getString(t, asMap(t, removeResp["removed_user"]), "user_id"))
+
+	replacements := getArray(t, removeResp, "reassigned_pull_requests")
+	require.Len(t, replacements, 1)
+	replacement := asMap(t, replacements[0])
+	require.Equal(t, "pr-"+tn, getString(t, replacement, "pull_request_id"))
+	require.Equal(t, r1, getString(t, replacement, "old_reviewer_id"))
+	require.Equal(t, newcomer, getString(t, replacement, "new_reviewer_id"))
+
+	status, body = post(t, base+teamRemoveMember, map[string]any{
+		"team_name": tn,
+		"user_id":   r1,
+	}, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var getResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &getResp))
+	require.Len(t, getArray(t, getResp, "members"), 3)
+}
+
+// A removed reviewer without a replacement is unassigned, so the pull request
+// does not keep a reviewer nobody can reassign, and the user can be reactivated.
+func TestTeam_RemoveMember_WithoutCandidate_Unassigns(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-team-member-alone")
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "alone",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamRemoveMember, map[string]any{
+		"team_name": tn,
+		"user_id":   reviewer,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var removeResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &removeResp))
+	replacements := getArray(t, removeResp, "reassigned_pull_requests")
+	require.Len(t, replacements, 1)
+	require.Nil(t, asMap(t, replacements[0])["new_reviewer_id"])
+
+	status, body = getWithHeaders(t, base+prGetPath+"?pull_request_id="+prID, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var getResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &getResp))
+	pr := asMap(t, getResp["pr"])
+	require.Empty(t, getArray(t, pr, "assigned_reviewers"))
+	require.True(t, getBool(t, pr, "need_more_reviewers"))
+
+	for _, active := range []bool{false, true} {
+		status, body = post(t, base+usersSetActive, map[string]any{
+			"user_id":   reviewer,
+			"is_active": active,
+		}, adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+	}
+}
+
+func TestTeam_Rename(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-team-rename")
+	renamed := tn + "-renamed"
+	member := "u1-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": member, "username": "member", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamRename, map[string]any{
+		"team_name":     tn,
+		"new_team_name": renamed,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	team := asMap(t, resp["team"])
+	require.Equal(t, renamed, getString(t, team, "team_name"))
+	require.Len(t, getArray(t, team, "members"), 1)
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+
+	status, body = post(t, base+teamRename, map[string]any{
+		"team_name":     "missing-" + tn,
+		"new_team_name": tn,
+	}, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}
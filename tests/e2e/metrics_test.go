@@ -0,0 +1,31 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Requests are counted by route pattern and error code, business and pool metrics are exposed.
+func TestMetrics_ExposesRequestsAndBusinessCounters(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := get(t, base+prGetPath+"?pull_request_id="+uniqueID("e2e-metrics-missing"))
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = get(t, base+metricsPath)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	metrics := string(body)
+	require.Contains(t, metrics, `code="UNAUTHORIZED",method="GET",route="/pullRequest/get",status="401"`)
+	require.Contains(t, metrics, `reviewer_service_http_request_duration_seconds_bucket{method="GET",route="/pullRequest/get",le="0.3"}`)
+	require.Contains(t, metrics, "reviewer_service_pull_requests_created_total")
+	require.Contains(t, metrics, "reviewer_service_pull_requests_merged_total")
+	require.Contains(t, metrics, `reviewer_service_pull_requests_reassignments_total{result="no_candidate"}`)
+	if usesPostgres() {
+		require.Contains(t, metrics, "reviewer_service_db_pool_acquired_connections")
+	}
+}
@@ -22,7 +22,7 @@ func TestPR_Create_Succeeds(t *testing.T) {
 		"members": []any{
 			map[string]any{"user_id": author, "username": "author", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// create PR
@@ -30,7 +30,7 @@ func TestPR_Create_Succeeds(t *testing.T) {
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "open-endpoint",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var resp map[string]any
@@ -62,14 +62,14 @@ func TestPR_Create_AutoAssignTwoReviewers(t *testing.T) {
 			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
 			map[string]any{"user_id": inactive, "username": "r3", "is_active": false},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "add feature",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var resp map[string]any
@@ -101,14 +101,14 @@ func TestPR_Create_AssignsOneWhenOnlyOneCandidate(t *testing.T) {
 			map[string]any{"user_id": author, "username": "author", "is_active": true},
 			map[string]any{"user_id": only, "username": "only", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "add A",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var resp map[string]any
@@ -134,14 +134,14 @@ func TestPR_Create_AssignsZeroWhenNoCandidates(t *testing.T) {
 	status, body := post(t, base+teamAddPath, map[string]any{
 		"team_name": tn,
 		"members":   []any{map[string]any{"user_id": author, "username": "author", "is_active": true}},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "empty",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var resp map[string]any
@@ -167,7 +167,7 @@ func TestPR_Create_Duplicate_Returns409(t *testing.T) {
 			map[string]any{"user_id": author, "username": "author", "is_active": true},
 			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	req := map[string]any{
@@ -176,10 +176,10 @@ func TestPR_Create_Duplicate_Returns409(t *testing.T) {
 		"author_id":         author,
 	}
 
-	status, body = post(t, base+prCreatePath, req, nil)
+	status, body = post(t, base+prCreatePath, req, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
-	status, body = post(t, base+prCreatePath, req, nil)
+	status, body = post(t, base+prCreatePath, req, adminAuth(t))
 	require.Equal(t, http.StatusConflict, status, string(body))
 
 	var er map[string]any
@@ -210,14 +210,14 @@ func TestPR_Reassign_Success(t *testing.T) {
 			map[string]any{"user_id": c, "username": "C", "is_active": true},
 			map[string]any{"user_id": inactive, "username": "X", "is_active": false},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "reassign",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var created map[string]any
@@ -241,7 +241,7 @@ func TestPR_Reassign_Success(t *testing.T) {
 	status, body = post(t, base+prReassignPath, map[string]any{
 		"pull_request_id": "pr-" + tn,
 		"old_reviewer_id": toReplace,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 
 	var rr map[string]any
@@ -279,20 +279,20 @@ func TestPR_Reassign_NoCandidate_409(t *testing.T) {
 			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
 			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "no-cand",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prReassignPath, map[string]any{
 		"pull_request_id": "pr-" + tn,
 		"old_reviewer_id": r1,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusConflict, status, string(body))
 
 	var er map[string]any
@@ -320,20 +320,20 @@ func TestPR_Reassign_NotAssigned_404(t *testing.T) {
 			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
 			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "not-assigned",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prReassignPath, map[string]any{
 		"pull_request_id": "pr-" + tn,
 		"old_reviewer_id": outsider,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusNotFound, status, string(body))
 
 	var er map[string]any
@@ -360,7 +360,7 @@ func TestPR_Merge_Idempotent_And_BlockReassign(t *testing.T) {
 			map[string]any{"user_id": author, "username": "author", "is_active": true},
 			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// create PR
@@ -368,13 +368,13 @@ func TestPR_Merge_Idempotent_And_BlockReassign(t *testing.T) {
 		"pull_request_id":   prID,
 		"pull_request_name": "merge-me",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// first merge
 	status, body = post(t, base+prMergePath, map[string]any{
 		"pull_request_id": prID,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 	var m1 map[string]any
 	require.NoError(t, json.Unmarshal(body, &m1))
@@ -384,7 +384,7 @@ func TestPR_Merge_Idempotent_And_BlockReassign(t *testing.T) {
 	// second merge (idempotent)
 	status, body = post(t, base+prMergePath, map[string]any{
 		"pull_request_id": prID,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 	var m2 map[string]any
 	require.NoError(t, json.Unmarshal(body, &m2))
@@ -395,7 +395,7 @@ func TestPR_Merge_Idempotent_And_BlockReassign(t *testing.T) {
 	status, body = post(t, base+prReassignPath, map[string]any{
 		"pull_request_id": prID,
 		"old_reviewer_id": r1,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusConflict, status, string(body))
 
 	var er map[string]any
@@ -416,14 +416,14 @@ func TestPR_Create_ExcludesAuthor_From_Reviewers(t *testing.T) {
 	status, body := post(t, base+teamAddPath, map[string]any{
 		"team_name": tn,
 		"members":   []any{map[string]any{"user_id": author, "username": "author", "is_active": true}},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	status, body = post(t, base+prCreatePath, map[string]any{
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "exclude-author",
 		"author_id":         author,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	var resp map[string]any
@@ -444,7 +444,7 @@ func TestPR_Create_UnknownAuthor_Returns404(t *testing.T) {
 		"pull_request_id":   "pr-" + tn,
 		"pull_request_name": "unknown-author",
 		"author_id":         "no-such-user-" + tn,
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusNotFound, status, string(body))
 
 	var er map[string]any
@@ -452,3 +452,54 @@ func TestPR_Create_UnknownAuthor_Returns404(t *testing.T) {
 	errObj := asMap(t, er["error"])
 	require.Equal(t, "NOT_FOUND", getString(t, errObj, "code"))
 }
+
+// A PR created with fewer than two reviewers is flagged and topped up when a teammate is activated.
+func TestPR_NeedMoreReviewers_ToppedUpOnActivation(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+
+	tn := uniqueID("e2e-pr-need-more")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	later := "u3-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": later, "username": "later", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "need more",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	pr := asMap(t, resp["pr"])
+	require.True(t, getBool(t, pr, "need_more_reviewers"))
+	require.Len(t, getArray(t, pr, "assigned_reviewers"), 1)
+
+	status, body = post(t, base+usersSetActive, map[string]any{
+		"user_id":   later,
+		"is_active": true,
+	}, map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+usersGetReview+"?user_id="+later, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var review map[string]any
+	require.NoError(t, json.Unmarshal(body, &review))
+	prs := getArray(t, review, "pull_requests")
+	require.Len(t, prs, 1)
+	require.Equal(t, "pr-"+tn, getString(t, asMap(t, prs[0]), "pull_request_id"))
+}
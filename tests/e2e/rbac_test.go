@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func registerAndLogin(t *testing.T, account map[string]any) map[string]string {
+	t.Helper()
+	base := mustGetAppURL()
+
+	account["password"] = "pass"
+	status, body := post(t, base+registerPath, account, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+loginPath, map[string]any{
+		"id":       account["id"],
+		"password": "pass",
+	}, nil)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	return map[string]string{"Authorization": "Bearer " + getString(t, resp, "token")}
+}
+
+func requireErrorCode(t *testing.T, body []byte, code string) {
+	t.Helper()
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, code, getString(t, asMap(t, resp["error"]), "code"))
+}
+
+func TestRBAC_RequiresToken(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": uniqueID("e2e-rbac-anon"),
+		"members":   []any{},
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = get(t, base+usersGetReview+"?user_id=u1")
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestRBAC_RegisterValidation(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	cases := []map[string]any{
+		{"id": uniqueID("e2e-rbac"), "password": "pass", "role": "UNKNOWN"},
+		{"id": uniqueID("e2e-rbac"), "password": "pass", "role": "TEAM_LEAD"},
+		{"id": uniqueID("e2e-rbac"), "password": "pass", "role": "MEMBER"},
+		{"id": uniqueID("e2e-rbac"), "password": "pass", "role": "ADMIN", "team_name": "x"},
+	}
+
+	for _, payload := range cases {
+		status, body := post(t, base+registerPath, payload, adminAuth(t))
+		require.Equal(t, http.StatusBadRequest, status, string(body))
+	}
+}
+
+func TestRBAC_TeamLeadManagesOnlyOwnTeam(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	own := uniqueID("e2e-rbac-own")
+	other := own + "-other"
+
+	for _, tn := range []string{own, other} {
+		status, body := post(t, base+teamAddPath, map[string]any{
+			"team_name": tn,
+			"members": []any{
+				map[string]any{"user_id": "u1-" + tn, "username": "u1", "is_active": true},
+			},
+		}, adminAuth(t))
+		require.Equal(t, http.StatusCreated, status, string(body))
+	}
+
+	lead := registerAndLogin(t, map[string]any{
+		"id":        "lead-" + own,
+		"role":      "TEAM_LEAD",
+		"team_name": own,
+	})
+
+	status, body := post(t, base+teamAddMember, map[string]any{
+		"team_name": own,
+		"member":    map[string]any{"user_id": "u2-" + own, "username": "u2", "is_active": true},
+	}, lead)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+teamAddMember, map[string]any{
+		"team_name": other,
+		"member":    map[string]any{"user_id": "u2-" + other, "username": "u2", "is_active": true},
+	}, lead)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+	requireErrorCode(t, body, "FORBIDDEN")
+
+	status, body = post(t, base+usersSetActive, map[string]any{
+		"user_id":   "u1-" + other,
+		"is_active": false,
+	}, lead)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+
+	status, body = post(t, base+teamRename, map[string]any{
+		"team_name":     own,
+		"new_team_name": own + "-renamed",
+	}, lead)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+
+	status, body = post(t, base+teamDeactivate, map[string]any{
+		"team_name": own,
+	}, lead)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+}
+
+func TestRBAC_MemberReassignsOnlyOwnReviews(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-rbac-member")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	spare := "u4-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	prID := "pr-" + tn
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "rbac",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamAddMember, map[string]any{
+		"team_name": tn,
+		"member":    map[string]any{"user_id": spare, "username": "spare", "is_active": true},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	member := registerAndLogin(t, map[string]any{
+		"id":      "member-" + tn,
+		"role":    "MEMBER",
+		"user_id": r1,
+	})
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID + "-2",
+		"pull_request_name": "rbac",
+		"author_id":         r1,
+	}, member)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": r2,
+	}, member)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": r1,
+	}, member)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+usersGetReview+"?user_id="+r2, member)
+	require.Equal(t, http.StatusForbidden, status, string(body))
+}
@@ -0,0 +1,35 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// A running instance with applied migrations is ready and reports the pool.
+func TestReady_ReportsDependencies(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := get(t, base+readyPath)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "ok", getString(t, resp, "status"))
+	require.Equal(t, "ok", getString(t, resp, "database"))
+	require.Equal(t, false, resp["shutting_down"])
+
+	migrations := asMap(t, resp["migrations"])
+	require.Equal(t, "ok", getString(t, migrations, "status"))
+	require.Equal(t, migrations["expected_version"], migrations["applied_version"])
+
+	pool := asMap(t, resp["pool"])
+	require.Contains(t, pool, "saturation")
+	if usesPostgres() {
+		require.Positive(t, pool["max_connections"])
+	}
+}
@@ -0,0 +1,219 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Preferred reviewers are assigned first, excluded ones are never picked
+// to fill the remaining slot.
+func TestPR_Create_PreferredAndExcludedReviewers(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-pr-preferred")
+	author := "u1-" + tn
+	a := "u2-" + tn
+	b := "u3-" + tn
+	c := "u4-" + tn
+	d := "u5-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": a, "username": "A", "is_active": true},
+			map[string]any{"user_id": b, "username": "B", "is_active": true},
+			map[string]any{"user_id": c, "username": "C", "is_active": true},
+			map[string]any{"user_id": d, "username": "D", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":     "pr-" + tn,
+		"pull_request_name":   "preferred",
+		"author_id":           author,
+		"preferred_reviewers": []string{c},
+		"excluded_reviewers":  []string{a, b},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var created map[string]any
+	require.NoError(t, json.Unmarshal(body, &created))
+	revs := getArray(t, asMap(t, created["pr"]), "assigned_reviewers")
+	require.ElementsMatch(t, []any{c, d}, revs)
+}
+
+// An ineligible preferred reviewer fails the creation with a precise code.
+func TestPR_Create_IneligiblePreferredReviewer(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-pr-preferred-bad")
+	author := "u1-" + tn
+	a := "u2-" + tn
+	b := "u3-" + tn
+	inactive := "u4-" + tn
+	other := uniqueID("e2e-pr-preferred-other")
+	outsider := "u1-" + other
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": a, "username": "A", "is_active": true},
+			map[string]any{"user_id": b, "username": "B", "is_active": true},
+			map[string]any{"user_id": inactive, "username": "X", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamAddPath, map[string]any{
+		"team_name": other,
+		"members": []any{
+			map[string]any{"user_id": outsider, "username": "outsider", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	tests := []struct {
+		name       string
+		preferred  []string
+		excluded   []string
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "inactive",
+			preferred:  []string{inactive},
+			wantStatus: http.StatusConflict,
+			wantCode:   "REVIEWER_INACTIVE",
+		},
+		{
+			name:       "author",
+			preferred:  []string{author},
+			wantStatus: http.StatusConflict,
+			wantCode:   "REVIEWER_IS_AUTHOR",
+		},
+		{
+			name:       "other team",
+			preferred:  []string{outsider},
+			wantStatus: http.StatusConflict,
+			wantCode:   "REVIEWER_NOT_IN_TEAM",
+		},
+		{
+			name:       "too many",
+			preferred:  []string{a, b, inactive},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "TOO_MANY_REVIEWERS",
+		},
+		{
+			name:       "preferred and excluded",
+			preferred:  []string{a},
+			excluded:   []string{a},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "BAD_REQUEST",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, body := post(t, base+prCreatePath, map[string]any{
+				"pull_request_id":     uniqueID("pr-" + tn),
+				"pull_request_name":   "preferred",
+				"author_id":           author,
+				"preferred_reviewers": tt.preferred,
+				"excluded_reviewers":  tt.excluded,
+			}, adminAuth(t))
+			require.Equal(t, tt.wantStatus, status, string(body))
+
+			var er map[string]any
+			require.NoError(t, json.Unmarshal(body, &er))
+			require.Equal(t, tt.wantCode, getString(t, asMap(t, er["error"]), "code"))
+		})
+	}
+}
+
+// Reassign replaces the reviewer with the chosen one if it is eligible,
+// and otherwise reports why it is not.
+func TestPR_Reassign_ChosenReviewer(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-pr-reassign-chosen")
+	author := "u1-" + tn
+	a := "u2-" + tn
+	b := "u3-" + tn
+	c := "u4-" + tn
+	inactive := "u5-" + tn
+	other := uniqueID("e2e-pr-reassign-chosen-other")
+	outsider := "u1-" + other
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": a, "username": "A", "is_active": true},
+			map[string]any{"user_id": b, "username": "B", "is_active": true},
+			map[string]any{"user_id": c, "username": "C", "is_active": true},
+			map[string]any{"user_id": inactive, "username": "X", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamAddPath, map[string]any{
+		"team_name": other,
+		"members": []any{
+			map[string]any{"user_id": outsider, "username": "outsider", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":     prID,
+		"pull_request_name":   "chosen",
+		"author_id":           author,
+		"preferred_reviewers": []string{a, b},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	for _, tc := range []struct {
+		chosen   string
+		wantCode string
+	}{
+		{chosen: b, wantCode: "REVIEWER_ALREADY_ASSIGNED"},
+		{chosen: inactive, wantCode: "REVIEWER_INACTIVE"},
+		{chosen: author, wantCode: "REVIEWER_IS_AUTHOR"},
+		{chosen: outsider, wantCode: "REVIEWER_NOT_IN_TEAM"},
+	} {
+		status, body = post(t, base+prReassignPath, map[string]any{
+			"pull_request_id": prID,
+			"old_reviewer_id": a,
+			"new_reviewer_id": tc.chosen,
+		}, adminAuth(t))
+		require.Equal(t, http.StatusConflict, status, string(body))
+
+		var er map[string]any
+		require.NoError(t, json.Unmarshal(body, &er))
+		require.Equal(t, tc.wantCode, getString(t, asMap(t, er["error"]), "code"))
+	}
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": a,
+		"new_reviewer_id": c,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, c, getString(t, resp, "replaced_by"))
+	require.ElementsMatch(t, []any{c, b}, getArray(t, asMap(t, resp["pr"]), "assigned_reviewers"))
+}
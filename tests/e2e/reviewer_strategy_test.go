@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// LEAST_LOADED must prefer the teammate without open reviews.
+func TestReviewerStrategy_LeastLoaded_PrefersIdleTeammate(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	tn := uniqueID("e2e-strategy-ll")
+	author := "u0-" + tn
+	members := []string{"u1-" + tn, "u2-" + tn, "u3-" + tn}
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name":         tn,
+		"reviewer_strategy": "LEAST_LOADED",
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": members[0], "username": "m1", "is_active": true},
+			map[string]any{"user_id": members[1], "username": "m2", "is_active": true},
+			map[string]any{"user_id": members[2], "username": "m3", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var teamResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &teamResp))
+	require.Equal(t, "LEAST_LOADED", getString(t, asMap(t, teamResp["team"]), "reviewer_strategy"))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-1-" + tn,
+		"pull_request_name": "first",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var firstResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &firstResp))
+	firstReviewers := getArray(t, asMap(t, firstResp["pr"]), "assigned_reviewers")
+	require.Len(t, firstReviewers, 2)
+
+	var idle string
+	for _, m := range members {
+		if !containsString(firstReviewers, m) {
+			idle = m
+		}
+	}
+	require.NotEmpty(t, idle)
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-2-" + tn,
+		"pull_request_name": "second",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var secondResp map[string]any
+	require.NoError(t, json.Unmarshal(body, &secondResp))
+	secondReviewers := getArray(t, asMap(t, secondResp["pr"]), "assigned_reviewers")
+	require.Len(t, secondReviewers, 2)
+	require.True(t, containsString(secondReviewers, idle), "idle teammate %s must be picked: %v", idle, secondReviewers)
+}
+
+func TestReviewerStrategy_Set(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-strategy-set")
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": "u1-" + tn, "username": "m1", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+teamSetStrategy, map[string]any{
+		"team_name":         tn,
+		"reviewer_strategy": "ROUND_ROBIN",
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+teamSetStrategy, map[string]any{
+		"team_name":         tn,
+		"reviewer_strategy": "ALPHABETICAL",
+	}, auth)
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+
+	status, body = post(t, base+teamSetStrategy, map[string]any{
+		"team_name":         tn,
+		"reviewer_strategy": "ROUND_ROBIN",
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.Equal(t, "ROUND_ROBIN", getString(t, asMap(t, resp["team"]), "reviewer_strategy"))
+
+	status, body = post(t, base+usersSetSeniority, map[string]any{
+		"user_id":   "u1-" + tn,
+		"seniority": 5,
+	}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	require.NoError(t, json.Unmarshal(body, &resp))
+	require.InDelta(t, 5, asMap(t, resp["user"])["seniority"], 0)
+}
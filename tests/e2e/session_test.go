@@ -0,0 +1,158 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func registerAccount(t *testing.T, password string) string {
+	t.Helper()
+	base := mustGetAppURL()
+
+	id := uniqueID("e2e-session")
+	status, body := post(t, base+registerPath, map[string]any{
+		"id":       id,
+		"password": password,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	return id
+}
+
+func login(t *testing.T, id string, password string) (string, string) {
+	t.Helper()
+	base := mustGetAppURL()
+
+	status, body := post(t, base+loginPath, map[string]any{
+		"id":       id,
+		"password": password,
+	}, nil)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	return tokenPair(t, body)
+}
+
+func tokenPair(t *testing.T, body []byte) (string, string) {
+	t.Helper()
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	return getString(t, resp, "token"), getString(t, resp, "refresh_token")
+}
+
+func bearer(token string) map[string]string {
+	return map[string]string{"Authorization": "Bearer " + token}
+}
+
+func requireTokenAccepted(t *testing.T, token string, accepted bool) {
+	t.Helper()
+	base := mustGetAppURL()
+
+	status, body := getWithHeaders(t, base+teamGetPath+"?team_name="+uniqueID("e2e-missing"), bearer(token))
+	if accepted {
+		require.Equal(t, http.StatusNotFound, status, string(body))
+		return
+	}
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestSession_Refresh_RotatesToken(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	id := registerAccount(t, "pass")
+	_, refresh := login(t, id, "pass")
+
+	status, body := post(t, base+refreshPath, map[string]any{"refresh_token": refresh}, nil)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	access, rotated := tokenPair(t, body)
+	require.NotEqual(t, refresh, rotated)
+	requireTokenAccepted(t, access, true)
+
+	status, body = post(t, base+refreshPath, map[string]any{"refresh_token": refresh}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+refreshPath, map[string]any{}, nil)
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+}
+
+func TestSession_Logout_RevokesTokens(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	id := registerAccount(t, "pass")
+	access, refresh := login(t, id, "pass")
+	requireTokenAccepted(t, access, true)
+
+	status, body := post(t, base+logoutPath, map[string]any{"refresh_token": refresh}, bearer(access))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	requireTokenAccepted(t, access, false)
+
+	status, body = post(t, base+refreshPath, map[string]any{"refresh_token": refresh}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+logoutPath, nil, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestSession_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	id := registerAccount(t, "pass")
+	access, refresh := login(t, id, "pass")
+
+	status, body := post(t, base+changePasswordPath, map[string]any{
+		"old_password": "wrong",
+		"new_password": "new-pass",
+	}, bearer(access))
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+	requireErrorCode(t, body, "INVALID_CREDENTIALS")
+
+	status, body = post(t, base+changePasswordPath, map[string]any{
+		"old_password": "pass",
+		"new_password": "new-pass",
+	}, bearer(access))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	newAccess, _ := tokenPair(t, body)
+	requireTokenAccepted(t, newAccess, true)
+	requireTokenAccepted(t, access, false)
+
+	status, body = post(t, base+refreshPath, map[string]any{"refresh_token": refresh}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = post(t, base+loginPath, map[string]any{"id": id, "password": "pass"}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	login(t, id, "new-pass")
+}
+
+func TestSession_DeleteAdmin(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	id := registerAccount(t, "pass")
+	access, refresh := login(t, id, "pass")
+
+	status, body := del(t, base+adminDeletePath, map[string]any{"id": id}, bearer(access))
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+
+	status, body = del(t, base+adminDeletePath, map[string]any{"id": id}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	requireTokenAccepted(t, access, false)
+
+	status, body = post(t, base+refreshPath, map[string]any{"refresh_token": refresh}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+
+	status, body = del(t, base+adminDeletePath, map[string]any{"id": id}, adminAuth(t))
+	require.Equal(t, http.StatusNotFound, status, string(body))
+	requireErrorCode(t, body, "NOT_FOUND")
+}
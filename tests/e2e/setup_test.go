@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -14,15 +15,45 @@ import (
 )
 
 const (
-	teamAddPath    = "/team/add"
-	teamGetPath    = "/team/get"
-	prCreatePath   = "/pullRequest/create"
-	prMergePath    = "/pullRequest/merge"
-	prReassignPath = "/pullRequest/reassign"
-	loginPath      = "/admins/login" // используется в loginAsDefaultAdmin()
-	registerPath   = "/admins/register"
-	usersSetActive = "/users/setIsActive"
-	usersGetReview = "/users/getReview"
+	teamAddPath        = "/team/add"
+	teamGetPath        = "/team/get"
+	teamDeactivate     = "/team/deactivate"
+	teamSetStrategy    = "/team/setReviewerStrategy"
+	teamAddMember      = "/team/addMember"
+	teamRemoveMember   = "/team/removeMember"
+	teamRename         = "/team/rename"
+	teamSetSettings    = "/team/setSettings"
+	teamDeletePath     = "/team"
+	prCreatePath       = "/pullRequest/create"
+	prMergePath        = "/pullRequest/merge"
+	prReassignPath     = "/pullRequest/reassign"
+	prHistoryPath      = "/pullRequest/history"
+	prListPath         = "/pullRequest/list"
+	prGetPath          = "/pullRequest/get"
+	loginPath          = "/admins/login" // используется в loginAsDefaultAdmin()
+	registerPath       = "/admins/register"
+	refreshPath        = "/admins/refresh"
+	logoutPath         = "/admins/logout"
+	changePasswordPath = "/admins/changePassword"
+	adminDeletePath    = "/admins"
+	apiKeysCreate      = "/apiKeys/create"
+	apiKeysList        = "/apiKeys/list"
+	apiKeysRevoke      = "/apiKeys/revoke"
+	jwksPath           = "/.well-known/jwks.json"
+	metricsPath        = "/metrics"
+	readyPath          = "/ready"
+	usersSetActive     = "/users/setIsActive"
+	usersGetReview     = "/users/getReview"
+	usersSetSeniority  = "/users/setSeniority"
+	statsReviewers     = "/stats/reviewers"
+	webhooksRegister   = "/webhooks/register"
+	webhooksList       = "/webhooks/list"
+	webhooksDelete     = "/webhooks/delete"
+	githubWebhook      = "/integrations/github/webhook"
+	gitlabWebhook      = "/integrations/gitlab/webhook"
+	mappingsSet        = "/integrations/mappings/set"
+	mappingsList       = "/integrations/mappings/list"
+	mappingsDelete     = "/integrations/mappings/delete"
 )
 
 func mustGetAppURL() string {
@@ -43,6 +74,12 @@ func getEnvDefault(key string, defaultVal string) string {
 	return env
 }
 
+// usesPostgres reports whether the app under test runs on the postgres
+// storage driver, the memory one has no connection pool.
+func usesPostgres() bool {
+	return getEnvDefault("STORAGE_DRIVER", "postgres") == "postgres"
+}
+
 func loginAsDefaultAdmin(t *testing.T) string {
 	t.Helper()
 	base := mustGetAppURL()
@@ -62,13 +99,43 @@ func loginAsDefaultAdmin(t *testing.T) string {
 	return token
 }
 
+var (
+	adminTokenOnce sync.Once
+	adminToken     string
+)
+
+// adminAuth returns the Authorization header of the default admin.
+// The token is shared by all tests to avoid logging in for every request.
+func adminAuth(t *testing.T) map[string]string {
+	t.Helper()
+
+	adminTokenOnce.Do(func() {
+		adminToken = loginAsDefaultAdmin(t)
+	})
+	require.NotEmpty(t, adminToken, "default admin login failed")
+
+	return map[string]string{"Authorization": "Bearer " + adminToken}
+}
+
 func post(t *testing.T, url string, payload any, headers map[string]string) (int, []byte) {
 	t.Helper()
 
+	return sendJSON(t, http.MethodPost, url, payload, headers)
+}
+
+func del(t *testing.T, url string, payload any, headers map[string]string) (int, []byte) {
+	t.Helper()
+
+	return sendJSON(t, http.MethodDelete, url, payload, headers)
+}
+
+func sendJSON(t *testing.T, method string, url string, payload any, headers map[string]string) (int, []byte) {
+	t.Helper()
+
 	body, err := json.Marshal(payload)
 	require.NoError(t, err)
 
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
 	require.NoError(t, err)
 	req.Header.Set("Content-Type", "application/json")
 	for k, v := range headers {
@@ -90,8 +157,17 @@ func post(t *testing.T, url string, payload any, headers map[string]string) (int
 func get(t *testing.T, rawURL string) (int, []byte) {
 	t.Helper()
 
+	return getWithHeaders(t, rawURL, nil)
+}
+
+func getWithHeaders(t *testing.T, rawURL string, headers map[string]string) (int, []byte) {
+	t.Helper()
+
 	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
 	require.NoError(t, err)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
 
 	cli := &http.Client{Timeout: 10 * time.Second}
 	res, err := cli.Do(req)
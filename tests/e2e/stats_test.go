@@ -0,0 +1,163 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// /stats/reviewers counts open assignments per reviewer and reviewers per PR within a team.
+func TestStats_Reviewers_CountsAssignments(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-stats")
+	author := "u1-" + tn
+	reviewer := "u2-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": reviewer, "username": "reviewer", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "stats",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	q := url.Values{}
+	q.Set("team_name", tn)
+
+	status, body = getWithHeaders(t, base+statsReviewers+"?"+q.Encode(), adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	reviewers := getArray(t, resp, "reviewers")
+	require.Len(t, reviewers, 2)
+	for _, raw := range reviewers {
+		r := asMap(t, raw)
+		switch getString(t, r, "user_id") {
+		case reviewer:
+			require.InDelta(t, 1, r["open"], 0)
+			require.InDelta(t, 1, r["total"], 0)
+		case author:
+			require.InDelta(t, 0, r["total"], 0)
+		default:
+			t.Fatalf("unexpected reviewer %v", r["user_id"])
+		}
+	}
+
+	prs := getArray(t, resp, "pull_requests")
+	require.Len(t, prs, 1)
+	pr := asMap(t, prs[0])
+	require.Equal(t, "pr-"+tn, getString(t, pr, "pull_request_id"))
+	require.InDelta(t, 1, pr["reviewers_count"], 0)
+}
+
+// The window counts reassignments by their own time, not by the creation time of the PR.
+func TestStats_Reviewers_ReassignmentOutsidePRWindow(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-stats-window")
+	author := "u1-" + tn
+	members := []any{
+		map[string]any{"user_id": author, "username": "author", "is_active": true},
+	}
+	for _, id := range []string{"u2-" + tn, "u3-" + tn, "u4-" + tn} {
+		members = append(members, map[string]any{"user_id": id, "username": id, "is_active": true})
+	}
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members":   members,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	prID := "pr-" + tn
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "stats window",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var created map[string]any
+	require.NoError(t, json.Unmarshal(body, &created))
+	revs := getArray(t, asMap(t, created["pr"]), "assigned_reviewers")
+	require.NotEmpty(t, revs)
+	oldReviewer, ok := revs[0].(string)
+	require.True(t, ok)
+
+	boundary := time.Now().UTC().Truncate(time.Second).Add(time.Second)
+	time.Sleep(time.Until(boundary) + 10*time.Millisecond)
+
+	status, body = post(t, base+prReassignPath, map[string]any{
+		"pull_request_id": prID,
+		"old_reviewer_id": oldReviewer,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	stats := func(q url.Values) map[string]any {
+		q.Set("team_name", tn)
+		status, body := getWithHeaders(t, base+statsReviewers+"?"+q.Encode(), adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(body, &resp))
+		for _, raw := range getArray(t, resp, "reviewers") {
+			r := asMap(t, raw)
+			if getString(t, r, "user_id") == oldReviewer {
+				return r
+			}
+		}
+		t.Fatalf("reviewer %s not found", oldReviewer)
+
+		return nil
+	}
+
+	after := stats(url.Values{"from": {boundary.Format(time.RFC3339)}})
+	require.InDelta(t, 0, after["total"], 0)
+	require.InDelta(t, 1, after["reassigned_away"], 0)
+
+	before := stats(url.Values{"to": {boundary.Format(time.RFC3339)}})
+	require.InDelta(t, 0, before["reassigned_away"], 0)
+}
+
+func TestStats_Reviewers_InvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	q := url.Values{}
+	q.Set("from", "yesterday")
+
+	status, body := getWithHeaders(t, base+statsReviewers+"?"+q.Encode(), adminAuth(t))
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+
+	var er map[string]any
+	require.NoError(t, json.Unmarshal(body, &er))
+	errObj := asMap(t, er["error"])
+	require.Equal(t, "BAD_REQUEST", getString(t, errObj, "code"))
+}
+
+func TestStats_Reviewers_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := get(t, base+statsReviewers)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
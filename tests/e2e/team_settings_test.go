@@ -0,0 +1,222 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Team settings bound the number of reviewers assigned on creation,
+// a per-PR count is accepted only within those bounds.
+func TestTeam_Settings_BoundReviewersCount(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-team-settings")
+	author := "u1-" + tn
+
+	members := []any{
+		map[string]any{"user_id": author, "username": "author", "is_active": true},
+	}
+	for _, id := range []string{"u2-", "u3-", "u4-", "u5-"} {
+		members = append(members, map[string]any{"user_id": id + tn, "username": id, "is_active": true})
+	}
+
+	status, body := post(t, base+teamAddPath, map[string]any{"team_name": tn, "members": members}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var added map[string]any
+	require.NoError(t, json.Unmarshal(body, &added))
+	team := asMap(t, added["team"])
+	require.InDelta(t, 2, team["min_reviewers"], 0)
+	require.InDelta(t, 2, team["max_reviewers"], 0)
+
+	status, body = post(t, base+teamSetSettings, map[string]any{
+		"team_name":     tn,
+		"min_reviewers": 1,
+		"max_reviewers": 3,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = getWithHeaders(t, base+teamGetPath+"?team_name="+tn, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var fetched map[string]any
+	require.NoError(t, json.Unmarshal(body, &fetched))
+	require.InDelta(t, 1, fetched["min_reviewers"], 0)
+	require.InDelta(t, 3, fetched["max_reviewers"], 0)
+
+	createPR := func(t *testing.T, id string, count any) (int, []byte) {
+		t.Helper()
+
+		payload := map[string]any{
+			"pull_request_id":   id,
+			"pull_request_name": "settings",
+			"author_id":         author,
+		}
+		if count != nil {
+			payload["reviewers_count"] = count
+		}
+
+		return post(t, base+prCreatePath, payload, adminAuth(t))
+	}
+
+	status, body = createPR(t, "pr-max-"+tn, nil)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var created map[string]any
+	require.NoError(t, json.Unmarshal(body, &created))
+	require.Len(t, getArray(t, asMap(t, created["pr"]), "assigned_reviewers"), 3)
+
+	status, body = createPR(t, "pr-one-"+tn, 1)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	require.NoError(t, json.Unmarshal(body, &created))
+	pr := asMap(t, created["pr"])
+	require.Len(t, getArray(t, pr, "assigned_reviewers"), 1)
+	require.False(t, getBool(t, pr, "need_more_reviewers"))
+
+	status, body = createPR(t, "pr-many-"+tn, 4)
+	require.Equal(t, http.StatusBadRequest, status, string(body))
+
+	var er map[string]any
+	require.NoError(t, json.Unmarshal(body, &er))
+	require.Equal(t, "INVALID_REVIEWERS_COUNT", getString(t, asMap(t, er["error"]), "code"))
+}
+
+func TestTeam_SetSettings_Validation(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-team-settings-bad")
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": "u1-" + tn, "username": "u1", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	tests := []struct {
+		name       string
+		payload    map[string]any
+		wantStatus int
+		wantCode   string
+	}{
+		{
+			name:       "min above max",
+			payload:    map[string]any{"team_name": tn, "min_reviewers": 3, "max_reviewers": 2},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "BAD_REQUEST",
+		},
+		{
+			name:       "zero max",
+			payload:    map[string]any{"team_name": tn, "min_reviewers": 0, "max_reviewers": 0},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "BAD_REQUEST",
+		},
+		{
+			name:       "missing max",
+			payload:    map[string]any{"team_name": tn, "min_reviewers": 1},
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "BAD_REQUEST",
+		},
+		{
+			name:       "unknown team",
+			payload:    map[string]any{"team_name": tn + "-missing", "min_reviewers": 1, "max_reviewers": 2},
+			wantStatus: http.StatusNotFound,
+			wantCode:   "NOT_FOUND",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, body := post(t, base+teamSetSettings, tt.payload, adminAuth(t))
+			require.Equal(t, tt.wantStatus, status, string(body))
+
+			var er map[string]any
+			require.NoError(t, json.Unmarshal(body, &er))
+			require.Equal(t, tt.wantCode, getString(t, asMap(t, er["error"]), "code"))
+		})
+	}
+}
+
+// Changing the minimum re-evaluates need_more_reviewers of open pull requests:
+// lowering it clears the flag, raising it tops the pull request up.
+func TestTeam_SetSettings_RefreshesNeedMoreReviewers(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	tn := uniqueID("e2e-team-settings-flag")
+	author := "u1-" + tn
+	prID := "pr-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": "u2-" + tn, "username": "u2", "is_active": true},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   prID,
+		"pull_request_name": "settings",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var created map[string]any
+	require.NoError(t, json.Unmarshal(body, &created))
+	require.True(t, getBool(t, asMap(t, created["pr"]), "need_more_reviewers"))
+
+	getPR := func(t *testing.T) map[string]any {
+		t.Helper()
+
+		status, body := getWithHeaders(t, base+prGetPath+"?pull_request_id="+prID, adminAuth(t))
+		require.Equal(t, http.StatusOK, status, string(body))
+
+		var resp map[string]any
+		require.NoError(t, json.Unmarshal(body, &resp))
+
+		return asMap(t, resp["pr"])
+	}
+
+	status, body = post(t, base+teamSetSettings, map[string]any{
+		"team_name":     tn,
+		"min_reviewers": 1,
+		"max_reviewers": 2,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	pr := getPR(t)
+	require.False(t, getBool(t, pr, "need_more_reviewers"))
+	require.Len(t, getArray(t, pr, "assigned_reviewers"), 1)
+
+	status, body = post(t, base+teamAddMember, map[string]any{
+		"team_name": tn,
+		"member":    map[string]any{"user_id": "u3-" + tn, "username": "u3", "is_active": true},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	pr = getPR(t)
+	require.False(t, getBool(t, pr, "need_more_reviewers"))
+	require.Len(t, getArray(t, pr, "assigned_reviewers"), 1)
+
+	status, body = post(t, base+teamSetSettings, map[string]any{
+		"team_name":     tn,
+		"min_reviewers": 2,
+		"max_reviewers": 2,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	pr = getPR(t)
+	require.False(t, getBool(t, pr, "need_more_reviewers"))
+	require.Len(t, getArray(t, pr, "assigned_reviewers"), 2)
+}
@@ -23,7 +23,7 @@ func TestTeam_Add_And_Get_Success(t *testing.T) {
 			map[string]any{"user_id": "u1-" + tn, "username": "Alice", "is_active": true},
 			map[string]any{"user_id": "u2-" + tn, "username": "Bob", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, "add team should return 201, got: %s", string(body))
 
 	// Validate envelope + payload fields
@@ -37,7 +37,7 @@ func TestTeam_Add_And_Get_Success(t *testing.T) {
 	// Fetch the team
 	q := url.Values{}
 	q.Set("team_name", tn)
-	status, body = get(t, base+teamGetPath+"?"+q.Encode())
+	status, body = getWithHeaders(t, base+teamGetPath+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, "get team should return 200, got: %s", string(body))
 
 	// /team/get returns the Team (no envelope)
@@ -72,11 +72,11 @@ func TestTeam_Add_Duplicate(t *testing.T) {
 	}
 
 	// First create -> 201
-	status, body := post(t, base+teamAddPath, req, nil)
+	status, body := post(t, base+teamAddPath, req, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, "first add must be 201, got: %s", string(body))
 
 	// Duplicate -> 400
-	status, body = post(t, base+teamAddPath, req, nil)
+	status, body = post(t, base+teamAddPath, req, adminAuth(t))
 	require.Equal(t, http.StatusBadRequest, status, "duplicate should be 400 or 409")
 
 	var er map[string]any
@@ -94,7 +94,7 @@ func TestTeam_Get_NotFound(t *testing.T) {
 
 	q := url.Values{}
 	q.Set("team_name", tn)
-	status, body := get(t, base+teamGetPath+"?"+q.Encode())
+	status, body := getWithHeaders(t, base+teamGetPath+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusNotFound, status, string(body))
 
 	var er map[string]any
@@ -115,7 +115,7 @@ func TestTeam_Add_ValidationErrors(t *testing.T) {
 		"members": []any{
 			map[string]any{"user_id": "x1", "username": "X", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusBadRequest, status)
 
 	var er map[string]any
@@ -127,7 +127,7 @@ func TestTeam_Add_ValidationErrors(t *testing.T) {
 	status, body = post(t, base+teamAddPath, map[string]any{
 		"team_name": uniqueID("e2e-nomembers"),
 		"members":   []any{},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusBadRequest, status)
 	require.NoError(t, json.Unmarshal(body, &er))
 	errObj = asMap(t, er["error"])
@@ -150,7 +150,7 @@ func TestTeam_Add_Deduplicates_SameUserID(t *testing.T) {
 			map[string]any{"user_id": dup, "username": "Alice-2", "is_active": false},
 			map[string]any{"user_id": "u2-" + tn, "username": "Bob", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, "add team should return 201, got: %s", string(body))
 
 	var addResp map[string]any
@@ -170,7 +170,7 @@ func TestTeam_Add_Deduplicates_SameUserID(t *testing.T) {
 	// Verify via /team/get as well
 	q := url.Values{}
 	q.Set("team_name", tn)
-	status, body = get(t, base+teamGetPath+"?"+q.Encode())
+	status, body = getWithHeaders(t, base+teamGetPath+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, "get team should return 200, got: %s", string(body))
 
 	var got map[string]any
@@ -185,3 +185,71 @@ func TestTeam_Add_Deduplicates_SameUserID(t *testing.T) {
 	require.Equal(t, 2, len(idCnt))
 	require.Equal(t, 1, idCnt[dup])
 }
+
+func TestTeam_Deactivate_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := post(t, base+teamDeactivate, map[string]any{
+		"team_name": uniqueID("e2e-team-deact-unauth"),
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+// Deactivating a reviewer swaps them out of open PRs for an active teammate.
+func TestTeam_Deactivate_ReassignsOpenPRs(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+
+	tn := uniqueID("e2e-team-deact")
+	author := "u1-" + tn
+	r1 := "u2-" + tn
+	r2 := "u3-" + tn
+	spare := "u4-" + tn
+
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": author, "username": "author", "is_active": true},
+			map[string]any{"user_id": r1, "username": "r1", "is_active": true},
+			map[string]any{"user_id": r2, "username": "r2", "is_active": true},
+			map[string]any{"user_id": spare, "username": "spare", "is_active": false},
+		},
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+prCreatePath, map[string]any{
+		"pull_request_id":   "pr-" + tn,
+		"pull_request_name": "deactivate",
+		"author_id":         author,
+	}, adminAuth(t))
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+usersSetActive, map[string]any{
+		"user_id":   spare,
+		"is_active": true,
+	}, map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+teamDeactivate, map[string]any{
+		"user_ids": []any{r1},
+	}, map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(body, &resp))
+
+	users := getArray(t, resp, "deactivated_users")
+	require.Len(t, users, 1)
+	require.Equal(t, r1, getString(t, asMap(t, users[0]), "user_id"))
+
+	replacements := getArray(t, resp, "reassigned_pull_requests")
+	require.Len(t, replacements, 1)
+	replacement := asMap(t, replacements[0])
+	require.Equal(t, "pr-"+tn, getString(t, replacement, "pull_request_id"))
+	require.Equal(t, r1, getString(t, replacement, "old_reviewer_id"))
+	require.Equal(t, spare, getString(t, replacement, "new_reviewer_id"))
+}
@@ -22,7 +22,7 @@ func TestUsers_SetActive_Unauthorized(t *testing.T) {
 		"members": []any{
 			map[string]any{"user_id": u1, "username": "User", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// No Authorization header -> must be 401 with code=UNAUTHORIZED
@@ -72,7 +72,7 @@ func TestUsers_SetActive_Toggle_Success(t *testing.T) {
 		"members": []any{
 			map[string]any{"user_id": u1, "username": "U1", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// Deactivate
@@ -117,13 +117,13 @@ func TestUsers_GetReview_EmptyList_WhenNoPRs(t *testing.T) {
 		"members": []any{
 			map[string]any{"user_id": u1, "username": "U1", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// No PRs for this user -> empty pull_requests array
 	q := url.Values{}
 	q.Set("user_id", u1)
-	status, body = get(t, base+usersGetReview+"?"+q.Encode())
+	status, body = getWithHeaders(t, base+usersGetReview+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 
 	var resp map[string]any
@@ -143,7 +143,7 @@ func TestUsers_GetReview_UnknownUser_Empty200(t *testing.T) {
 	q := url.Values{}
 	q.Set("user_id", uid)
 
-	status, body := get(t, base+usersGetReview+"?"+q.Encode())
+	status, body := getWithHeaders(t, base+usersGetReview+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 
 	var resp map[string]any
@@ -173,7 +173,7 @@ func TestUsers_GetReview_ListsAssignedPRs(t *testing.T) {
 			map[string]any{"user_id": r1, "username": "R1", "is_active": true},
 			map[string]any{"user_id": r2, "username": "R2", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// Create a PR (the service will auto-assign up to two reviewers)
@@ -188,7 +188,7 @@ func TestUsers_GetReview_ListsAssignedPRs(t *testing.T) {
 	// getReview for r1 must include that PR
 	q := url.Values{}
 	q.Set("user_id", r1)
-	status, body = get(t, base+usersGetReview+"?"+q.Encode())
+	status, body = getWithHeaders(t, base+usersGetReview+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 
 	var resp map[string]any
@@ -229,7 +229,7 @@ func TestUsers_Deactivated_NotAssigned_To_NewPRs(t *testing.T) {
 			map[string]any{"user_id": willOff, "username": "R1", "is_active": true},
 			map[string]any{"user_id": other, "username": "R2", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// Deactivate willOff and then create a PR -> willOff must NOT appear in assigned_reviewers
@@ -271,7 +271,7 @@ func TestUsers_GetReview_StillShowsAssignedPRs_AfterDeactivation(t *testing.T) {
 			map[string]any{"user_id": author, "username": "Author", "is_active": true},
 			map[string]any{"user_id": reviewer, "username": "R1", "is_active": true},
 		},
-	}, nil)
+	}, adminAuth(t))
 	require.Equal(t, http.StatusCreated, status, string(body))
 
 	// Create PR while reviewer is active (so they are assigned)
@@ -293,7 +293,7 @@ func TestUsers_GetReview_StillShowsAssignedPRs_AfterDeactivation(t *testing.T) {
 	// /users/getReview must still show the already assigned PR
 	q := url.Values{}
 	q.Set("user_id", reviewer)
-	status, body = get(t, base+usersGetReview+"?"+q.Encode())
+	status, body = getWithHeaders(t, base+usersGetReview+"?"+q.Encode(), adminAuth(t))
 	require.Equal(t, http.StatusOK, status, string(body))
 
 	var resp map[string]any
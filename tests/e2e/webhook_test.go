@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhook_Register_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+
+	status, body := post(t, base+webhooksRegister, map[string]any{
+		"url": "http://example.com/hook",
+	}, nil)
+	require.Equal(t, http.StatusUnauthorized, status, string(body))
+}
+
+func TestWebhook_Register_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	cases := []map[string]any{
+		{},
+		{"url": "example.com/hook"},
+		{"url": "ftp://example.com/hook"},
+		{"url": "http://example.com/hook", "team_name": ""},
+	}
+
+	for _, payload := range cases {
+		status, body := post(t, base+webhooksRegister, payload, auth)
+		require.Equal(t, http.StatusBadRequest, status, string(body))
+	}
+}
+
+func TestWebhook_Register_UnknownTeam(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	status, body := post(t, base+webhooksRegister, map[string]any{
+		"url":       "http://example.com/hook",
+		"team_name": uniqueID("e2e-webhook-missing"),
+	}, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}
+
+func TestWebhook_RegisterListDelete(t *testing.T) {
+	t.Parallel()
+
+	base := mustGetAppURL()
+	token := loginAsDefaultAdmin(t)
+	auth := map[string]string{"Authorization": "Bearer " + token}
+
+	tn := uniqueID("e2e-webhook")
+	status, body := post(t, base+teamAddPath, map[string]any{
+		"team_name": tn,
+		"members": []any{
+			map[string]any{"user_id": "u1-" + tn, "username": "u1", "is_active": true},
+		},
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	status, body = post(t, base+webhooksRegister, map[string]any{
+		"url":       "http://example.com/hook",
+		"team_name": tn,
+		"secret":    "e2e-secret",
+	}, auth)
+	require.Equal(t, http.StatusCreated, status, string(body))
+
+	var registered map[string]any
+	require.NoError(t, json.Unmarshal(body, &registered))
+	require.Equal(t, "e2e-secret", getString(t, registered, "secret"))
+	webhook := asMap(t, registered["webhook"])
+	require.Equal(t, tn, getString(t, webhook, "team_name"))
+	id := webhook["webhook_id"]
+
+	status, body = getWithHeaders(t, base+webhooksList, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	var list map[string]any
+	require.NoError(t, json.Unmarshal(body, &list))
+	found := false
+	for _, item := range getArray(t, list, "webhooks") {
+		w := asMap(t, item)
+		if w["webhook_id"] == id {
+			found = true
+			_, hasSecret := w["secret"]
+			require.False(t, hasSecret, "list must not expose secrets")
+		}
+	}
+	require.True(t, found, "registered webhook must be listed")
+
+	status, body = post(t, base+webhooksDelete, map[string]any{"webhook_id": id}, auth)
+	require.Equal(t, http.StatusOK, status, string(body))
+
+	status, body = post(t, base+webhooksDelete, map[string]any{"webhook_id": id}, auth)
+	require.Equal(t, http.StatusNotFound, status, string(body))
+}